@@ -0,0 +1,1656 @@
+// Package annotator splits a FlatBuffer into annotated regions using the
+// binary schema (.bfbs) it was built with. It is a port of the annotator
+// behind `flatc --annotate` and produces the same region-by-region view,
+// including vtables, padding, unknown regions and invalid offsets, so it can
+// be used to explain why a buffer was rejected.
+package annotator
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+	"github.com/google/flatbuffers/go/reflection"
+)
+
+// RegionType is the underlying data type of a Region.
+type RegionType int
+
+const (
+	RegionUnknown RegionType = iota
+	RegionUOffset
+	RegionSOffset
+	RegionVOffset
+	RegionBool
+	RegionByte
+	RegionChar
+	RegionUint8
+	RegionInt8
+	RegionUint16
+	RegionInt16
+	RegionUint32
+	RegionInt32
+	RegionUint64
+	RegionInt64
+	RegionFloat
+	RegionDouble
+	RegionUType
+	RegionUOffset64
+)
+
+var regionTypeNames = map[RegionType]string{
+	RegionUOffset:   "UOffset32",
+	RegionUOffset64: "UOffset64",
+	RegionSOffset:   "SOffset32",
+	RegionVOffset:   "VOffset16",
+	RegionBool:      "bool",
+	RegionChar:      "char",
+	RegionByte:      "int8_t",
+	RegionUint8:     "uint8_t",
+	RegionUint16:    "uint16_t",
+	RegionUint32:    "uint32_t",
+	RegionUint64:    "uint64_t",
+	RegionInt8:      "int8_t",
+	RegionInt16:     "int16_t",
+	RegionInt32:     "int32_t",
+	RegionInt64:     "int64_t",
+	RegionDouble:    "double",
+	RegionFloat:     "float",
+	RegionUType:     "UType8",
+	RegionUnknown:   "?uint8_t",
+}
+
+func (t RegionType) String() string {
+	if s, ok := regionTypeNames[t]; ok {
+		return s
+	}
+	return "todo"
+}
+
+// IsOffset reports whether the region holds an offset to another location.
+func (t RegionType) IsOffset() bool {
+	return t == RegionUOffset || t == RegionSOffset || t == RegionUOffset64
+}
+
+// RegionStatus tells whether a Region is well formed. Values at or above
+// StatusError mark regions that make the buffer invalid.
+type RegionStatus int
+
+const StatusOK RegionStatus = 0
+
+const (
+	StatusWarn RegionStatus = 100 + iota
+	StatusWarnNoReferences
+	StatusWarnCorruptedPadding
+	StatusWarnPaddingLength
+)
+
+const (
+	StatusError RegionStatus = 200 + iota
+	// An offset is pointing outside the binary bounds.
+	StatusErrorOffsetOutOfBinary
+	// Expecting to read N bytes but not enough remain in the binary.
+	StatusErrorIncompleteBinary
+	// When a length of a vtable/vector is longer than possible.
+	StatusErrorLengthTooLong
+	// When a length of a vtable/vector is shorter than possible.
+	StatusErrorLengthTooShort
+	// A field marked required is not present in the vtable.
+	StatusErrorRequiredFieldNotPresent
+	// A realized union type is not within the enum bounds.
+	StatusErrorInvalidUnionType
+	// Occurs when there is a cycle in offsets.
+	StatusErrorCycleDetected
+)
+
+// IsError reports whether the status marks an invalid region.
+func (s RegionStatus) IsError() bool {
+	return s >= StatusError
+}
+
+// CommentType describes the role a Region plays in its Section.
+type CommentType int
+
+const (
+	CommentUnknown CommentType = iota
+	CommentSizePrefix
+	// The offset to the root table.
+	CommentRootTableOffset
+	// The optional 4-char file identifier.
+	CommentFileIdentifier
+	// Generic 0-filled padding.
+	CommentPadding
+	// The size of the vtable.
+	CommentVTableSize
+	// The size of the referring table.
+	CommentVTableReferringTableLength
+	// Offsets to vtable fields.
+	CommentVTableFieldOffset
+	// Offsets to unknown vtable fields.
+	CommentVTableUnknownFieldOffset
+	// The vtable offset of a table.
+	CommentTableVTableOffset
+	// A "inline" table field value.
+	CommentTableField
+	// A table field that is unknown.
+	CommentTableUnknownField
+	// A table field value that points to another section.
+	CommentTableOffsetField
+	// A struct field value.
+	CommentStructField
+	// A array field value.
+	CommentArrayField
+	// The length of the string.
+	CommentStringLength
+	// The string contents.
+	CommentStringValue
+	// The explicit string terminator.
+	CommentStringTerminator
+	// The length of the vector (# of items).
+	CommentVectorLength
+	// A "inline" value of a vector.
+	CommentVectorValue
+	// A vector value that points to another section.
+	CommentVectorTableValue
+	CommentVectorStringValue
+	CommentVectorUnionValue
+)
+
+// Comment explains a Region.
+type Comment struct {
+	Status RegionStatus
+	// If Status is not StatusOK, this may be filled in with additional
+	// details.
+	StatusMessage string
+	Type          CommentType
+	Name          string
+	DefaultValue  string
+	Index         uint64
+}
+
+func (c *Comment) setError(status RegionStatus, message string) {
+	c.Status = status
+	c.StatusMessage = message
+}
+
+// Region is a contiguous run of bytes with a single meaning.
+type Region struct {
+	// Offset into the binary where this region begins.
+	Offset uint64
+	// The length of this region in bytes.
+	Length uint64
+	Type   RegionType
+	// If Type is an array/vector, this is the number of those types this
+	// region encompasses.
+	ArrayLength uint64
+	// If this is an offset to some other region, this is what it points to,
+	// relative to the start of the binary.
+	PointsToOffset uint64
+	Comment        Comment
+}
+
+// SectionType is the kind of object a Section holds.
+type SectionType int
+
+const (
+	SectionUnknown SectionType = iota
+	SectionHeader
+	SectionTable
+	SectionRootTable
+	SectionVTable
+	SectionStruct
+	SectionString
+	SectionVector
+	SectionUnion
+	SectionPadding
+	SectionVector64
+)
+
+var sectionTypeNames = map[SectionType]string{
+	SectionHeader:    "header",
+	SectionTable:     "table",
+	SectionRootTable: "root_table",
+	SectionVTable:    "vtable",
+	SectionStruct:    "struct",
+	SectionString:    "string",
+	SectionVector:    "vector",
+	SectionVector64:  "vector64",
+	SectionUnknown:   "unknown",
+	SectionUnion:     "union",
+	SectionPadding:   "padding",
+}
+
+func (t SectionType) String() string {
+	if s, ok := sectionTypeNames[t]; ok {
+		return s
+	}
+	return "todo"
+}
+
+// Section is a group of regions that belong together, such as a table, a
+// vtable, a string or a vector.
+type Section struct {
+	// Offset is the absolute offset the section is keyed by.
+	Offset uint64
+	// Name of the section, if applicable.
+	Name string
+	Type SectionType
+	// The regions that make up this section, in order of their offsets.
+	Regions []Region
+}
+
+// Errors returned by Annotate.
+var (
+	ErrInvalidSchema  = errors.New("annotator: not a binary schema (.bfbs)")
+	ErrBufferTooShort = errors.New("annotator: buffer is too short to be a FlatBuffer")
+)
+
+const fileIdentifierLength = 4
+
+// minBufferSize is the smallest buffer that can hold a root offset, a table
+// and its vtable.
+const minBufferSize = flatbuffers.SizeUOffsetT + flatbuffers.SizeSOffsetT +
+	2*flatbuffers.SizeVOffsetT
+
+// Annotate splits buf into sections described by the binary schema bfbs,
+// ordered by offset. Every byte of buf belongs to exactly one region; bytes
+// that nothing refers to end up in padding or unknown sections. Problems in
+// buf are reported through each Region's Comment.Status rather than as an
+// error. The schema itself is trusted.
+func Annotate(bfbs, buf []byte, sizePrefixed bool) ([]Section, error) {
+	if len(bfbs) < minBufferSize || !reflection.SchemaBufferHasIdentifier(bfbs) {
+		return nil, ErrInvalidSchema
+	}
+	schema := reflection.GetRootAsSchema(bfbs, 0)
+	if schema.RootTable(nil) == nil {
+		return nil, ErrInvalidSchema
+	}
+	if len(buf) < minBufferSize {
+		return nil, ErrBufferTooShort
+	}
+
+	a := &annotator{
+		schema:       schema,
+		binary:       buf,
+		sizePrefixed: sizePrefixed,
+		vtables:      map[uint64][]*vtable{},
+		sections:     map[uint64]*Section{},
+	}
+
+	// First parse the header region which always start at offset 0. The
+	// returned offset will point to the root_table location.
+	rootTableOffset := a.buildHeader(0)
+	if a.isValidOffset(rootTableOffset) {
+		// Build the root table, and all else will be referenced from it.
+		a.buildTable(rootTableOffset, SectionRootTable, schema.RootTable(nil))
+	}
+
+	// Now that all the sections are built, make sure the binary sections are
+	// contiguous.
+	a.fixMissingRegions()
+
+	// Then scan the area between sections and insert the implied padding.
+	a.fixMissingSections()
+
+	sections := make([]Section, 0, len(a.offsets))
+	for _, offset := range a.offsets {
+		sections = append(sections, *a.sections[offset])
+	}
+	return sections, nil
+}
+
+type vtableEntry struct {
+	// field is nil for vtable entries the schema doesn't know about.
+	field           *reflection.Field
+	offsetFromTable uint16
+}
+
+type vtable struct {
+	// referringTable is the position of the reflection.Object in the schema.
+	referringTable flatbuffers.UOffsetT
+	// Field ID -> {field def, offset from table}
+	fields     map[uint16]vtableEntry
+	vtableSize uint16
+	tableSize  uint16
+}
+
+type annotator struct {
+	schema       *reflection.Schema
+	binary       []byte
+	sizePrefixed bool
+
+	// Binary offset to vtables, to dedupe vtables.
+	vtables map[uint64][]*vtable
+
+	// The sections, indexed by their absolute offset, and those offsets in
+	// increasing order.
+	sections map[uint64]*Section
+	offsets  []uint64
+}
+
+func (a *annotator) length() uint64 {
+	return uint64(len(a.binary))
+}
+
+func (a *annotator) isValidOffset(offset uint64) bool {
+	return offset < a.length()
+}
+
+// isValidRead reports whether reading length bytes at offset stays within
+// the binary.
+func (a *annotator) isValidRead(offset, length uint64) bool {
+	return length < a.length() && a.isValidOffset(offset+length-1)
+}
+
+// remainingBytes is the number of bytes from offset to the end of the binary.
+func (a *annotator) remainingBytes(offset uint64) uint64 {
+	if a.isValidOffset(offset) {
+		return a.length() - offset
+	}
+	return 0
+}
+
+func (a *annotator) readUint8(offset uint64) (uint8, bool) {
+	if !a.isValidRead(offset, 1) {
+		return 0, false
+	}
+	return a.binary[offset], true
+}
+
+func (a *annotator) readUint16(offset uint64) (uint16, bool) {
+	if !a.isValidRead(offset, 2) {
+		return 0, false
+	}
+	return flatbuffers.GetUint16(a.binary[offset:]), true
+}
+
+func (a *annotator) readInt32(offset uint64) (int32, bool) {
+	if !a.isValidRead(offset, 4) {
+		return 0, false
+	}
+	return flatbuffers.GetInt32(a.binary[offset:]), true
+}
+
+func (a *annotator) readUint32(offset uint64) (uint32, bool) {
+	if !a.isValidRead(offset, 4) {
+		return 0, false
+	}
+	return flatbuffers.GetUint32(a.binary[offset:]), true
+}
+
+func (a *annotator) readUint64(offset uint64) (uint64, bool) {
+	if !a.isValidRead(offset, 8) {
+		return 0, false
+	}
+	return flatbuffers.GetUint64(a.binary[offset:]), true
+}
+
+// addSection records section at offset, unless one is already there.
+func (a *annotator) addSection(offset uint64, section Section) {
+	if _, ok := a.sections[offset]; ok {
+		return
+	}
+	a.setSection(offset, section)
+}
+
+// setSection records section at offset, replacing any existing one.
+func (a *annotator) setSection(offset uint64, section Section) {
+	section.Offset = offset
+	if _, ok := a.sections[offset]; !ok {
+		i := sort.Search(len(a.offsets), func(i int) bool { return a.offsets[i] >= offset })
+		a.offsets = append(a.offsets, 0)
+		copy(a.offsets[i+1:], a.offsets[i:])
+		a.offsets[i] = offset
+	}
+	a.sections[offset] = &section
+}
+
+// containsSection reports whether offset is the start of, or inside, an
+// existing section.
+func (a *annotator) containsSection(offset uint64) bool {
+	i := sort.Search(len(a.offsets), func(i int) bool { return a.offsets[i] >= offset })
+	if i < len(a.offsets) && a.offsets[i] == offset {
+		return true
+	}
+	if i == 0 {
+		return false
+	}
+	prev := a.sections[a.offsets[i-1]]
+	if len(prev.Regions) == 0 {
+		return false
+	}
+	last := prev.Regions[len(prev.Regions)-1]
+	return offset >= prev.Offset && offset < last.Offset+last.Length
+}
+
+func (a *annotator) object(index int32) *reflection.Object {
+	obj := new(reflection.Object)
+	a.schema.Objects(obj, int(index))
+	return obj
+}
+
+func (a *annotator) isInlineField(field *reflection.Field) bool {
+	t := field.Type(nil)
+	if t.BaseType() == reflection.BaseTypeObj {
+		return a.object(t.Index()).IsStruct()
+	}
+	return isScalar(t.BaseType())
+}
+
+func isUnionType(t reflection.BaseType) bool {
+	return t == reflection.BaseTypeUType || t == reflection.BaseTypeUnion
+}
+
+func isUnionField(field *reflection.Field) bool {
+	t := field.Type(nil)
+	return isUnionType(t.BaseType()) && t.Index() >= 0
+}
+
+func (a *annotator) isValidUnionField(field *reflection.Field, value uint8) bool {
+	return isUnionField(field) && a.isValidUnionValue(field.Type(nil).Index(), value)
+}
+
+func (a *annotator) isValidUnionValue(enumID int32, value uint8) bool {
+	if enumID < 0 || int(enumID) >= a.schema.EnumsLength() {
+		return false
+	}
+	enum := new(reflection.Enum)
+	if !a.schema.Enums(enum, int(enumID)) {
+		return false
+	}
+	return int(value) < enum.ValuesLength()
+}
+
+func (a *annotator) elementSize(field *reflection.Field) uint64 {
+	t := field.Type(nil)
+	if isScalar(t.Element()) {
+		return typeSize(t.Element())
+	}
+	if t.Element() == reflection.BaseTypeObj {
+		if obj := a.object(t.Index()); obj.IsStruct() {
+			return uint64(obj.Bytesize())
+		}
+	}
+	return flatbuffers.SizeUOffsetT
+}
+
+func isScalar(t reflection.BaseType) bool {
+	return t >= reflection.BaseTypeUType && t <= reflection.BaseTypeDouble
+}
+
+func isFloat(t reflection.BaseType) bool {
+	return t == reflection.BaseTypeFloat || t == reflection.BaseTypeDouble
+}
+
+var typeSizes = [...]uint64{
+	reflection.BaseTypeNone:     0,
+	reflection.BaseTypeUType:    1,
+	reflection.BaseTypeBool:     1,
+	reflection.BaseTypeByte:     1,
+	reflection.BaseTypeUByte:    1,
+	reflection.BaseTypeShort:    2,
+	reflection.BaseTypeUShort:   2,
+	reflection.BaseTypeInt:      4,
+	reflection.BaseTypeUInt:     4,
+	reflection.BaseTypeLong:     8,
+	reflection.BaseTypeULong:    8,
+	reflection.BaseTypeFloat:    4,
+	reflection.BaseTypeDouble:   8,
+	reflection.BaseTypeString:   4,
+	reflection.BaseTypeVector:   4,
+	reflection.BaseTypeObj:      4,
+	reflection.BaseTypeUnion:    4,
+	reflection.BaseTypeArray:    0,
+	reflection.BaseTypeVector64: 8,
+}
+
+// typeSize is the size of a basic type, don't use with structs.
+func typeSize(t reflection.BaseType) uint64 {
+	if int(t) < len(typeSizes) {
+		return typeSizes[t]
+	}
+	return 0
+}
+
+func regionType(t reflection.BaseType) RegionType {
+	switch t {
+	case reflection.BaseTypeUType:
+		return RegionUType
+	case reflection.BaseTypeBool, reflection.BaseTypeByte, reflection.BaseTypeUByte:
+		return RegionUint8
+	case reflection.BaseTypeShort:
+		return RegionInt16
+	case reflection.BaseTypeUShort:
+		return RegionUint16
+	case reflection.BaseTypeInt, reflection.BaseTypeUInt:
+		return RegionUint32
+	case reflection.BaseTypeLong:
+		return RegionInt64
+	case reflection.BaseTypeULong:
+		return RegionUint64
+	case reflection.BaseTypeFloat:
+		return RegionFloat
+	case reflection.BaseTypeDouble:
+		return RegionDouble
+	default:
+		return RegionUnknown
+	}
+}
+
+// forAllFields calls fn for each field of object in order of their ids.
+func forAllFields(object *reflection.Object, fn func(field *reflection.Field)) {
+	fields := make([]*reflection.Field, object.FieldsLength())
+	for i := range fields {
+		field := new(reflection.Field)
+		object.Fields(field, i)
+		if int(field.Id()) < len(fields) {
+			fields[field.Id()] = field
+		}
+	}
+	for _, field := range fields {
+		if field != nil {
+			fn(field)
+		}
+	}
+}
+
+func singleRegionSection(name string, t SectionType, region Region) Section {
+	return Section{Name: name, Type: t, Regions: []Region{region}}
+}
+
+func isNonZeroRegion(offset, length uint64, binary []byte) bool {
+	for i := offset; i < offset+length; i++ {
+		if binary[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func isPrint(c byte) bool {
+	return c >= 0x20 && c < 0x7F
+}
+
+func isPrintableRegion(offset, length uint64, binary []byte) bool {
+	for i := offset; i < offset+length; i++ {
+		if !isPrint(binary[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func generateMissingSection(offset, length uint64, binary []byte) Section {
+	// Check if the region is all zeros or not, as that can tell us if it is
+	// padding or not.
+	if isNonZeroRegion(offset, length, binary) {
+		// Some of the padding bytes are non-zero, so this might be an unknown
+		// section of the binary.
+		comment := Comment{Type: CommentUnknown}
+		if length >= 8 {
+			comment.setError(StatusWarnNoReferences, "")
+		} else {
+			comment.setError(StatusWarnCorruptedPadding, "")
+		}
+		return singleRegionSection("no known references", SectionUnknown, Region{
+			Offset: offset, Length: length, Type: RegionUnknown,
+			ArrayLength: length, Comment: comment,
+		})
+	}
+
+	comment := Comment{Type: CommentPadding}
+	if length >= 8 {
+		comment.setError(StatusWarnPaddingLength, "")
+	}
+	// This region is most likely padding.
+	return singleRegionSection("", SectionPadding, Region{
+		Offset: offset, Length: length, Type: RegionUint8,
+		ArrayLength: length, Comment: comment,
+	})
+}
+
+func (a *annotator) buildHeader(headerOffset uint64) uint64 {
+	offset := headerOffset
+	var regions []Region
+
+	// If this binary is a size prefixed one, attempt to parse the size.
+	if a.sizePrefixed {
+		prefixComment := Comment{Type: CommentSizePrefix}
+
+		hasPrefix := false
+		if prefix, ok := a.readUint64(offset); ok && prefix <= a.length() {
+			regions = append(regions, Region{
+				Offset: offset, Length: 8, Type: RegionUint64, Comment: prefixComment,
+			})
+			offset += 8
+			hasPrefix = true
+		}
+		if !hasPrefix {
+			if prefix, ok := a.readUint32(offset); ok && uint64(prefix) <= a.length() {
+				regions = append(regions, Region{
+					Offset: offset, Length: 4, Type: RegionUint32, Comment: prefixComment,
+				})
+				offset += 4
+				hasPrefix = true
+			}
+		}
+		if !hasPrefix {
+			prefixComment.setError(StatusError, "")
+		}
+	}
+
+	rootOffset, ok := a.readUint32(offset)
+	if !ok {
+		// This shouldn't occur, since we validate the min size of the buffer
+		// before. But we shouldn't read passed the binary end.
+		return ^uint64(0)
+	}
+	rootTableLoc := offset + uint64(rootOffset)
+
+	rootComment := Comment{
+		Type: CommentRootTableOffset,
+		Name: string(a.schema.RootTable(nil).Name()),
+	}
+	if !a.isValidOffset(rootTableLoc) {
+		rootComment.setError(StatusErrorOffsetOutOfBinary, "")
+	}
+	regions = append(regions, Region{
+		Offset: offset, Length: 4, Type: RegionUOffset,
+		PointsToOffset: rootTableLoc, Comment: rootComment,
+	})
+	offset += 4
+
+	if a.isValidRead(offset, fileIdentifierLength) &&
+		isPrintableRegion(offset, fileIdentifierLength, a.binary) {
+		// Assume printable data here is the file identifier. Otherwise, it
+		// will get filled in with padding later.
+		regions = append(regions, Region{
+			Offset: offset, Length: fileIdentifierLength,
+			Type: RegionChar, ArrayLength: fileIdentifierLength,
+			Comment: Comment{Type: CommentFileIdentifier},
+		})
+	}
+
+	a.addSection(headerOffset, Section{Type: SectionHeader, Regions: regions})
+	return rootTableLoc
+}
+
+// getOrBuildVTable returns the vtable at vtableOffset as seen by table,
+// building and recording it if this is the first time. VTables can be shared
+// across instances or even across objects. It returns nil if the vtable is
+// invalid.
+func (a *annotator) getOrBuildVTable(vtableOffset uint64, table *reflection.Object, referringTableOffset uint64) *vtable {
+	tablePos := table.Table().Pos
+	vtables := a.vtables[vtableOffset]
+	for _, vt := range vtables {
+		if vt.referringTable == tablePos {
+			return vt
+		}
+	}
+
+	// If we are trying to make a new vtable and it is already encompassed by
+	// another section, something is corrupted.
+	if len(vtables) == 0 && a.containsSection(vtableOffset) {
+		return nil
+	}
+
+	referringTableName := string(table.Name())
+
+	sizeComment := Comment{Type: CommentVTableSize}
+
+	vtableSize, ok := a.readUint16(vtableOffset)
+	if !ok {
+		remaining := a.remainingBytes(vtableOffset)
+		sizeComment.setError(StatusErrorIncompleteBinary, "2")
+		a.addSection(vtableOffset, singleRegionSection(referringTableName, SectionVTable, Region{
+			Offset: vtableOffset, Length: remaining, Type: RegionUnknown,
+			ArrayLength: remaining, Comment: sizeComment,
+		}))
+		return nil
+	}
+
+	if !a.isValidOffset(vtableOffset + uint64(vtableSize) - 1) {
+		// The vtable size points off the end of the binary.
+		sizeComment.setError(StatusErrorLengthTooLong, "")
+		a.addSection(vtableOffset, singleRegionSection(referringTableName, SectionVTable, Region{
+			Offset: vtableOffset, Length: 2, Type: RegionUint16, Comment: sizeComment,
+		}))
+		return nil
+	} else if vtableSize < 2*flatbuffers.SizeVOffsetT {
+		// The size includes itself and the table size which are both uint16.
+		sizeComment.setError(StatusErrorLengthTooShort, "4")
+		a.addSection(vtableOffset, singleRegionSection(referringTableName, SectionVTable, Region{
+			Offset: vtableOffset, Length: 2, Type: RegionUint16, Comment: sizeComment,
+		}))
+		return nil
+	}
+
+	regions := []Region{{
+		Offset: vtableOffset, Length: 2, Type: RegionUint16, Comment: sizeComment,
+	}}
+	offset := vtableOffset + 2
+
+	tableLenComment := Comment{Type: CommentVTableReferringTableLength}
+
+	// Ensure we can read the size of the referring table.
+	tableSize, ok := a.readUint16(offset)
+	if !ok {
+		remaining := a.remainingBytes(offset)
+		tableLenComment.setError(StatusErrorIncompleteBinary, "2")
+		a.addSection(offset, singleRegionSection(referringTableName, SectionVTable, Region{
+			Offset: offset, Length: remaining, Type: RegionUnknown,
+			ArrayLength: remaining, Comment: tableLenComment,
+		}))
+		return nil
+	}
+
+	if !a.isValidOffset(referringTableOffset + uint64(tableSize) - 1) {
+		tableLenComment.setError(StatusErrorLengthTooLong, "")
+	} else if tableSize < 4 {
+		tableLenComment.setError(StatusErrorLengthTooShort, "4")
+	}
+
+	regions = append(regions, Region{
+		Offset: offset, Length: 2, Type: RegionUint16, Comment: tableLenComment,
+	})
+	offset += 2
+
+	offsetStart := offset
+
+	// Field id to the offset from the start of the table.
+	fields := map[uint16]vtableEntry{}
+
+	// Counts the fields seen, to tell if the binary has more vtable entries
+	// than the schema knows about. This can occur if the binary was created
+	// with a newer schema.
+	var fieldsProcessed uint16
+
+	forAllFields(table, func(field *reflection.Field) {
+		fieldOffset := offsetStart + uint64(field.Id())*2
+
+		if fieldOffset >= vtableOffset+uint64(vtableSize) {
+			// The field is past the end of this vtable, so it comes from a
+			// newer schema than the binary, or the writer didn't write it.
+			return
+		}
+
+		comment := Comment{
+			Type: CommentVTableFieldOffset,
+			Name: string(field.Name()) + "` (id: " + strconv.Itoa(int(field.Id())) + ")",
+		}
+
+		offsetFromTable, ok := a.readUint16(fieldOffset)
+		if !ok {
+			remaining := a.remainingBytes(fieldOffset)
+			comment.setError(StatusErrorIncompleteBinary, "2")
+			regions = append(regions, Region{
+				Offset: fieldOffset, Length: remaining, Type: RegionUnknown,
+				ArrayLength: remaining, Comment: comment,
+			})
+			return
+		}
+
+		if !a.isValidOffset(referringTableOffset + uint64(offsetFromTable) - 1) {
+			comment.setError(StatusErrorOffsetOutOfBinary, "")
+			regions = append(regions, Region{
+				Offset: fieldOffset, Length: 2, Type: RegionVOffset, Comment: comment,
+			})
+			return
+		}
+
+		fields[field.Id()] = vtableEntry{field: field, offsetFromTable: offsetFromTable}
+
+		if offsetFromTable == 0 {
+			// Not present, so could be default or be optional.
+			if field.Required() {
+				comment.setError(StatusErrorRequiredFieldNotPresent, "")
+				regions = append(regions, Region{
+					Offset: fieldOffset, Length: 2, Type: RegionVOffset, Comment: comment,
+				})
+				return
+			}
+			baseType := field.Type(nil).BaseType()
+			if isScalar(baseType) {
+				def := strconv.FormatInt(field.DefaultInteger(), 10)
+				if isFloat(baseType) {
+					def = strconv.FormatFloat(field.DefaultReal(), 'f', 6, 64)
+				}
+				comment.DefaultValue = "<defaults to " + def + "> ("
+			} else {
+				comment.DefaultValue = "<null> ("
+			}
+			comment.DefaultValue += baseType.String() + ")"
+		}
+
+		regions = append(regions, Region{
+			Offset: fieldOffset, Length: 2, Type: RegionVOffset, Comment: comment,
+		})
+		fieldsProcessed++
+	})
+
+	// Add any vtable entries past the known fields as unknown fields.
+	expectedFields := (vtableSize - 4) / 2
+
+	// Prevent a bad binary from declaring a really large vtable size, that we
+	// can not independently verify.
+	if fieldsProcessed*3 < expectedFields {
+		expectedFields = fieldsProcessed * 3
+	}
+
+	for id := fieldsProcessed; id < expectedFields; id++ {
+		fieldOffset := offsetStart + uint64(id)*2
+
+		comment := Comment{Type: CommentVTableUnknownFieldOffset, Index: uint64(id)}
+
+		offsetFromTable, ok := a.readUint16(fieldOffset)
+		if !ok {
+			remaining := a.remainingBytes(fieldOffset)
+			comment.setError(StatusErrorIncompleteBinary, "2")
+			regions = append(regions, Region{
+				Offset: fieldOffset, Length: remaining, Type: RegionUnknown,
+				ArrayLength: remaining, Comment: comment,
+			})
+			continue
+		}
+
+		if _, ok := fields[id]; !ok {
+			fields[id] = vtableEntry{offsetFromTable: offsetFromTable}
+		}
+		regions = append(regions, Region{
+			Offset: fieldOffset, Length: 2, Type: RegionVOffset, Comment: comment,
+		})
+	}
+
+	if len(vtables) == 0 {
+		a.setSection(vtableOffset, Section{
+			Name: referringTableName, Type: SectionVTable, Regions: regions,
+		})
+	} else {
+		// Add the current table name to the name of the section.
+		a.sections[vtableOffset].Name += ", " + referringTableName
+	}
+
+	vt := &vtable{
+		referringTable: tablePos,
+		fields:         fields,
+		tableSize:      tableSize,
+		vtableSize:     vtableSize,
+	}
+	a.vtables[vtableOffset] = append(vtables, vt)
+	return vt
+}
+
+func (a *annotator) buildTable(tableOffset uint64, sectionType SectionType, table *reflection.Object) {
+	if a.containsSection(tableOffset) {
+		return
+	}
+
+	tableName := string(table.Name())
+	vtableComment := Comment{Type: CommentTableVTableOffset}
+
+	vtableSOffset, ok := a.readInt32(tableOffset)
+	if !ok {
+		// There aren't enough bytes left to read the vtable offset, so there
+		// is nothing we can do.
+		remaining := a.remainingBytes(tableOffset)
+		vtableComment.setError(StatusErrorIncompleteBinary, "4")
+		a.addSection(tableOffset, singleRegionSection(tableName, sectionType, Region{
+			Offset: tableOffset, Length: remaining, Type: RegionUnknown,
+			ArrayLength: remaining, Comment: vtableComment,
+		}))
+		return
+	}
+
+	// Tables start with the vtable.
+	vtableOffset := tableOffset - uint64(int64(vtableSOffset))
+
+	if !a.isValidOffset(vtableOffset) {
+		// We can't interpret the rest of the table without its vtable.
+		vtableComment.setError(StatusErrorOffsetOutOfBinary, "")
+		a.addSection(tableOffset, singleRegionSection(tableName, sectionType, Region{
+			Offset: tableOffset, Length: 4, Type: RegionSOffset,
+			PointsToOffset: vtableOffset, Comment: vtableComment,
+		}))
+		return
+	}
+
+	regions := []Region{{
+		Offset: tableOffset, Length: 4, Type: RegionSOffset,
+		PointsToOffset: vtableOffset, Comment: vtableComment,
+	}}
+
+	// Parse the vtable first so we know what the rest of the fields in the
+	// table are.
+	vt := a.getOrBuildVTable(vtableOffset, table, tableOffset)
+	if vt == nil {
+		return
+	}
+
+	tableEndOffset := tableOffset + uint64(vt.tableSize)
+	if !a.isValidOffset(tableEndOffset - 1) {
+		// The table size was already reported in the vtable, but we must not
+		// use a bad value here.
+		tableEndOffset = a.length()
+	}
+
+	// Iterate over the vtable fields by their offset in the binary, not by
+	// their ids.
+	ids := make([]int, 0, len(vt.fields))
+	for id := range vt.fields {
+		ids = append(ids, int(id))
+	}
+	sort.Ints(ids)
+	fields := make([]vtableEntry, 0, len(ids))
+	for _, id := range ids {
+		fields = append(fields, vt.fields[uint16(id)])
+	}
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].offsetFromTable < fields[j].offsetFromTable
+	})
+
+	for i, entry := range fields {
+		field := entry.field
+
+		if entry.offsetFromTable == 0 {
+			// Skip non-present fields.
+			continue
+		}
+
+		// The field offsets are relative to the start of the table.
+		fieldOffset := tableOffset + uint64(entry.offsetFromTable)
+		if !a.isValidOffset(fieldOffset) {
+			continue
+		}
+
+		// A vtable entry for a field the schema doesn't know, so the binary
+		// was generated by a newer schema.
+		if field == nil {
+			// The unknown field runs up to the next field or the table end.
+			var unknownLength uint64
+			if i+1 < len(fields) {
+				unknownLength = tableOffset + uint64(fields[i+1].offsetFromTable) - fieldOffset
+			} else {
+				unknownLength = tableEndOffset - fieldOffset
+			}
+			if unknownLength == 0 {
+				continue
+			}
+
+			hint := ""
+			if unknownLength == 4 {
+				if relative, ok := a.readUint32(fieldOffset); ok {
+					// The field is 4 in length, so it could be an offset.
+					hint = "<possibly an offset? Check Loc: +0x" +
+						toHex(fieldOffset+uint64(relative), 8) + ">"
+				}
+			}
+
+			comment := Comment{Type: CommentTableUnknownField}
+			if !a.isValidRead(fieldOffset, unknownLength) {
+				remaining := a.remainingBytes(fieldOffset)
+				comment.setError(StatusErrorIncompleteBinary, strconv.FormatUint(unknownLength, 10))
+				regions = append(regions, Region{
+					Offset: fieldOffset, Length: remaining, Type: RegionUnknown,
+					ArrayLength: remaining, Comment: comment,
+				})
+				continue
+			}
+			comment.DefaultValue = hint
+			regions = append(regions, Region{
+				Offset: fieldOffset, Length: unknownLength, Type: RegionUnknown,
+				ArrayLength: unknownLength, Comment: comment,
+			})
+			continue
+		}
+
+		fieldType := field.Type(nil)
+		baseType := fieldType.BaseType()
+		fieldName := string(field.Name())
+
+		if isScalar(baseType) {
+			// These are the raw values stored in the table.
+			size := typeSize(baseType)
+			comment := Comment{
+				Type: CommentTableField,
+				Name: fieldName + "` (" + baseType.String() + ")",
+			}
+
+			if !a.isValidRead(fieldOffset, size) {
+				remaining := a.remainingBytes(fieldOffset)
+				comment.setError(StatusErrorIncompleteBinary, strconv.FormatUint(size, 10))
+				regions = append(regions, Region{
+					Offset: fieldOffset, Length: remaining, Type: RegionUnknown,
+					ArrayLength: remaining, Comment: comment,
+				})
+				continue
+			}
+
+			if isUnionField(field) {
+				// This is the type of a union, validate the value.
+				if value, _ := a.readUint8(fieldOffset); !a.isValidUnionField(field, value) {
+					comment.setError(StatusErrorInvalidUnionType, "")
+				}
+			}
+
+			regions = append(regions, Region{
+				Offset: fieldOffset, Length: size, Type: regionType(baseType), Comment: comment,
+			})
+			continue
+		}
+
+		// Read the offset.
+		var offset uint64
+		length := uint64(4)
+		offsetType := RegionUOffset
+		if field.Offset64() {
+			length = 8
+			offsetType = RegionUOffset64
+			offset, _ = a.readUint64(fieldOffset)
+		} else {
+			o, _ := a.readUint32(fieldOffset)
+			offset = uint64(o)
+		}
+
+		var nextItemOffset uint64
+		comment := Comment{Type: CommentTableOffsetField, Name: fieldName}
+
+		// Validate any field that isn't inline (i.e., non-structs).
+		if !a.isInlineField(field) {
+			if offset == 0 {
+				remaining := a.remainingBytes(fieldOffset)
+				comment.setError(StatusErrorIncompleteBinary, "4")
+				regions = append(regions, Region{
+					Offset: fieldOffset, Length: remaining, Type: RegionUnknown,
+					ArrayLength: remaining, Comment: comment,
+				})
+				continue
+			}
+
+			nextItemOffset = fieldOffset + offset
+			if !a.isValidOffset(nextItemOffset) {
+				comment.setError(StatusErrorOffsetOutOfBinary, "")
+				regions = append(regions, Region{
+					Offset: fieldOffset, Length: length, Type: offsetType,
+					PointsToOffset: nextItemOffset, Comment: comment,
+				})
+				continue
+			}
+		}
+
+		switch baseType {
+		case reflection.BaseTypeObj:
+			next := a.object(fieldType.Index())
+			if next.IsStruct() {
+				// Structs are stored inline.
+				a.buildStruct(fieldOffset, &regions, fieldName, next)
+			} else {
+				comment.DefaultValue = "(table)"
+				regions = append(regions, Region{
+					Offset: fieldOffset, Length: length, Type: offsetType,
+					PointsToOffset: nextItemOffset, Comment: comment,
+				})
+				a.buildTable(nextItemOffset, SectionTable, next)
+			}
+
+		case reflection.BaseTypeString:
+			comment.DefaultValue = "(string)"
+			regions = append(regions, Region{
+				Offset: fieldOffset, Length: length, Type: offsetType,
+				PointsToOffset: nextItemOffset, Comment: comment,
+			})
+			a.buildString(nextItemOffset, table, field)
+
+		case reflection.BaseTypeVector, reflection.BaseTypeVector64:
+			comment.DefaultValue = "(vector)"
+			if baseType == reflection.BaseTypeVector64 {
+				comment.DefaultValue = "(vector64)"
+			}
+			regions = append(regions, Region{
+				Offset: fieldOffset, Length: length, Type: offsetType,
+				PointsToOffset: nextItemOffset, Comment: comment,
+			})
+			a.buildVector(nextItemOffset, table, field, tableOffset, vt.fields)
+
+		case reflection.BaseTypeUnion:
+			unionOffset := nextItemOffset
+
+			// The union type field is always one less than the union itself.
+			typeEntry, ok := vt.fields[field.Id()-1]
+			if !ok {
+				break
+			}
+			comment.DefaultValue = "(union)"
+
+			typeOffset := tableOffset + uint64(typeEntry.offsetFromTable)
+			realizedType, ok := a.readUint8(typeOffset)
+			if !ok {
+				remaining := a.remainingBytes(typeOffset)
+				comment.setError(StatusErrorIncompleteBinary, "1")
+				regions = append(regions, Region{
+					Offset: typeOffset, Length: remaining, Type: RegionUnknown,
+					ArrayLength: remaining, Comment: comment,
+				})
+				continue
+			}
+
+			if !a.isValidUnionField(field, realizedType) {
+				// The error is reported on the union type field, so the
+				// union itself becomes an unreferenced section.
+				continue
+			}
+
+			enumType := a.buildUnion(unionOffset, realizedType, field)
+			comment.DefaultValue = "(union of type `" + enumType + "`)"
+			regions = append(regions, Region{
+				Offset: fieldOffset, Length: length, Type: offsetType,
+				PointsToOffset: unionOffset, Comment: comment,
+			})
+		}
+	}
+
+	// Handle any padding after the last known region, towards the expected
+	// end of the table.
+	last := regions[len(regions)-1]
+	if i := last.Offset + last.Length + 1; i < tableEndOffset {
+		padBytes := tableEndOffset - i + 1
+		regions = append(regions, Region{
+			Offset: i - 1, Length: padBytes, Type: RegionUint8,
+			ArrayLength: padBytes, Comment: Comment{Type: CommentPadding},
+		})
+	}
+
+	a.addSection(tableOffset, Section{Name: tableName, Type: sectionType, Regions: regions})
+}
+
+// buildStruct appends the regions of the struct at structOffset and returns
+// the offset just past it.
+func (a *annotator) buildStruct(structOffset uint64, regions *[]Region, referringFieldName string, object *reflection.Object) uint64 {
+	if !object.IsStruct() {
+		return structOffset
+	}
+	offset := structOffset
+	objectName := string(object.Name())
+
+	forAllFields(object, func(field *reflection.Field) {
+		fieldType := field.Type(nil)
+		baseType := fieldType.BaseType()
+		fieldName := referringFieldName + "." + string(field.Name())
+
+		switch {
+		case isScalar(baseType):
+			size := typeSize(baseType)
+			comment := Comment{
+				Type:         CommentStructField,
+				Name:         fieldName,
+				DefaultValue: "of '" + objectName + "' (" + baseType.String() + ")",
+			}
+
+			if !a.isValidRead(offset, size) {
+				remaining := a.remainingBytes(offset)
+				comment.setError(StatusErrorIncompleteBinary, strconv.FormatUint(size, 10))
+				*regions = append(*regions, Region{
+					Offset: offset, Length: remaining, Type: RegionUnknown,
+					ArrayLength: remaining, Comment: comment,
+				})
+				// This moves offset to the end of the binary, so all other
+				// reads fail too.
+				offset += remaining
+				return
+			}
+
+			*regions = append(*regions, Region{
+				Offset: offset, Length: size, Type: regionType(baseType), Comment: comment,
+			})
+			offset += size
+
+		case baseType == reflection.BaseTypeObj:
+			// Structs are stored inline, even when nested.
+			offset = a.buildStruct(offset, regions, fieldName, a.object(fieldType.Index()))
+
+		case baseType == reflection.BaseTypeArray:
+			element := fieldType.Element()
+			size := typeSize(element)
+
+			// Arrays are just repeated structures.
+			for i := 0; i < int(fieldType.FixedLength()); i++ {
+				if !isScalar(element) {
+					// Array of structs.
+					offset = a.buildStruct(offset, regions, fieldName, a.object(fieldType.Index()))
+					continue
+				}
+
+				comment := Comment{
+					Type:         CommentArrayField,
+					Name:         fieldName,
+					Index:        uint64(i),
+					DefaultValue: "of '" + objectName + "' (" + element.String() + ")",
+				}
+
+				if !a.isValidRead(offset, size) {
+					remaining := a.remainingBytes(offset)
+					comment.setError(StatusErrorIncompleteBinary, strconv.FormatUint(size, 10))
+					*regions = append(*regions, Region{
+						Offset: offset, Length: remaining, Type: RegionUnknown,
+						ArrayLength: remaining, Comment: comment,
+					})
+					offset += remaining
+					break
+				}
+
+				*regions = append(*regions, Region{
+					Offset: offset, Length: size, Type: regionType(element), Comment: comment,
+				})
+				offset += size
+			}
+		}
+
+		// Insert any padding after this field.
+		if padding := uint64(field.Padding()); padding > 0 && a.isValidOffset(offset+padding) {
+			*regions = append(*regions, Region{
+				Offset: offset, Length: padding, Type: RegionUint8,
+				ArrayLength: padding, Comment: Comment{Type: CommentPadding},
+			})
+			offset += padding
+		}
+	})
+
+	return offset
+}
+
+func (a *annotator) buildString(stringOffset uint64, table *reflection.Object, field *reflection.Field) {
+	// Shared strings are only generated once.
+	if a.containsSection(stringOffset) {
+		return
+	}
+
+	var regions []Region
+	lengthComment := Comment{Type: CommentStringLength}
+
+	if size, ok := a.readUint32(stringOffset); !ok {
+		remaining := a.remainingBytes(stringOffset)
+		lengthComment.setError(StatusErrorIncompleteBinary, "4")
+		regions = append(regions, Region{
+			Offset: stringOffset, Length: remaining, Type: RegionUnknown,
+			ArrayLength: remaining, Comment: lengthComment,
+		})
+	} else if stringEnd := stringOffset + 4 + uint64(size) + 1; !a.isValidOffset(stringEnd - 1) {
+		lengthComment.setError(StatusErrorLengthTooLong, "")
+		regions = append(regions, Region{
+			Offset: stringOffset, Length: 4, Type: RegionUint32, Comment: lengthComment,
+		})
+	} else {
+		regions = append(regions,
+			Region{
+				Offset: stringOffset, Length: 4, Type: RegionUint32, Comment: lengthComment,
+			},
+			Region{
+				Offset: stringOffset + 4, Length: uint64(size), Type: RegionChar,
+				ArrayLength: uint64(size), Comment: Comment{Type: CommentStringValue},
+			},
+			Region{
+				Offset: stringOffset + 4 + uint64(size), Length: 1, Type: RegionChar,
+				Comment: Comment{Type: CommentStringTerminator},
+			})
+	}
+
+	a.addSection(stringOffset, Section{
+		Name:    string(table.Name()) + "." + string(field.Name()),
+		Type:    SectionString,
+		Regions: regions,
+	})
+}
+
+func (a *annotator) buildVector(vectorOffset uint64, table *reflection.Object, field *reflection.Field, parentTableOffset uint64, vtableFields map[uint16]vtableEntry) {
+	if a.containsSection(vectorOffset) {
+		return
+	}
+
+	name := string(table.Name()) + "." + string(field.Name())
+	fieldType := field.Type(nil)
+	lengthComment := Comment{Type: CommentVectorLength}
+
+	var vectorLength uint64
+	var ok bool
+	lengthSize := uint64(4)
+	lengthType := RegionUint32
+	sectionType := SectionVector
+	if fieldType.BaseType() == reflection.BaseTypeVector64 {
+		vectorLength, ok = a.readUint64(vectorOffset)
+		lengthSize = 8
+		lengthType = RegionUint64
+		sectionType = SectionVector64
+	} else {
+		var l uint32
+		l, ok = a.readUint32(vectorOffset)
+		vectorLength = uint64(l)
+	}
+
+	if !ok {
+		remaining := a.remainingBytes(vectorOffset)
+		lengthComment.setError(StatusErrorIncompleteBinary, "4")
+		a.addSection(vectorOffset, singleRegionSection(name, SectionVector, Region{
+			Offset: vectorOffset, Length: remaining, Type: RegionUnknown,
+			ArrayLength: remaining, Comment: lengthComment,
+		}))
+		return
+	}
+
+	// Validate there are enough bytes left in the binary to process all the
+	// items.
+	lastItemOffset := vectorOffset + lengthSize + vectorLength*a.elementSize(field)
+	if !a.isValidOffset(lastItemOffset - 1) {
+		lengthComment.setError(StatusErrorLengthTooLong, "")
+		a.addSection(vectorOffset, singleRegionSection(name, SectionVector, Region{
+			Offset: vectorOffset, Length: lengthSize, Type: lengthType, Comment: lengthComment,
+		}))
+		return
+	}
+
+	regions := []Region{{
+		Offset: vectorOffset, Length: lengthSize, Type: lengthType, Comment: lengthComment,
+	}}
+	offset := vectorOffset + lengthSize
+
+	switch fieldType.Element() {
+	case reflection.BaseTypeObj:
+		object := a.object(fieldType.Index())
+
+		if object.IsStruct() {
+			// Structs are inline to the vector.
+			for i := uint64(0); i < vectorLength; i++ {
+				next := a.buildStruct(offset, &regions, "["+strconv.FormatUint(i, 10)+"]", object)
+				if next == offset {
+					break
+				}
+				offset = next
+			}
+			break
+		}
+
+		for i := uint64(0); i < vectorLength; i++ {
+			comment := Comment{Type: CommentVectorTableValue, Index: i}
+
+			relative, ok := a.readUint32(offset)
+			if !ok {
+				remaining := a.remainingBytes(offset)
+				comment.setError(StatusErrorIncompleteBinary, "4")
+				regions = append(regions, Region{
+					Offset: offset, Length: remaining, Type: RegionUnknown,
+					ArrayLength: remaining, Comment: comment,
+				})
+				break
+			}
+
+			// The table offset is relative from the offset location itself.
+			tableOffset := offset + uint64(relative)
+
+			if !a.isValidOffset(tableOffset) {
+				comment.setError(StatusErrorOffsetOutOfBinary, "")
+			} else if tableOffset == parentTableOffset {
+				// A table vector field pointing to its own table, which only
+				// happens in corrupted files.
+				comment.setError(StatusErrorCycleDetected, "")
+			}
+			regions = append(regions, Region{
+				Offset: offset, Length: 4, Type: RegionUOffset,
+				PointsToOffset: tableOffset, Comment: comment,
+			})
+			offset += 4
+
+			if comment.Status == StatusOK {
+				a.buildTable(tableOffset, SectionTable, object)
+			}
+		}
+
+	case reflection.BaseTypeString:
+		for i := uint64(0); i < vectorLength; i++ {
+			comment := Comment{Type: CommentVectorStringValue, Index: i}
+
+			relative, ok := a.readUint32(offset)
+			if !ok {
+				remaining := a.remainingBytes(offset)
+				comment.setError(StatusErrorIncompleteBinary, "4")
+				regions = append(regions, Region{
+					Offset: offset, Length: remaining, Type: RegionUnknown,
+					ArrayLength: remaining, Comment: comment,
+				})
+				break
+			}
+
+			// The string offset is relative from the offset location itself.
+			stringOffset := offset + uint64(relative)
+
+			valid := a.isValidOffset(stringOffset)
+			if !valid {
+				comment.setError(StatusErrorOffsetOutOfBinary, "")
+			}
+			regions = append(regions, Region{
+				Offset: offset, Length: 4, Type: RegionUOffset,
+				PointsToOffset: stringOffset, Comment: comment,
+			})
+			if valid {
+				a.buildString(stringOffset, table, field)
+			}
+			offset += 4
+		}
+
+	case reflection.BaseTypeUnion:
+		// The realized types of a union vector are stored in a separate
+		// vector, in the field with the previous id.
+		typeEntry, ok := vtableFields[field.Id()-1]
+		if !ok {
+			break
+		}
+
+		typeVectorFieldOffset := parentTableOffset + uint64(typeEntry.offsetFromTable)
+
+		typeVectorRelative, ok := a.readUint16(typeVectorFieldOffset)
+		if !ok {
+			remaining := a.remainingBytes(offset)
+			comment := Comment{Type: CommentVectorUnionValue}
+			comment.setError(StatusErrorIncompleteBinary, "2")
+			regions = append(regions, Region{
+				Offset: offset, Length: remaining, Type: RegionUnknown,
+				ArrayLength: remaining, Comment: comment,
+			})
+			break
+		}
+
+		// Skip over the length of the type vector, we already know it.
+		typeVectorDataOffset := typeVectorFieldOffset + uint64(typeVectorRelative) + 4
+
+		for i := uint64(0); i < vectorLength; i++ {
+			comment := Comment{Type: CommentVectorUnionValue, Index: i}
+
+			relative, ok := a.readUint32(offset)
+			if !ok {
+				remaining := a.remainingBytes(offset)
+				comment.setError(StatusErrorIncompleteBinary, "4")
+				regions = append(regions, Region{
+					Offset: offset, Length: remaining, Type: RegionUnknown,
+					ArrayLength: remaining, Comment: comment,
+				})
+				break
+			}
+
+			// The union offset is relative from the offset location itself.
+			unionOffset := offset + uint64(relative)
+
+			if !a.isValidOffset(unionOffset) {
+				comment.setError(StatusErrorOffsetOutOfBinary, "")
+				regions = append(regions, Region{
+					Offset: offset, Length: 4, Type: RegionUOffset,
+					PointsToOffset: unionOffset, Comment: comment,
+				})
+				continue
+			}
+
+			realizedType, ok := a.readUint8(typeVectorDataOffset + i)
+			if !ok {
+				comment.setError(StatusErrorIncompleteBinary, "1")
+				regions = append(regions, Region{
+					Offset: offset, Type: RegionUnknown, Comment: comment,
+				})
+				continue
+			}
+
+			if !a.isValidUnionValue(typeEntry.field.Type(nil).Index(), realizedType) {
+				// The error is reported on the union type vector, so the
+				// union itself becomes an unreferenced section.
+				offset += 4
+				continue
+			}
+
+			enumType := a.buildUnion(unionOffset, realizedType, field)
+			comment.DefaultValue = "(`" + enumType + "`)"
+			regions = append(regions, Region{
+				Offset: offset, Length: 4, Type: RegionUOffset,
+				PointsToOffset: unionOffset, Comment: comment,
+			})
+			offset += 4
+		}
+
+	default:
+		element := fieldType.Element()
+		if !isScalar(element) {
+			break
+		}
+		size := typeSize(element)
+		elementType := regionType(element)
+
+		for i := uint64(0); i < vectorLength; i++ {
+			comment := Comment{Type: CommentVectorValue, Index: i}
+
+			if !a.isValidRead(offset, size) {
+				remaining := a.remainingBytes(offset)
+				comment.setError(StatusErrorIncompleteBinary, strconv.FormatUint(size, 10))
+				regions = append(regions, Region{
+					Offset: offset, Length: remaining, Type: RegionUnknown,
+					ArrayLength: remaining, Comment: comment,
+				})
+				break
+			}
+
+			if isUnionType(element) {
+				// This is a type for a union, validate the value.
+				if value, _ := a.readUint8(offset); !a.isValidUnionValue(fieldType.Index(), value) {
+					comment.setError(StatusErrorInvalidUnionType, "")
+				}
+			}
+
+			regions = append(regions, Region{
+				Offset: offset, Length: size, Type: elementType, Comment: comment,
+			})
+			offset += size
+		}
+	}
+
+	a.addSection(vectorOffset, Section{Name: name, Type: sectionType, Regions: regions})
+}
+
+// buildUnion builds the value of a union and returns the name of its type.
+func (a *annotator) buildUnion(unionOffset uint64, realizedType uint8, field *reflection.Field) string {
+	enum := new(reflection.Enum)
+	a.schema.Enums(enum, int(field.Type(nil).Index()))
+	enumVal := new(reflection.EnumVal)
+	enum.Values(enumVal, int(realizedType))
+	enumName := string(enumVal.Name())
+
+	if a.containsSection(unionOffset) {
+		return enumName
+	}
+
+	unionType := enumVal.UnionType(nil)
+	if unionType != nil && unionType.BaseType() == reflection.BaseTypeObj {
+		object := a.object(unionType.Index())
+
+		if object.IsStruct() {
+			var regions []Region
+			a.buildStruct(unionOffset, &regions, string(field.Name()), object)
+			a.addSection(unionOffset, Section{
+				Name:    string(object.Name()) + "." + string(field.Name()),
+				Type:    SectionUnion,
+				Regions: regions,
+			})
+		} else {
+			a.buildTable(unionOffset, SectionTable, object)
+		}
+	}
+	// TODO: handle the other union types.
+
+	return enumName
+}
+
+// fixMissingRegions fills the gaps between the regions of each section.
+func (a *annotator) fixMissingRegions() {
+	for _, sectionOffset := range a.offsets {
+		section := a.sections[sectionOffset]
+		if len(section.Regions) == 0 {
+			continue
+		}
+
+		var missing []Region
+		offset := section.Regions[0].Offset + section.Regions[0].Length
+		for _, region := range section.Regions[1:] {
+			nextOffset := region.Offset
+			if !a.isValidOffset(nextOffset) {
+				continue
+			}
+
+			if offset < nextOffset {
+				padBytes := nextOffset - offset
+				comment := Comment{Type: CommentPadding}
+				padType := RegionUint8
+				if isNonZeroRegion(offset, padBytes, a.binary) {
+					comment.setError(StatusWarnNoReferences, "")
+					padType = RegionUnknown
+				}
+				missing = append(missing, Region{
+					Offset: offset, Length: padBytes, Type: padType,
+					ArrayLength: padBytes, Comment: comment,
+				})
+			}
+			offset = nextOffset + region.Length
+		}
+
+		if len(missing) > 0 {
+			section.Regions = append(section.Regions, missing...)
+			sort.SliceStable(section.Regions, func(i, j int) bool {
+				return section.Regions[i].Offset < section.Regions[j].Offset
+			})
+		}
+	}
+}
+
+// fixMissingSections adds padding or unknown sections for the bytes that no
+// section covers.
+func (a *annotator) fixMissingSections() {
+	var offset uint64
+	var missing []Section
+
+	for _, sectionOffset := range a.offsets {
+		section := a.sections[sectionOffset]
+		if len(section.Regions) == 0 {
+			continue
+		}
+		last := section.Regions[len(section.Regions)-1]
+
+		if offset < sectionOffset {
+			// We are at an offset that is less then the current section.
+			padBytes := sectionOffset - offset + 1
+			missing = append(missing, generateMissingSection(offset-1, padBytes, a.binary))
+		}
+		offset = last.Offset + last.Length + 1
+	}
+
+	// Handle any bytes left at the end of the binary.
+	if offset < a.length() {
+		padBytes := a.length() - offset + 1
+		missing = append(missing, generateMissingSection(offset-1, padBytes, a.binary))
+	}
+
+	for _, section := range missing {
+		a.addSection(section.Regions[0].Offset, section)
+	}
+}
@@ -0,0 +1,392 @@
+package annotator
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// Options controls the text written by WriteText.
+type Options struct {
+	// MaxBytesPerLine is the number of raw bytes printed per line. Zero
+	// means 8, which fits the largest scalar (double) on one line.
+	MaxBytesPerLine int
+
+	// SparseVectors only prints the first and last element of vectors with
+	// more than three elements.
+	SparseVectors bool
+
+	// SchemaFile and BinaryFile are named in the file header. The header is
+	// left out when both are empty.
+	SchemaFile string
+	BinaryFile string
+}
+
+type outputConfig struct {
+	largestTypeString  int
+	largestValueString int
+	maxBytesPerLine    int
+	offsetMaxChar      int
+	sparseVectors      bool
+}
+
+const delimiter = "|"
+
+// WriteText writes sections, as returned by Annotate for buf, in the
+// annotated FlatBuffer binary (.afb) text format used by `flatc --annotate`.
+func WriteText(w io.Writer, sections []Section, buf []byte, opts Options) error {
+	config := outputConfig{
+		largestValueString: 20,
+		maxBytesPerLine:    opts.MaxBytesPerLine,
+		sparseVectors:      opts.SparseVectors,
+	}
+	if config.maxBytesPerLine <= 0 {
+		config.maxBytesPerLine = 8
+	}
+
+	// The length of the binary gives the number of hex characters needed to
+	// display any offset.
+	switch n := len(buf); {
+	case n > 0xFFFFFF:
+		config.offsetMaxChar = 8
+	case n > 0xFFFF:
+		config.offsetMaxChar = 6
+	case n > 0xFF:
+		config.offsetMaxChar = 4
+	default:
+		config.offsetMaxChar = 2
+	}
+
+	// Find the largest type and value strings, so the columns line up.
+	for _, section := range sections {
+		for _, region := range section.Regions {
+			if s := typeString(region); len(s) > config.largestTypeString {
+				config.largestTypeString = len(s)
+			}
+			// Array values are split over multiple lines.
+			if region.ArrayLength == 0 {
+				if s := valueString(region, buf, &config); len(s) > config.largestValueString {
+					config.largestValueString = len(s)
+				}
+			}
+		}
+	}
+
+	var sb strings.Builder
+	if opts.SchemaFile != "" || opts.BinaryFile != "" {
+		sb.WriteString("// Annotated Flatbuffer Binary\n")
+		sb.WriteString("//\n")
+		sb.WriteString("// Schema file: " + opts.SchemaFile + "\n")
+		sb.WriteString("// Binary file: " + opts.BinaryFile + "\n")
+	}
+	for i := range sections {
+		writeSection(&sb, &sections[i], buf, &config)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// Text annotates buf with the binary schema bfbs and returns the result in
+// the .afb text format, without a file header. It is meant for logging a
+// buffer that failed to parse or verify.
+func Text(bfbs, buf []byte, sizePrefixed bool) (string, error) {
+	sections, err := Annotate(bfbs, buf, sizePrefixed)
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	if err := WriteText(&sb, sections, buf, Options{}); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// toHex formats v as upper case hex, zero padded to width characters.
+func toHex(v uint64, width int) string {
+	s := strings.ToUpper(strconv.FormatUint(v, 16))
+	if len(s) < width {
+		s = strings.Repeat("0", width-len(s)) + s
+	}
+	return s
+}
+
+// padRight pads s with spaces to width characters.
+func padRight(s string, width int) string {
+	if len(s) < width {
+		return s + strings.Repeat(" ", width-len(s))
+	}
+	return s
+}
+
+// formatFloat formats v like a C++ stream with default settings.
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', 6, 64)
+}
+
+func scalarValueString(region Region, buf []byte) string {
+	if region.Type == RegionUnknown {
+		return ""
+	}
+	b := buf[region.Offset:]
+	var value string
+	switch region.Type {
+	case RegionUint32, RegionUOffset:
+		value = strconv.FormatUint(uint64(flatbuffers.GetUint32(b)), 10)
+	case RegionInt32, RegionSOffset:
+		value = strconv.FormatInt(int64(flatbuffers.GetInt32(b)), 10)
+	case RegionUint16, RegionVOffset:
+		value = strconv.FormatUint(uint64(flatbuffers.GetUint16(b)), 10)
+	case RegionInt16:
+		value = strconv.FormatInt(int64(flatbuffers.GetInt16(b)), 10)
+	case RegionBool:
+		value = "0"
+		if flatbuffers.GetBool(b) {
+			value = "1"
+		}
+	case RegionUint8, RegionUType:
+		value = strconv.FormatUint(uint64(flatbuffers.GetUint8(b)), 10)
+	case RegionChar, RegionByte, RegionInt8:
+		value = strconv.FormatInt(int64(flatbuffers.GetInt8(b)), 10)
+	case RegionInt64:
+		value = strconv.FormatInt(flatbuffers.GetInt64(b), 10)
+	case RegionUint64, RegionUOffset64:
+		value = strconv.FormatUint(flatbuffers.GetUint64(b), 10)
+	case RegionDouble:
+		value = formatFloat(flatbuffers.GetFloat64(b))
+	case RegionFloat:
+		value = formatFloat(float64(flatbuffers.GetFloat32(b)))
+	default:
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("0x")
+	for i := int(region.Length) - 1; i >= 0; i-- {
+		sb.WriteString(toHex(uint64(b[i]), 2))
+	}
+	sb.WriteString(" (" + value + ")")
+	return sb.String()
+}
+
+func valueString(region Region, buf []byte, config *outputConfig) string {
+	if region.ArrayLength > 0 {
+		data := buf[region.Offset : region.Offset+region.ArrayLength]
+		switch region.Type {
+		case RegionUint8, RegionUnknown:
+			// Interpret each value as ASCII to aid debugging.
+			b := make([]byte, len(data))
+			for i, c := range data {
+				b[i] = '.'
+				if isPrint(c) {
+					b[i] = c
+				}
+			}
+			return string(b)
+		case RegionChar:
+			return string(data)
+		}
+	}
+
+	s := scalarValueString(region, buf)
+	// Include the location an offset points to.
+	if region.Type.IsOffset() {
+		s += " Loc: 0x" + toHex(region.PointsToOffset, config.offsetMaxChar)
+	}
+	return s
+}
+
+func typeString(region Region) string {
+	s := region.Type.String()
+	if region.ArrayLength > 0 {
+		s += "[" + strconv.FormatUint(region.ArrayLength, 10) + "]"
+	}
+	return s
+}
+
+func commentString(comment Comment) string {
+	var s string
+	switch comment.Type {
+	case CommentUnknown:
+		s = "unknown"
+	case CommentSizePrefix:
+		s = "size prefix"
+	case CommentRootTableOffset:
+		s = "offset to root table `" + comment.Name + "`"
+	case CommentFileIdentifier:
+		s = "File Identifier"
+	case CommentPadding:
+		s = "padding"
+	case CommentVTableSize:
+		s = "size of this vtable"
+	case CommentVTableReferringTableLength:
+		s = "size of referring table"
+	case CommentVTableFieldOffset:
+		s = "offset to field `" + comment.Name
+	case CommentVTableUnknownFieldOffset:
+		s = "offset to unknown field (id: " + strconv.FormatUint(comment.Index, 10) + ")"
+	case CommentTableVTableOffset:
+		s = "offset to vtable"
+	case CommentTableField:
+		s = "table field `" + comment.Name
+	case CommentTableUnknownField:
+		s = "unknown field"
+	case CommentTableOffsetField:
+		s = "offset to field `" + comment.Name + "`"
+	case CommentStructField:
+		s = "struct field `" + comment.Name + "`"
+	case CommentArrayField:
+		s = "array field `" + comment.Name + "`[" + strconv.FormatUint(comment.Index, 10) + "]"
+	case CommentStringLength:
+		s = "length of string"
+	case CommentStringValue:
+		s = "string literal"
+	case CommentStringTerminator:
+		s = "string terminator"
+	case CommentVectorLength:
+		s = "length of vector (# items)"
+	case CommentVectorValue:
+		s = "value[" + strconv.FormatUint(comment.Index, 10) + "]"
+	case CommentVectorTableValue:
+		s = "offset to table[" + strconv.FormatUint(comment.Index, 10) + "]"
+	case CommentVectorStringValue:
+		s = "offset to string[" + strconv.FormatUint(comment.Index, 10) + "]"
+	case CommentVectorUnionValue:
+		s = "offset to union[" + strconv.FormatUint(comment.Index, 10) + "]"
+	}
+	if comment.DefaultValue != "" {
+		s += " " + comment.DefaultValue
+	}
+
+	switch comment.Status {
+	case StatusWarn:
+		s = "WARN: " + s
+	case StatusWarnNoReferences:
+		s = "WARN: nothing refers to this section."
+	case StatusWarnCorruptedPadding:
+		s = "WARN: could be corrupted padding region."
+	case StatusWarnPaddingLength:
+		s = "WARN: padding is longer than expected."
+	case StatusError:
+		s = "ERROR: " + s
+	case StatusErrorOffsetOutOfBinary:
+		s = "ERROR: " + s + ". Invalid offset, points outside the binary."
+	case StatusErrorIncompleteBinary:
+		s = "ERROR: " + s + ". Incomplete binary, expected to read " +
+			comment.StatusMessage + " bytes."
+	case StatusErrorLengthTooLong:
+		s = "ERROR: " + s + ". Longer than the binary."
+	case StatusErrorLengthTooShort:
+		s = "ERROR: " + s + ". Shorter than the minimum length: "
+	case StatusErrorRequiredFieldNotPresent:
+		s = "ERROR: " + s + ". Required field is not present."
+	case StatusErrorInvalidUnionType:
+		s = "ERROR: " + s + ". Invalid union type value."
+	case StatusErrorCycleDetected:
+		s = "ERROR: " + s + ". Invalid offset, cycle detected."
+	}
+	return s
+}
+
+// docContinuation holds the part of an array value that didn't fit on the
+// first line of its region.
+type docContinuation struct {
+	// The column where the value text first starts.
+	valueStartColumn int
+	// The remaining part of the value to print.
+	value string
+}
+
+func writeDocumentation(sb *strings.Builder, region Region, buf []byte, cont *docContinuation, config *outputConfig) {
+	chunk := func(s string) (string, string) {
+		if len(s) > config.maxBytesPerLine {
+			return s[:config.maxBytesPerLine], s[config.maxBytesPerLine:]
+		}
+		return s, ""
+	}
+
+	// A pending continuation takes priority.
+	if cont.valueStartColumn > 0 {
+		sb.WriteString(strings.Repeat(" ", cont.valueStartColumn-2))
+		sb.WriteString(delimiter + " ")
+		var line string
+		line, cont.value = chunk(cont.value)
+		sb.WriteString(line)
+		return
+	}
+
+	typeStr := padRight(typeString(region), config.largestTypeString)
+	sb.WriteString(typeStr)
+	sb.WriteString(" " + delimiter + " ")
+	if region.ArrayLength > 0 {
+		// Record where the value is first printed, and chunk the value over
+		// the following lines.
+		cont.valueStartColumn = 3 + len(typeStr)
+		var line string
+		line, cont.value = chunk(valueString(region, buf, config))
+		sb.WriteString(padRight(line, config.largestValueString))
+	} else {
+		sb.WriteString(padRight(valueString(region, buf, config), config.largestValueString))
+	}
+	sb.WriteString(" " + delimiter + " ")
+	sb.WriteString(commentString(region.Comment))
+}
+
+func writeRegion(sb *strings.Builder, region Region, buf []byte, config *outputConfig) {
+	perLine := uint64(config.maxBytesPerLine)
+	docGenerated := false
+	var cont docContinuation
+	for i := uint64(0); i < region.Length; i++ {
+		if i%perLine == 0 {
+			// Start a new line of output.
+			sb.WriteString("\n  +0x" + toHex(region.Offset+i, config.offsetMaxChar))
+			sb.WriteString(" " + delimiter)
+		}
+
+		sb.WriteString(" " + toHex(uint64(buf[region.Offset+i]), 2))
+
+		if (i+1)%perLine == 0 || i+1 == region.Length {
+			if i+1 == region.Length {
+				// Align the last line with the full ones.
+				for j := i + 1; j%perLine != 0; j++ {
+					sb.WriteString("   ")
+				}
+			}
+			sb.WriteString(" " + delimiter)
+			if !docGenerated {
+				sb.WriteString(" ")
+				writeDocumentation(sb, region, buf, &cont, config)
+				// A remaining continuation value goes on the next line.
+				docGenerated = cont.value == ""
+			}
+		}
+	}
+}
+
+func writeSection(sb *strings.Builder, section *Section, buf []byte, config *outputConfig) {
+	sb.WriteString("\n" + section.Type.String())
+	if section.Name != "" {
+		sb.WriteString(" (" + section.Name + ")")
+	}
+	sb.WriteString(":")
+
+	regions := section.Regions
+
+	// Only print the length, first and last elements of long vectors.
+	if (section.Type == SectionVector || section.Type == SectionVector64) &&
+		config.sparseVectors && len(regions) > 4 {
+		writeRegion(sb, regions[0], buf, config)
+		writeRegion(sb, regions[1], buf, config)
+		sb.WriteString("\n  <" + strconv.Itoa(len(regions)-3) + " regions omitted>")
+		writeRegion(sb, regions[len(regions)-1], buf, config)
+		sb.WriteString("\n")
+		return
+	}
+
+	for _, region := range regions {
+		writeRegion(sb, region, buf, config)
+	}
+	sb.WriteString("\n")
+}
@@ -1,6 +1,18 @@
 package flatbuffers
 
-import "sort"
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrRequiredField is wrapped by the error that Builder.Err returns when a
+// table was ended without one of its required fields.
+var ErrRequiredField = errors.New("flatbuffers: required field is missing")
+
+// ErrDuplicateKey is wrapped by the error that Builder.Err returns when
+// CreateVectorOfUniqueSortedTables was given two tables with the same key.
+var ErrDuplicateKey = errors.New("flatbuffers: duplicate key")
 
 // Builder is a state machine for creating FlatBuffer objects.
 // Use a Builder to construct object(s) starting from leaf nodes.
@@ -19,8 +31,12 @@ type Builder struct {
 	head      UOffsetT
 	nested    bool
 	finished  bool
+	err       error
+	resets    uint32 // the number of calls to Reset, to tell Marks apart
 
 	sharedStrings map[string]UOffsetT
+	keyedTables   []keyedTable   // reused by CreateVectorOfSortedTablesByKey
+	offsets       *offsetChecker // nil unless checked, see NewCheckedBuilder
 }
 
 const fileIdentifierLength = 4
@@ -38,6 +54,9 @@ func NewBuilder(initialSize int) *Builder {
 	b.head = UOffsetT(initialSize)
 	b.minalign = 1
 	b.vtables = make([]UOffsetT, 0, 16) // sensible default capacity
+	if checkedByDefault {
+		b.offsets = newOffsetChecker()
+	}
 	return b
 }
 
@@ -66,6 +85,85 @@ func (b *Builder) Reset() {
 	b.minalign = 1
 	b.nested = false
 	b.finished = false
+	b.err = nil
+	b.resets++
+	if b.offsets != nil {
+		b.offsets.reset()
+	}
+}
+
+// Err returns the first error recorded while building, such as a table ended
+// without one of its required fields, or nil. The Builder keeps working after
+// an error, so it can be checked once the buffer is finished. Reset clears it.
+func (b *Builder) Err() error {
+	return b.err
+}
+
+// Mark is a checkpoint of a Builder, taken by Mark and restored by Rollback.
+type Mark struct {
+	resets   uint32
+	offset   UOffsetT
+	vtables  int
+	minalign int
+	finished bool
+	err      error
+}
+
+// Mark returns a checkpoint of the Builder, to which Rollback can return to
+// abandon what was built since, such as a child table that failed
+// validation. It must not be called while an object or vector is being
+// built.
+func (b *Builder) Mark() Mark {
+	b.assertNotNested()
+	return Mark{
+		resets:   b.resets,
+		offset:   b.Offset(),
+		vtables:  len(b.vtables),
+		minalign: b.minalign,
+		finished: b.finished,
+		err:      b.err,
+	}
+}
+
+// Rollback returns the Builder to the checkpoint m, which it took with Mark
+// since it was last Reset. Everything built since, including an object or
+// vector still being built, is discarded: its offsets must not be used, and
+// its strings and vtables are no longer shared, while everything built
+// before m is kept. The error recorded since, if any, is cleared. It panics
+// if m was taken before the last Reset.
+func (b *Builder) Rollback(m Mark) {
+	if m.resets != b.resets {
+		panic("Incorrect use of Rollback(): mark was taken before Reset.")
+	}
+	if m.offset > b.Offset() || m.vtables > len(b.vtables) {
+		panic("Incorrect use of Rollback(): mark is past the data written so far.")
+	}
+	b.head = UOffsetT(len(b.Bytes)) - m.offset
+	b.vtables = b.vtables[:m.vtables]
+	b.vtable = b.vtable[:0]
+	for s, off := range b.sharedStrings {
+		if off > m.offset {
+			delete(b.sharedStrings, s)
+		}
+	}
+	if b.offsets != nil {
+		b.offsets.rollback(m.offset)
+	}
+	b.minalign = m.minalign
+	b.nested = false
+	b.finished = m.finished
+	b.err = m.err
+}
+
+// Required checks that the field in slot was added to the object being
+// built, as the generated End functions of tables with required fields do.
+// If it was not, the Builder records an error naming field, which Err
+// returns.
+func (b *Builder) Required(slot int, field string) {
+	b.assertNested()
+	if b.vtable[slot] == 0 && b.err == nil {
+		b.err = fmt.Errorf("%w: %s", ErrRequiredField, field)
+	}
 }
 
 // FinishedBytes returns a pointer to the written data in the byte buffer.
@@ -206,6 +304,9 @@ func (b *Builder) EndObject() UOffsetT {
 	b.assertNested()
 	n := b.WriteVtable()
 	b.nested = false
+	if b.offsets != nil {
+		b.offsets.issue(n)
+	}
 	return n
 }
 
@@ -285,6 +386,9 @@ func (b *Builder) PrependSOffsetT(off SOffsetT) {
 
 // PrependUOffsetT prepends an UOffsetT, relative to where it will be written.
 func (b *Builder) PrependUOffsetT(off UOffsetT) {
+	if b.offsets != nil {
+		b.offsets.check(off, b.Offset())
+	}
 	b.Prep(SizeUOffsetT, 0) // Ensure alignment is already done.
 	if !(off <= b.Offset()) {
 		panic("unreachable: off <= b.Offset()")
@@ -314,12 +418,20 @@ func (b *Builder) EndVector(vectorNumElems int) UOffsetT {
 	b.PlaceUOffsetT(UOffsetT(vectorNumElems))
 
 	b.nested = false
+	if b.offsets != nil {
+		b.offsets.issue(b.Offset())
+	}
 	return b.Offset()
 }
 
 // CreateVectorOfTables serializes slice of table offsets into a vector.
 func (b *Builder) CreateVectorOfTables(offsets []UOffsetT) UOffsetT {
 	b.assertNotNested()
+	if b.offsets != nil {
+		for _, off := range offsets {
+			b.offsets.check(off, b.Offset())
+		}
+	}
 	b.StartVector(4, len(offsets), 4)
 	for i := len(offsets) - 1; i >= 0; i-- {
 		b.PrependUOffsetT(offsets[i])
@@ -329,6 +441,10 @@ func (b *Builder) CreateVectorOfTables(offsets []UOffsetT) UOffsetT {
 
 type KeyCompare func(o1, o2 UOffsetT, buf []byte) bool
 
+// CreateVectorOfSortedTables sorts offsets with keyCompare, such as the
+// generated MonsterKeyCompare, and serializes them into a vector.
+// CreateVectorOfSortedTablesByKey is faster, and keeps the order of tables
+// with equal keys.
 func (b *Builder) CreateVectorOfSortedTables(offsets []UOffsetT, keyCompare KeyCompare) UOffsetT {
 	sort.Slice(offsets, func(i, j int) bool {
 		return keyCompare(offsets[i], offsets[j], b.Bytes)
@@ -336,6 +452,52 @@ func (b *Builder) CreateVectorOfSortedTables(offsets []UOffsetT, keyCompare KeyC
 	return b.CreateVectorOfTables(offsets)
 }
 
+// CreateVectorOfSortedTablesByKey sorts offsets by the keys that key, such
+// as the generated MonsterKey, extracts once from each table, and serializes
+// them into a vector that the generated ByKey functions can search. The sort
+// is stable, and allocates nothing once the Builder has sorted as many
+// tables before.
+func (b *Builder) CreateVectorOfSortedTablesByKey(offsets []UOffsetT, key KeyFunc) UOffsetT {
+	b.sortTablesByKey(offsets, key, false)
+	return b.CreateVectorOfTables(offsets)
+}
+
+// CreateVectorOfUniqueSortedTables is like CreateVectorOfSortedTablesByKey,
+// but if two tables have the same key, the Builder records an error wrapping
+// ErrDuplicateKey, which Err returns.
+func (b *Builder) CreateVectorOfUniqueSortedTables(offsets []UOffsetT, key KeyFunc) UOffsetT {
+	b.sortTablesByKey(offsets, key, true)
+	return b.CreateVectorOfTables(offsets)
+}
+
+func (b *Builder) sortTablesByKey(offsets []UOffsetT, key KeyFunc, unique bool) {
+	b.assertNotNested()
+	n := len(offsets)
+	if cap(b.keyedTables) < 2*n {
+		b.keyedTables = make([]keyedTable, 2*n)
+	}
+	tables, tmp := b.keyedTables[:n], b.keyedTables[n:2*n]
+	for i, off := range offsets {
+		tables[i] = keyedTable{off: off, key: key(off, b.Bytes)}
+	}
+	sortKeyedTables(tables, tmp)
+	for i := range tables {
+		offsets[i] = tables[i].off
+		if unique && i > 0 && b.err == nil && tables[i].key.Compare(tables[i-1].key) == 0 {
+			if k := tables[i].key; k.bytes != nil {
+				b.err = fmt.Errorf("%w: %q", ErrDuplicateKey, k.bytes)
+			} else {
+				b.err = ErrDuplicateKey
+			}
+		}
+	}
+	// Drop the keys, which point into the buffer, so that it can be freed
+	// when it grows.
+	for i := range b.keyedTables[:2*n] {
+		b.keyedTables[i] = keyedTable{}
+	}
+}
+
 // CreateSharedString Checks if the string is already written
 // to the buffer before calling CreateString
 func (b *Builder) CreateSharedString(s string) UOffsetT {
@@ -397,6 +559,77 @@ func (b *Builder) CreateByteVector(v []byte) UOffsetT {
 	return b.EndVector(len(v))
 }
 
+// CreateNestedFlatBuffer writes the finished buffer of `nested` as a ubyte
+// vector. The vector data is aligned to the largest alignment used by
+// `nested`, so the embedded buffer can be read in place. An error recorded by
+// `nested` is recorded by b too.
+func (b *Builder) CreateNestedFlatBuffer(nested *Builder) UOffsetT {
+	buf := nested.FinishedBytes()
+	if nested.err != nil && b.err == nil {
+		b.err = nested.err
+	}
+	b.StartVector(SizeByte, len(buf), nested.minalign)
+
+	l := UOffsetT(len(buf))
+
+	b.head -= l
+	copy(b.Bytes[b.head:b.head+l], buf)
+
+	return b.EndVector(len(buf))
+}
+
+// Splicer is a generated table type, such as *Monster, that can copy itself
+// into a Builder. The Splice methods are generated with the object API.
+type Splicer interface {
+	FlatBuffer
+	Splice(builder *Builder) UOffsetT
+}
+
+// Splice copies the root table of finished, a buffer finished without a size
+// prefix, and the data it references into b, and returns its offset, to be
+// used as a child of the object being built in b. root is a table of the
+// root type, such as &Monster{}, which is initialized on finished. The copy
+// shares the vtables of b like a table built in b, so buffers built in
+// parallel by separate Builders can be combined without unpacking them. Its
+// strings are copied as CreateByteString writes them, without being shared
+// with those of b.
+func (b *Builder) Splice(finished []byte, root Splicer) UOffsetT {
+	GetRootAs(finished, 0, root)
+	return root.Splice(b)
+}
+
+// SpliceVector copies the vector of scalars or structs in the field at the
+// vtable offset o of t into b, and returns its offset. It is used by the
+// generated Splice methods.
+func (b *Builder) SpliceVector(t *Table, o UOffsetT, elemSize, alignment int) UOffsetT {
+	start := t.Vector(o)
+	n := t.VectorLen(o)
+	b.StartVector(elemSize, n, alignment)
+
+	l := UOffsetT(n * elemSize)
+
+	b.head -= l
+	copy(b.Bytes[b.head:b.head+l], t.Bytes[start:start+l])
+
+	return b.EndVector(n)
+}
+
+// SpliceStruct copies the struct of size bytes in the field at the vtable
+// offset o of t into the object being built, and returns its offset for the
+// generated Add function of the field. It is used by the generated Splice
+// methods.
+func (b *Builder) SpliceStruct(t *Table, o UOffsetT, size, alignment int) UOffsetT {
+	b.Prep(alignment, size)
+
+	l := UOffsetT(size)
+	start := o + t.Pos
+
+	b.head -= l
+	copy(b.Bytes[b.head:b.head+l], t.Bytes[start:start+l])
+
+	return b.Offset()
+}
+
 func (b *Builder) assertNested() {
 	// If you get this assert, you're in an object while trying to write
 	// data that belongs outside of an object.
@@ -0,0 +1,95 @@
+package flatbuffers
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidOffset is wrapped by the error that a checked Builder panics with
+// when it is given an offset it cannot refer to.
+var ErrInvalidOffset = errors.New("flatbuffers: invalid offset")
+
+// NewCheckedBuilder initializes a Builder like NewBuilder, but one that
+// remembers the offsets it returned from EndObject and EndVector, and the
+// functions built on them, such as CreateString. PrependUOffsetT,
+// PrependUOffsetTSlot and CreateVectorOfTables then panic with an error
+// wrapping ErrInvalidOffset, instead of writing a corrupt buffer, when given
+// an offset from another Builder, one returned before the last Reset or
+// abandoned by Rollback, or one past the data written so far.
+//
+// Each Reset starts a new generation of the Builder, and an offset returned
+// in an earlier one is stale. A stale offset is caught unless the Builder has
+// since returned the same offset again, as offsets are plain numbers.
+//
+// The checks cost a map lookup for every offset written, so they are meant
+// for tests and debugging. Building with the flatbuffers_checked tag makes
+// every Builder from NewBuilder a checked one.
+func NewCheckedBuilder(initialSize int) *Builder {
+	b := NewBuilder(initialSize)
+	if b.offsets == nil {
+		b.offsets = newOffsetChecker()
+	}
+	return b
+}
+
+// NewUncheckedBuilder initializes a Builder like NewBuilder, but one that does
+// not check offsets even when built with the flatbuffers_checked tag, for code
+// that writes raw offsets on purpose.
+func NewUncheckedBuilder(initialSize int) *Builder {
+	b := NewBuilder(initialSize)
+	b.offsets = nil
+	return b
+}
+
+// offsetChecker records the offsets a checked Builder returned, with the
+// generation of the Builder, which Reset bumps, that each was returned in.
+// It keeps those of the current generation and of the one before it, so that
+// an offset used after a Reset is reported as stale.
+type offsetChecker struct {
+	generation uint32
+	issued     map[UOffsetT]uint32
+}
+
+func newOffsetChecker() *offsetChecker {
+	return &offsetChecker{issued: make(map[UOffsetT]uint32)}
+}
+
+// issue records off as returned in the current generation.
+func (c *offsetChecker) issue(off UOffsetT) {
+	c.issued[off] = c.generation
+}
+
+// reset starts a new generation, for Builder.Reset, and forgets the offsets
+// of the generations before the one it ends.
+func (c *offsetChecker) reset() {
+	for off, gen := range c.issued {
+		if gen != c.generation {
+			delete(c.issued, off)
+		}
+	}
+	c.generation++
+}
+
+// rollback forgets the offsets of the current generation past end.
+func (c *offsetChecker) rollback(end UOffsetT) {
+	for off, gen := range c.issued {
+		if off > end && gen == c.generation {
+			delete(c.issued, off)
+		}
+	}
+}
+
+// check panics if off cannot be referred to from a Builder whose Offset is
+// end.
+func (c *offsetChecker) check(off, end UOffsetT) {
+	if off > end {
+		panic(fmt.Errorf("%w: %d is past the %d bytes written so far", ErrInvalidOffset, off, end))
+	}
+	gen, ok := c.issued[off]
+	if !ok {
+		panic(fmt.Errorf("%w: %d was not returned by this Builder, or was abandoned by Rollback", ErrInvalidOffset, off))
+	}
+	if gen != c.generation {
+		panic(fmt.Errorf("%w: %d was returned in generation %d of this Builder, which Reset has since moved to generation %d", ErrInvalidOffset, off, gen, c.generation))
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !flatbuffers_checked
+// +build !flatbuffers_checked
+
+package flatbuffers
+
+// checkedByDefault makes NewBuilder return unchecked Builders; build with the
+// flatbuffers_checked tag to check them all.
+const checkedByDefault = false
@@ -0,0 +1,8 @@
+//go:build flatbuffers_checked
+// +build flatbuffers_checked
+
+package flatbuffers
+
+// checkedByDefault makes NewBuilder return checked Builders, as
+// NewCheckedBuilder does.
+const checkedByDefault = true
@@ -0,0 +1,102 @@
+// Command flatc-gen-go generates Go code from a schema, like `flatc --go`,
+// without needing flatc or a C++ toolchain. Schemas (.fbs) are parsed with
+// package idl, looking for included files in the -I directories. Binary
+// schemas (.bfbs) are produced by `flatc --binary --schema`; building them
+// with --bfbs-comments keeps the doc comments, and --bfbs-filenames limits
+// the output to the types of the compiled schema rather than its includes.
+//
+// Usage:
+//
+//	flatc-gen-go [flags] schema.fbs|schema.bfbs...
+//
+// It is meant to be run by `go generate`, e.g.:
+//
+//	//go:generate go run github.com/google/flatbuffers/go/cmd/flatc-gen-go -o . --gen-object-api monster.fbs
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/flatbuffers/go/gogen"
+	"github.com/google/flatbuffers/go/idl"
+)
+
+// paths is a flag that can be repeated, such as -I.
+type paths []string
+
+func (p *paths) String() string {
+	return strings.Join(*p, ",")
+}
+
+func (p *paths) Set(path string) error {
+	*p = append(*p, path)
+	return nil
+}
+
+func main() {
+	var opts gogen.Options
+	var includes paths
+	out := flag.String("o", ".", "output directory")
+	flag.Var(&includes, "I", "search for included schemas in this directory (repeatable)")
+	flag.BoolVar(&opts.ObjectAPI, "gen-object-api", false, "generate the object based API")
+	flag.BoolVar(&opts.All, "gen-all", false, "generate the types of included schemas too")
+	flag.StringVar(&opts.Namespace, "go-namespace", "", "generate all code in this namespace")
+	flag.StringVar(&opts.Import, "go-import", "", "import path of the FlatBuffers library")
+	flag.StringVar(&opts.ModuleName, "go-module-name", "", "module prefix of the import paths of generated packages")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] schema.fbs|schema.bfbs...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	for _, schema := range flag.Args() {
+		if err := generate(schema, includes, *out, opts); err != nil {
+			fmt.Fprintf(os.Stderr, "flatc-gen-go: %s: %v\n", schema, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func generate(schema string, includes []string, out string, opts gogen.Options) error {
+	bfbs, err := compile(schema, includes)
+	if err != nil {
+		return err
+	}
+	files, err := gogen.Generate(bfbs, opts)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		path := filepath.Join(out, filepath.FromSlash(f.Path))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, f.Content, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compile returns the binary schema of schema, parsing it if it is a .fbs
+// file like `flatc --binary --schema --bfbs-comments --bfbs-builtins
+// --bfbs-filenames` would.
+func compile(schema string, includes []string) ([]byte, error) {
+	if filepath.Ext(schema) != ".fbs" {
+		return os.ReadFile(schema)
+	}
+	return idl.Compile(schema, idl.Options{
+		IncludePaths: includes,
+		ProjectRoot:  filepath.Dir(schema),
+		Comments:     true,
+		Builtins:     true,
+	})
+}
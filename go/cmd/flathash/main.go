@@ -0,0 +1,106 @@
+// Command flathash is a Go port of the flathash tool. It prints the hash of
+// each STRING argument using one of the algorithms accepted by the schema
+// `hash` attribute.
+//
+//	flathash HASH [OPTION]... [--] STRING...
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/google/flatbuffers/go/flathash"
+)
+
+type outputFormat int
+
+const (
+	decimal outputFormat = iota
+	hexadecimal
+	hexadecimal0x
+)
+
+func usage(name string) {
+	fmt.Printf("%s HASH [OPTION]... [--] STRING...\n", name)
+	fmt.Printf("Available hashing algorithms:\n")
+	fmt.Printf("  16 bit:\n")
+	for _, f := range flathash.HashFunctions16 {
+		fmt.Printf("    * %s\n", f.Name)
+	}
+	fmt.Printf("  32 bit:\n")
+	for _, f := range flathash.HashFunctions32 {
+		fmt.Printf("    * %s\n", f.Name)
+	}
+	fmt.Printf("  64 bit:\n")
+	for _, f := range flathash.HashFunctions64 {
+		fmt.Printf("    * %s\n", f.Name)
+	}
+	fmt.Printf("  -d         Output hash in decimal.\n" +
+		"  -x         Output hash in hexadecimal.\n" +
+		"  -0x        Output hash in hexadecimal and prefix with 0x.\n" +
+		"  -c         Append the string to the output in a c-style comment.\n")
+}
+
+func main() {
+	if len(os.Args) <= 1 {
+		usage(os.Args[0])
+		os.Exit(1)
+	}
+
+	algorithm := os.Args[1]
+	hash16 := flathash.FindHashFunction16(algorithm)
+	hash32 := flathash.FindHashFunction32(algorithm)
+	hash64 := flathash.FindHashFunction64(algorithm)
+	if hash16 == nil && hash32 == nil && hash64 == nil {
+		fmt.Printf("%q is not a known hash algorithm.\n", algorithm)
+		os.Exit(1)
+	}
+
+	format := hexadecimal
+	annotate := false
+	escapeDash := false
+	for _, arg := range os.Args[2:] {
+		if !escapeDash && len(arg) > 0 && arg[0] == '-' {
+			switch arg {
+			case "-d":
+				format = decimal
+			case "-x":
+				format = hexadecimal
+			case "-0x":
+				format = hexadecimal0x
+			case "-c":
+				annotate = true
+			case "--":
+				escapeDash = true
+			default:
+				fmt.Printf("Unrecognized argument: %q\n", arg)
+			}
+			continue
+		}
+
+		var hash uint64
+		switch {
+		case hash16 != nil:
+			hash = uint64(hash16(arg))
+		case hash32 != nil:
+			hash = uint64(hash32(arg))
+		default:
+			hash = hash64(arg)
+		}
+
+		var out string
+		switch format {
+		case decimal:
+			out = strconv.FormatUint(hash, 10)
+		case hexadecimal:
+			out = strconv.FormatUint(hash, 16)
+		case hexadecimal0x:
+			out = "0x" + strconv.FormatUint(hash, 16)
+		}
+		if annotate {
+			out += " /* \"" + arg + "\" */"
+		}
+		fmt.Println(out)
+	}
+}
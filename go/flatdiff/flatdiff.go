@@ -0,0 +1,565 @@
+// Package flatdiff compares two FlatBuffers field by field, using the binary
+// schema (.bfbs) they were built with. Only values are compared: buffers that
+// differ in layout alone, such as in field order, padding, vtable sharing or
+// string deduplication, have no differences. Absent scalars compare equal to
+// their default, and absent vectors to empty ones.
+package flatdiff
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+	"github.com/google/flatbuffers/go/reflection"
+)
+
+// Difference is a value that differs between two buffers.
+type Difference struct {
+	// Path locates the value from the root table, e.g.
+	// `testarrayoftables[1].hp`.
+	Path string
+	// Want and Got are the values in each buffer. Absent tables, strings,
+	// vectors and optional scalars are shown as `null`.
+	Want, Got string
+}
+
+func (d Difference) String() string {
+	return d.Path + ": " + d.Want + " → " + d.Got
+}
+
+// Options controls how the buffers are read.
+type Options struct {
+	// RootType is the fully qualified name of the root table. If empty, the
+	// root_type of the schema is used.
+	RootType string
+	// SizePrefixed is set if both buffers start with a size prefix.
+	SizePrefixed bool
+}
+
+// Diff returns the differences between the buffers want and got, ordered by
+// field id within each table. It returns an error if the schema or the root
+// type is invalid, or if either buffer is malformed.
+func Diff(bfbs, want, got []byte, opts Options) (diffs []Difference, err error) {
+	if len(bfbs) < 8 || !reflection.SchemaBufferHasIdentifier(bfbs) {
+		return nil, fmt.Errorf("flatdiff: not a binary schema (.bfbs)")
+	}
+	schema := reflection.GetRootAsSchema(bfbs, 0)
+
+	root := new(reflection.Object)
+	if opts.RootType != "" {
+		if !schema.ObjectsByKey(root, opts.RootType) || root.IsStruct() {
+			return nil, fmt.Errorf("flatdiff: no table %q in schema", opts.RootType)
+		}
+	} else if schema.RootTable(root) == nil {
+		return nil, fmt.Errorf("flatdiff: schema has no root_type")
+	}
+
+	// Reading a malformed buffer panics with an out of range index.
+	defer func() {
+		if r := recover(); r != nil {
+			diffs = nil
+			err = fmt.Errorf("flatdiff: malformed buffer: %v", r)
+		}
+	}()
+
+	d := &differ{schema: schema}
+	d.table("", root, rootTable(want, opts.SizePrefixed), rootTable(got, opts.SizePrefixed))
+	return d.diffs, nil
+}
+
+// AssertEqual reports a test error listing every difference between the
+// buffers want and got, and returns whether they are equal.
+func AssertEqual(tb testing.TB, bfbs, want, got []byte, opts Options) bool {
+	tb.Helper()
+	diffs, err := Diff(bfbs, want, got, opts)
+	if err != nil {
+		tb.Errorf("%v", err)
+		return false
+	}
+	if len(diffs) == 0 {
+		return true
+	}
+	lines := make([]string, len(diffs))
+	for i, d := range diffs {
+		lines[i] = "\t" + d.String()
+	}
+	tb.Errorf("buffers differ (want → got):\n%s", strings.Join(lines, "\n"))
+	return false
+}
+
+func rootTable(buf []byte, sizePrefixed bool) *flatbuffers.Table {
+	var offset flatbuffers.UOffsetT
+	if sizePrefixed {
+		offset = flatbuffers.SizeUint32
+	}
+	return &flatbuffers.Table{
+		Bytes: buf,
+		Pos:   offset + flatbuffers.GetUOffsetT(buf[offset:]),
+	}
+}
+
+const null = "null"
+
+type differ struct {
+	schema *reflection.Schema
+	diffs  []Difference
+}
+
+func (d *differ) report(path, want, got string) {
+	d.diffs = append(d.diffs, Difference{Path: path, Want: want, Got: got})
+}
+
+func (d *differ) object(index int32) *reflection.Object {
+	obj := new(reflection.Object)
+	d.schema.Objects(obj, int(index))
+	return obj
+}
+
+func (d *differ) enum(index int32) *reflection.Enum {
+	enum := new(reflection.Enum)
+	d.schema.Enums(enum, int(index))
+	return enum
+}
+
+func fieldPath(path string, field *reflection.Field) string {
+	if path == "" {
+		return string(field.Name())
+	}
+	return path + "." + string(field.Name())
+}
+
+func indexPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// forAllFields calls fn for each field of object in order of their ids.
+func forAllFields(object *reflection.Object, fn func(field *reflection.Field)) {
+	fields := make([]*reflection.Field, object.FieldsLength())
+	for i := range fields {
+		field := new(reflection.Field)
+		object.Fields(field, i)
+		if int(field.Id()) < len(fields) {
+			fields[field.Id()] = field
+		}
+	}
+	for _, field := range fields {
+		if field != nil {
+			fn(field)
+		}
+	}
+}
+
+func (d *differ) table(path string, object *reflection.Object, a, b *flatbuffers.Table) {
+	forAllFields(object, func(field *reflection.Field) {
+		if field.Deprecated() {
+			return
+		}
+		d.field(fieldPath(path, field), object, field, a, b)
+	})
+}
+
+func (d *differ) field(path string, owner *reflection.Object, field *reflection.Field, a, b *flatbuffers.Table) {
+	slot := flatbuffers.VOffsetT(field.Offset())
+	oa, ob := a.Offset(slot), b.Offset(slot)
+	fieldType := field.Type(nil)
+	baseType := fieldType.BaseType()
+
+	if isScalar(baseType) {
+		wa, wb := d.defaultScalar(field), d.defaultScalar(field)
+		if oa != 0 {
+			wa = scalar(a.Bytes, a.Pos+flatbuffers.UOffsetT(oa), baseType)
+		}
+		if ob != 0 {
+			wb = scalar(b.Bytes, b.Pos+flatbuffers.UOffsetT(ob), baseType)
+		}
+		if wa != wb {
+			d.report(path, d.formatScalar(wa, fieldType), d.formatScalar(wb, fieldType))
+		}
+		return
+	}
+
+	if oa == 0 || ob == 0 {
+		// An absent vector reads the same as an empty one.
+		if baseType == reflection.BaseTypeVector && vectorSlotLen(a, oa) == 0 && vectorSlotLen(b, ob) == 0 {
+			return
+		}
+		if oa != ob {
+			d.report(path, d.summary(field, a, oa), d.summary(field, b, ob))
+		}
+		return
+	}
+	pa, pb := a.Pos+flatbuffers.UOffsetT(oa), b.Pos+flatbuffers.UOffsetT(ob)
+
+	switch baseType {
+	case reflection.BaseTypeString:
+		if sa, sb := a.String(pa), b.String(pb); sa != sb {
+			d.report(path, strconv.Quote(sa), strconv.Quote(sb))
+		}
+
+	case reflection.BaseTypeObj:
+		object := d.object(fieldType.Index())
+		if object.IsStruct() {
+			d.structure(path, object, a.Bytes, pa, b.Bytes, pb)
+		} else {
+			d.table(path, object, indirect(a, pa), indirect(b, pb))
+		}
+
+	case reflection.BaseTypeUnion:
+		d.union(path, owner, field, a, pa, b, pb)
+
+	case reflection.BaseTypeVector:
+		d.vector(path, owner, field, a, pa, b, pb)
+
+		// 64-bit offsets (BaseTypeVector64) aren't supported in Go.
+	}
+}
+
+// summary renders a non-scalar field for a report about its presence.
+func (d *differ) summary(field *reflection.Field, t *flatbuffers.Table, off flatbuffers.VOffsetT) string {
+	if off == 0 {
+		return null
+	}
+	pos := t.Pos + flatbuffers.UOffsetT(off)
+	switch field.Type(nil).BaseType() {
+	case reflection.BaseTypeString:
+		return strconv.Quote(t.String(pos))
+	case reflection.BaseTypeVector:
+		return "[" + strconv.Itoa(vectorSlotLen(t, off)) + " items]"
+	default:
+		return "{...}"
+	}
+}
+
+func indirect(t *flatbuffers.Table, pos flatbuffers.UOffsetT) *flatbuffers.Table {
+	return &flatbuffers.Table{Bytes: t.Bytes, Pos: t.Indirect(pos)}
+}
+
+func (d *differ) structure(path string, object *reflection.Object, a []byte, pa flatbuffers.UOffsetT, b []byte, pb flatbuffers.UOffsetT) {
+	forAllFields(object, func(field *reflection.Field) {
+		fieldType := field.Type(nil)
+		p := fieldPath(path, field)
+		off := flatbuffers.UOffsetT(field.Offset())
+
+		switch baseType := fieldType.BaseType(); {
+		case isScalar(baseType):
+			d.scalar(p, fieldType, baseType, a, pa+off, b, pb+off)
+
+		case baseType == reflection.BaseTypeObj:
+			d.structure(p, d.object(fieldType.Index()), a, pa+off, b, pb+off)
+
+		case baseType == reflection.BaseTypeArray:
+			element := fieldType.Element()
+			size := flatbuffers.UOffsetT(typeSize(element))
+			var object *reflection.Object
+			if element == reflection.BaseTypeObj {
+				object = d.object(fieldType.Index())
+				size = flatbuffers.UOffsetT(object.Bytesize())
+			}
+			for i := 0; i < int(fieldType.FixedLength()); i++ {
+				ea, eb := pa+off+flatbuffers.UOffsetT(i)*size, pb+off+flatbuffers.UOffsetT(i)*size
+				if object != nil {
+					d.structure(indexPath(p, i), object, a, ea, b, eb)
+				} else {
+					d.scalar(indexPath(p, i), fieldType, element, a, ea, b, eb)
+				}
+			}
+		}
+	})
+}
+
+func (d *differ) scalar(path string, fieldType *reflection.Type, baseType reflection.BaseType, a []byte, pa flatbuffers.UOffsetT, b []byte, pb flatbuffers.UOffsetT) {
+	if wa, wb := scalar(a, pa, baseType), scalar(b, pb, baseType); wa != wb {
+		d.report(path, d.formatScalar(wa, fieldType), d.formatScalar(wb, fieldType))
+	}
+}
+
+func (d *differ) union(path string, owner *reflection.Object, field *reflection.Field, a *flatbuffers.Table, pa flatbuffers.UOffsetT, b *flatbuffers.Table, pb flatbuffers.UOffsetT) {
+	// The type is stored in the field before the union, and differences in
+	// it are already reported there.
+	typeField := unionTypeField(owner, field)
+	if typeField == nil {
+		return
+	}
+	slot := flatbuffers.VOffsetT(typeField.Offset())
+	ta, tb := a.GetUint8Slot(slot, 0), b.GetUint8Slot(slot, 0)
+	if ta != tb {
+		return
+	}
+	d.unionValue(path, field.Type(nil).Index(), ta, a.Bytes, a.Indirect(pa), b.Bytes, b.Indirect(pb))
+}
+
+// unionTypeField returns the `_type` field of owner paired with a union (or
+// vector of unions) field.
+func unionTypeField(owner *reflection.Object, field *reflection.Field) *reflection.Field {
+	if field.Id() == 0 {
+		return nil
+	}
+	var found *reflection.Field
+	forAllFields(owner, func(f *reflection.Field) {
+		if f.Id() == field.Id()-1 {
+			found = f
+		}
+	})
+	return found
+}
+
+// unionValue compares two union values of the same type, at absolute
+// positions pa and pb.
+func (d *differ) unionValue(path string, enumIndex int32, unionType uint8, a []byte, pa flatbuffers.UOffsetT, b []byte, pb flatbuffers.UOffsetT) {
+	enumVal := new(reflection.EnumVal)
+	if !d.enum(enumIndex).ValuesByKey(enumVal, int64(unionType)) {
+		return
+	}
+	valueType := enumVal.UnionType(nil)
+	if valueType == nil {
+		return
+	}
+	switch valueType.BaseType() {
+	case reflection.BaseTypeObj:
+		object := d.object(valueType.Index())
+		if object.IsStruct() {
+			d.structure(path, object, a, pa, b, pb)
+		} else {
+			d.table(path, object, &flatbuffers.Table{Bytes: a, Pos: pa}, &flatbuffers.Table{Bytes: b, Pos: pb})
+		}
+	case reflection.BaseTypeString:
+		if sa, sb := stringAt(a, pa), stringAt(b, pb); sa != sb {
+			d.report(path, strconv.Quote(sa), strconv.Quote(sb))
+		}
+	}
+}
+
+func (d *differ) vector(path string, owner *reflection.Object, field *reflection.Field, a *flatbuffers.Table, pa flatbuffers.UOffsetT, b *flatbuffers.Table, pb flatbuffers.UOffsetT) {
+	fieldType := field.Type(nil)
+	element := fieldType.Element()
+
+	// Nested FlatBuffers are compared by value too.
+	if nested := d.nestedRoot(owner, field); nested != nil {
+		na, nb := a.ByteVector(pa), b.ByteVector(pb)
+		d.table(path, nested, rootTable(na, false), rootTable(nb, false))
+		return
+	}
+
+	la, lb := vectorLen(a.Bytes, pa), vectorLen(b.Bytes, pb)
+	if la != lb {
+		d.report("len("+path+")", strconv.Itoa(la), strconv.Itoa(lb))
+	}
+	n := la
+	if lb < n {
+		n = lb
+	}
+	da, db := vectorData(a.Bytes, pa), vectorData(b.Bytes, pb)
+
+	var object *reflection.Object
+	size := flatbuffers.UOffsetT(typeSize(element))
+	if element == reflection.BaseTypeObj {
+		object = d.object(fieldType.Index())
+		if object.IsStruct() {
+			size = flatbuffers.UOffsetT(object.Bytesize())
+		}
+	}
+
+	var typesA, typesB flatbuffers.UOffsetT
+	if element == reflection.BaseTypeUnion {
+		typeField := unionTypeField(owner, field)
+		if typeField == nil {
+			return
+		}
+		slot := flatbuffers.VOffsetT(typeField.Offset())
+		oa, ob := a.Offset(slot), b.Offset(slot)
+		if oa == 0 || ob == 0 {
+			return
+		}
+		typesA = vectorData(a.Bytes, a.Pos+flatbuffers.UOffsetT(oa))
+		typesB = vectorData(b.Bytes, b.Pos+flatbuffers.UOffsetT(ob))
+	}
+
+	for i := 0; i < n; i++ {
+		p := indexPath(path, i)
+		ea, eb := da+flatbuffers.UOffsetT(i)*size, db+flatbuffers.UOffsetT(i)*size
+		switch {
+		case isScalar(element):
+			d.scalar(p, fieldType, element, a.Bytes, ea, b.Bytes, eb)
+
+		case element == reflection.BaseTypeString:
+			if sa, sb := a.String(ea), b.String(eb); sa != sb {
+				d.report(p, strconv.Quote(sa), strconv.Quote(sb))
+			}
+
+		case element == reflection.BaseTypeObj && object.IsStruct():
+			d.structure(p, object, a.Bytes, ea, b.Bytes, eb)
+
+		case element == reflection.BaseTypeObj:
+			d.table(p, object, indirect(a, ea), indirect(b, eb))
+
+		case element == reflection.BaseTypeUnion:
+			ta := a.Bytes[typesA+flatbuffers.UOffsetT(i)]
+			tb := b.Bytes[typesB+flatbuffers.UOffsetT(i)]
+			if ta == tb {
+				d.unionValue(p, fieldType.Index(), ta, a.Bytes, a.Indirect(ea), b.Bytes, b.Indirect(eb))
+			}
+		}
+	}
+}
+
+// nestedRoot returns the root table of a `nested_flatbuffer` field.
+func (d *differ) nestedRoot(owner *reflection.Object, field *reflection.Field) *reflection.Object {
+	attr := new(reflection.KeyValue)
+	if !field.AttributesByKey(attr, "nested_flatbuffer") {
+		return nil
+	}
+	name := string(attr.Value())
+	object := new(reflection.Object)
+	if d.schema.ObjectsByKey(object, name) {
+		return object
+	}
+	// The attribute may not be fully qualified; look for it in the namespace
+	// of the referring table.
+	ownerName := string(owner.Name())
+	if i := strings.LastIndex(ownerName, "."); i >= 0 && d.schema.ObjectsByKey(object, ownerName[:i+1]+name) {
+		return object
+	}
+	return nil
+}
+
+// vectorSlotLen is the length of the vector at vtable offset off of t, or 0
+// if the vector is absent.
+func vectorSlotLen(t *flatbuffers.Table, off flatbuffers.VOffsetT) int {
+	if off == 0 {
+		return 0
+	}
+	return t.VectorLen(flatbuffers.UOffsetT(off))
+}
+
+// stringAt reads the string whose length prefix is at pos.
+func stringAt(buf []byte, pos flatbuffers.UOffsetT) string {
+	start := pos + flatbuffers.SizeUOffsetT
+	return string(buf[start : start+flatbuffers.GetUOffsetT(buf[pos:])])
+}
+
+func vectorLen(buf []byte, pos flatbuffers.UOffsetT) int {
+	pos += flatbuffers.GetUOffsetT(buf[pos:])
+	return int(flatbuffers.GetUOffsetT(buf[pos:]))
+}
+
+func vectorData(buf []byte, pos flatbuffers.UOffsetT) flatbuffers.UOffsetT {
+	return pos + flatbuffers.GetUOffsetT(buf[pos:]) + flatbuffers.SizeUOffsetT
+}
+
+func isScalar(t reflection.BaseType) bool {
+	return t >= reflection.BaseTypeUType && t <= reflection.BaseTypeDouble
+}
+
+func typeSize(t reflection.BaseType) int {
+	switch t {
+	case reflection.BaseTypeUType, reflection.BaseTypeBool, reflection.BaseTypeByte, reflection.BaseTypeUByte:
+		return 1
+	case reflection.BaseTypeShort, reflection.BaseTypeUShort:
+		return 2
+	case reflection.BaseTypeInt, reflection.BaseTypeUInt, reflection.BaseTypeFloat:
+		return 4
+	case reflection.BaseTypeLong, reflection.BaseTypeULong, reflection.BaseTypeDouble:
+		return 8
+	default:
+		return flatbuffers.SizeUOffsetT
+	}
+}
+
+// scalar reads a scalar in a canonical text form, so that equal values
+// compare equal.
+func scalar(buf []byte, pos flatbuffers.UOffsetT, t reflection.BaseType) string {
+	b := buf[pos:]
+	switch t {
+	case reflection.BaseTypeBool:
+		return strconv.FormatBool(flatbuffers.GetBool(b))
+	case reflection.BaseTypeByte:
+		return strconv.FormatInt(int64(flatbuffers.GetInt8(b)), 10)
+	case reflection.BaseTypeUType, reflection.BaseTypeUByte:
+		return strconv.FormatUint(uint64(flatbuffers.GetUint8(b)), 10)
+	case reflection.BaseTypeShort:
+		return strconv.FormatInt(int64(flatbuffers.GetInt16(b)), 10)
+	case reflection.BaseTypeUShort:
+		return strconv.FormatUint(uint64(flatbuffers.GetUint16(b)), 10)
+	case reflection.BaseTypeInt:
+		return strconv.FormatInt(int64(flatbuffers.GetInt32(b)), 10)
+	case reflection.BaseTypeUInt:
+		return strconv.FormatUint(uint64(flatbuffers.GetUint32(b)), 10)
+	case reflection.BaseTypeLong:
+		return strconv.FormatInt(flatbuffers.GetInt64(b), 10)
+	case reflection.BaseTypeULong:
+		return strconv.FormatUint(flatbuffers.GetUint64(b), 10)
+	case reflection.BaseTypeFloat:
+		return formatFloat(float64(flatbuffers.GetFloat32(b)), 32)
+	case reflection.BaseTypeDouble:
+		return formatFloat(flatbuffers.GetFloat64(b), 64)
+	}
+	return ""
+}
+
+func formatFloat(f float64, bitSize int) string {
+	if math.IsNaN(f) {
+		return "NaN"
+	}
+	return strconv.FormatFloat(f, 'g', -1, bitSize)
+}
+
+// defaultScalar is the canonical form of the value of an absent scalar
+// field.
+func (d *differ) defaultScalar(field *reflection.Field) string {
+	if field.Optional() {
+		return null
+	}
+	switch t := field.Type(nil).BaseType(); t {
+	case reflection.BaseTypeBool:
+		return strconv.FormatBool(field.DefaultInteger() != 0)
+	case reflection.BaseTypeFloat:
+		return formatFloat(float64(float32(field.DefaultReal())), 32)
+	case reflection.BaseTypeDouble:
+		return formatFloat(field.DefaultReal(), 64)
+	case reflection.BaseTypeULong:
+		return strconv.FormatUint(uint64(field.DefaultInteger()), 10)
+	default:
+		return strconv.FormatInt(field.DefaultInteger(), 10)
+	}
+}
+
+// formatScalar renders a canonical scalar value for a report, using the
+// names of enum values where the type has them.
+func (d *differ) formatScalar(value string, fieldType *reflection.Type) string {
+	if value == null || fieldType.Index() < 0 {
+		return value
+	}
+	v, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		u, uerr := strconv.ParseUint(value, 10, 64)
+		if uerr != nil {
+			return value
+		}
+		v = int64(u)
+	}
+	enum := d.enum(fieldType.Index())
+	enumVal := new(reflection.EnumVal)
+	if enum.ValuesByKey(enumVal, v) {
+		return string(enumVal.Name()) + " (" + value + ")"
+	}
+	attr := new(reflection.KeyValue)
+	if !enum.AttributesByKey(attr, "bit_flags") || v == 0 {
+		return value
+	}
+	var names []string
+	rest := v
+	for i := 0; i < enum.ValuesLength(); i++ {
+		enum.Values(enumVal, i)
+		if flag := enumVal.Value(); flag != 0 && v&flag == flag {
+			names = append(names, string(enumVal.Name()))
+			rest &^= flag
+		}
+	}
+	if len(names) == 0 || rest != 0 {
+		return value
+	}
+	return strings.Join(names, "|") + " (" + value + ")"
+}
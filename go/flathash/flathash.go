@@ -0,0 +1,143 @@
+// Package flathash implements the FNV-1 and FNV-1a hashes used by the
+// FlatBuffers `hash` attribute. The results match include/flatbuffers/hash.h,
+// so Go code computes the same ids as flatc and the C++ flathash tool.
+//
+// Like the C++ implementation, hashing stops at the first NUL byte of the
+// input, since flatc hashes the C string of the attribute value.
+package flathash
+
+const (
+	fnvPrime32       = 0x01000193
+	fnvOffsetBasis32 = 0x811C9DC5
+	fnvPrime64       = 0x00000100000001b3
+	fnvOffsetBasis64 = 0xcbf29ce484222645
+)
+
+// HashFunction16 hashes a string to 16 bits.
+type HashFunction16 func(input string) uint16
+
+// HashFunction32 hashes a string to 32 bits.
+type HashFunction32 func(input string) uint32
+
+// HashFunction64 hashes a string to 64 bits.
+type HashFunction64 func(input string) uint64
+
+// Fnv1Hash32 returns the 32 bit FNV-1 hash of `input`.
+func Fnv1Hash32(input string) uint32 {
+	hash := uint32(fnvOffsetBasis32)
+	for i := 0; i < len(input) && input[i] != 0; i++ {
+		hash *= fnvPrime32
+		hash ^= uint32(input[i])
+	}
+	return hash
+}
+
+// Fnv1aHash32 returns the 32 bit FNV-1a hash of `input`.
+func Fnv1aHash32(input string) uint32 {
+	hash := uint32(fnvOffsetBasis32)
+	for i := 0; i < len(input) && input[i] != 0; i++ {
+		hash ^= uint32(input[i])
+		hash *= fnvPrime32
+	}
+	return hash
+}
+
+// Fnv1Hash64 returns the 64 bit FNV-1 hash of `input`.
+func Fnv1Hash64(input string) uint64 {
+	hash := uint64(fnvOffsetBasis64)
+	for i := 0; i < len(input) && input[i] != 0; i++ {
+		hash *= fnvPrime64
+		hash ^= uint64(input[i])
+	}
+	return hash
+}
+
+// Fnv1aHash64 returns the 64 bit FNV-1a hash of `input`.
+func Fnv1aHash64(input string) uint64 {
+	hash := uint64(fnvOffsetBasis64)
+	for i := 0; i < len(input) && input[i] != 0; i++ {
+		hash ^= uint64(input[i])
+		hash *= fnvPrime64
+	}
+	return hash
+}
+
+// Fnv1Hash16 returns the 32 bit FNV-1 hash of `input`, folded to 16 bits.
+func Fnv1Hash16(input string) uint16 {
+	return fold16(Fnv1Hash32(input))
+}
+
+// Fnv1aHash16 returns the 32 bit FNV-1a hash of `input`, folded to 16 bits.
+func Fnv1aHash16(input string) uint16 {
+	return fold16(Fnv1aHash32(input))
+}
+
+func fold16(hash uint32) uint16 {
+	return uint16((hash >> 16) ^ (hash & 0xffff))
+}
+
+// NamedHashFunction16 pairs a 16 bit hash function with its schema name.
+type NamedHashFunction16 struct {
+	Name     string
+	Function HashFunction16
+}
+
+// NamedHashFunction32 pairs a 32 bit hash function with its schema name.
+type NamedHashFunction32 struct {
+	Name     string
+	Function HashFunction32
+}
+
+// NamedHashFunction64 pairs a 64 bit hash function with its schema name.
+type NamedHashFunction64 struct {
+	Name     string
+	Function HashFunction64
+}
+
+// HashFunctions16 lists the 16 bit algorithms accepted by `hash:`.
+var HashFunctions16 = []NamedHashFunction16{
+	{"fnv1_16", Fnv1Hash16},
+	{"fnv1a_16", Fnv1aHash16},
+}
+
+// HashFunctions32 lists the 32 bit algorithms accepted by `hash:`.
+var HashFunctions32 = []NamedHashFunction32{
+	{"fnv1_32", Fnv1Hash32},
+	{"fnv1a_32", Fnv1aHash32},
+}
+
+// HashFunctions64 lists the 64 bit algorithms accepted by `hash:`.
+var HashFunctions64 = []NamedHashFunction64{
+	{"fnv1_64", Fnv1Hash64},
+	{"fnv1a_64", Fnv1aHash64},
+}
+
+// FindHashFunction16 returns the 16 bit hash function called `name`, or nil.
+func FindHashFunction16(name string) HashFunction16 {
+	for _, f := range HashFunctions16 {
+		if f.Name == name {
+			return f.Function
+		}
+	}
+	return nil
+}
+
+// FindHashFunction32 returns the 32 bit hash function called `name`, or nil.
+func FindHashFunction32(name string) HashFunction32 {
+	for _, f := range HashFunctions32 {
+		if f.Name == name {
+			return f.Function
+		}
+	}
+	return nil
+}
+
+// FindHashFunction64 returns the 64 bit hash function called `name`, or nil.
+func FindHashFunction64(name string) HashFunction64 {
+	for _, f := range HashFunctions64 {
+		if f.Name == name {
+			return f.Function
+		}
+	}
+	return nil
+}
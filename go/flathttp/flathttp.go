@@ -0,0 +1,453 @@
+// Package flathttp carries the calls of rpc_service declarations over plain
+// HTTP/1.1, for services that cannot use gRPC. It is the runtime of the code
+// generated by `flatc --go --go-http`, and mirrors the gRPC API that the
+// generated gRPC code uses.
+//
+// A call is a POST to /<namespace>.<Service>/<Method> with the
+// application/x-flatbuffers content type. A message that is not streamed is
+// the whole request or response body. A stream is a sequence of size-prefixed
+// FlatBuffers, sent with chunked transfer encoding and flushed one by one. An
+// error returned by the server after a response stream has started is sent
+// in the Flatbuffers-Error trailer.
+package flathttp
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// ContentType is the media type of requests and responses.
+const ContentType = "application/x-flatbuffers"
+
+// ErrorTrailer is the trailer that carries the error of a response stream,
+// as the status code followed by the message, e.g. `500 out of monsters`.
+const ErrorTrailer = "Flatbuffers-Error"
+
+// maxFrameSize is the size of the largest FlatBuffer.
+const maxFrameSize = 1<<31 - 1
+
+// Error is an error with the HTTP status code that carries it. Servers may
+// return one to choose the status code, which is 500 for other errors, and
+// clients get one for every error response.
+type Error struct {
+	Code    int
+	Message string
+}
+
+// Errorf returns an *Error with the given status code and message.
+func Errorf(code int, format string, a ...interface{}) error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, a...)}
+}
+
+func (e *Error) Error() string {
+	return "flathttp: " + strconv.Itoa(e.Code) + " " + http.StatusText(e.Code) + ": " + e.Message
+}
+
+// WriteFrame writes buf as a size-prefixed frame.
+func WriteFrame(w io.Writer, buf []byte) error {
+	var prefix [flatbuffers.SizeUOffsetT]byte
+	binary.LittleEndian.PutUint32(prefix[:], uint32(len(buf)))
+	if _, err := w.Write(prefix[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+// ReadFrame reads the next size-prefixed frame, and returns it without its
+// size prefix. It returns io.EOF when r ends before a frame, and
+// io.ErrUnexpectedEOF when it ends inside one.
+func ReadFrame(r io.Reader) ([]byte, error) {
+	var prefix [flatbuffers.SizeUOffsetT]byte
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		return nil, err
+	}
+	size := binary.LittleEndian.Uint32(prefix[:])
+	if size > maxFrameSize {
+		return nil, fmt.Errorf("flathttp: frame of %d bytes is too large", size)
+	}
+	// The frame grows as it is read, so that a corrupt size prefix cannot
+	// make us allocate more than the stream holds.
+	var frame bytes.Buffer
+	if _, err := io.CopyN(&frame, r, int64(size)); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return frame.Bytes(), nil
+}
+
+// StreamDesc says which sides of a call are streamed.
+type StreamDesc struct {
+	ServerStreams bool
+	ClientStreams bool
+}
+
+// ServerStream is the server side of a streaming call.
+type ServerStream interface {
+	// Context returns the context of the request.
+	Context() context.Context
+	// SendMsg sends m, which is anything FlatbuffersCodec can marshal.
+	SendMsg(m interface{}) error
+	// RecvMsg receives the next request message into m, and returns io.EOF
+	// after the last one.
+	RecvMsg(m interface{}) error
+}
+
+// ClientStream is the client side of a streaming call.
+type ClientStream interface {
+	// Context returns the context of the call.
+	Context() context.Context
+	// SendMsg sends m, which is anything FlatbuffersCodec can marshal.
+	SendMsg(m interface{}) error
+	// CloseSend ends the request.
+	CloseSend() error
+	// RecvMsg receives the next response message into m, and returns io.EOF
+	// after the last one.
+	RecvMsg(m interface{}) error
+}
+
+var codec flatbuffers.FlatbuffersCodec
+
+// UnaryHandler returns the handler of a call without streams. handler
+// decodes the request with dec and returns the response.
+func UnaryHandler(handler func(ctx context.Context, dec func(interface{}) error) (interface{}, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !checkRequest(w, r) {
+			return
+		}
+		dec := func(m interface{}) error {
+			buf, err := io.ReadAll(r.Body)
+			if err != nil {
+				return err
+			}
+			if err := codec.Unmarshal(buf, m); err != nil {
+				return &Error{Code: http.StatusBadRequest, Message: err.Error()}
+			}
+			return nil
+		}
+		out, err := handler(r.Context(), dec)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		buf, err := codec.Marshal(out)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeMessage(w, buf)
+	})
+}
+
+// StreamHandler returns the handler of a streaming call.
+func StreamHandler(desc StreamDesc, handler func(ServerStream) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !checkRequest(w, r) {
+			return
+		}
+		if desc.ClientStreams && desc.ServerStreams {
+			// HTTP/1.1 servers stop reading the request once the response
+			// has started, unless asked not to.
+			http.NewResponseController(w).EnableFullDuplex()
+		}
+		s := &serverStream{desc: desc, w: w, r: r}
+		err := handler(s)
+		switch {
+		case err != nil && !s.started:
+			writeError(w, err)
+		case err != nil:
+			code, message := errorCode(err)
+			w.Header().Set(ErrorTrailer, strconv.Itoa(code)+" "+message)
+		case !s.started && desc.ServerStreams:
+			s.start()
+		case !s.started:
+			writeError(w, errors.New("no response was sent"))
+		}
+	})
+}
+
+func checkRequest(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, Errorf(http.StatusMethodNotAllowed, "%s is not allowed", r.Method))
+		return false
+	}
+	if t, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || t != ContentType {
+		writeError(w, Errorf(http.StatusUnsupportedMediaType, "content type must be %s", ContentType))
+		return false
+	}
+	return true
+}
+
+func errorCode(err error) (int, string) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code, e.Message
+	}
+	return http.StatusInternalServerError, err.Error()
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	code, message := errorCode(err)
+	http.Error(w, message, code)
+}
+
+func writeMessage(w http.ResponseWriter, buf []byte) {
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(buf)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf)
+}
+
+type serverStream struct {
+	desc     StreamDesc
+	w        http.ResponseWriter
+	r        *http.Request
+	started  bool
+	received bool
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.r.Context()
+}
+
+// start sends the headers of a response stream.
+func (s *serverStream) start() {
+	s.started = true
+	s.w.Header().Set("Content-Type", ContentType)
+	s.w.Header().Set("Trailer", ErrorTrailer)
+	s.w.WriteHeader(http.StatusOK)
+}
+
+func (s *serverStream) SendMsg(m interface{}) error {
+	buf, err := codec.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if !s.desc.ServerStreams {
+		if s.started {
+			return errors.New("flathttp: the response was already sent")
+		}
+		s.started = true
+		writeMessage(s.w, buf)
+		return nil
+	}
+	if !s.started {
+		s.start()
+	}
+	if err := WriteFrame(s.w, buf); err != nil {
+		return err
+	}
+	return http.NewResponseController(s.w).Flush()
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	var buf []byte
+	var err error
+	if s.desc.ClientStreams {
+		buf, err = ReadFrame(s.r.Body)
+	} else if s.received {
+		err = io.EOF
+	} else {
+		s.received = true
+		buf, err = io.ReadAll(s.r.Body)
+	}
+	if err != nil {
+		return err
+	}
+	if err := codec.Unmarshal(buf, m); err != nil {
+		return &Error{Code: http.StatusBadRequest, Message: err.Error()}
+	}
+	return nil
+}
+
+// Client calls the methods of services served at BaseURL.
+type Client struct {
+	// BaseURL is the URL the method paths are appended to, such as
+	// `http://localhost:8080`.
+	BaseURL string
+	// HTTPClient makes the requests. If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+}
+
+// NewClient returns a client of the services at baseURL.
+func NewClient(baseURL string, client *http.Client) *Client {
+	return &Client{BaseURL: baseURL, HTTPClient: client}
+}
+
+// Invoke calls a method without streams, and receives its response into
+// out.
+func (c *Client) Invoke(ctx context.Context, method string, in, out interface{}) error {
+	buf, err := codec.Marshal(in)
+	if err != nil {
+		return err
+	}
+	resp, err := c.do(ctx, method, bytes.NewReader(buf))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	buf, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return codec.Unmarshal(buf, out)
+}
+
+// NewStream starts a streaming call. The request is sent when it is closed
+// with CloseSend, or frame by frame if the client streams.
+func (c *Client) NewStream(ctx context.Context, desc *StreamDesc, method string) (ClientStream, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &clientStream{
+		ctx:    ctx,
+		cancel: cancel,
+		desc:   *desc,
+		done:   make(chan struct{}),
+	}
+	if desc.ClientStreams {
+		var body io.Reader
+		body, s.pipe = io.Pipe()
+		go s.do(c, method, body)
+	} else {
+		s.call = func(body io.Reader) { go s.do(c, method, body) }
+	}
+	return s, nil
+}
+
+func (c *Client) do(ctx context.Context, method string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(c.BaseURL, "/")+method, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", ContentType)
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		message, _ := io.ReadAll(resp.Body)
+		return nil, &Error{Code: resp.StatusCode, Message: strings.TrimSpace(string(message))}
+	}
+	return resp, nil
+}
+
+type clientStream struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	desc   StreamDesc
+
+	// pipe is the request body if the client streams, and call sends the
+	// request message otherwise.
+	pipe    *io.PipeWriter
+	call    func(body io.Reader)
+	request []byte
+
+	done     chan struct{}
+	resp     *http.Response
+	err      error
+	received bool
+}
+
+func (s *clientStream) do(c *Client, method string, body io.Reader) {
+	s.resp, s.err = c.do(s.ctx, method, body)
+	if s.err != nil && s.pipe != nil {
+		s.pipe.CloseWithError(s.err)
+	}
+	close(s.done)
+}
+
+func (s *clientStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *clientStream) SendMsg(m interface{}) error {
+	buf, err := codec.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if !s.desc.ClientStreams {
+		if s.call == nil || s.request != nil {
+			return errors.New("flathttp: the request was already sent")
+		}
+		s.request = buf
+		return nil
+	}
+	if err := WriteFrame(s.pipe, buf); err != nil {
+		// The request failed; its error is the interesting one.
+		<-s.done
+		if s.err != nil {
+			return s.err
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *clientStream) CloseSend() error {
+	if s.desc.ClientStreams {
+		return s.pipe.Close()
+	}
+	if s.call != nil {
+		s.call(bytes.NewReader(s.request))
+		s.call = nil
+	}
+	return nil
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	<-s.done
+	if s.err != nil {
+		return s.err
+	}
+	var buf []byte
+	var err error
+	if s.desc.ServerStreams {
+		buf, err = ReadFrame(s.resp.Body)
+		if err == io.EOF {
+			err = s.trailerError()
+		}
+	} else if s.received {
+		err = io.EOF
+	} else {
+		s.received = true
+		buf, err = io.ReadAll(s.resp.Body)
+		s.resp.Body.Close()
+	}
+	if err != nil {
+		s.resp.Body.Close()
+		s.cancel()
+		return err
+	}
+	return codec.Unmarshal(buf, m)
+}
+
+// trailerError returns the error of a finished response stream, or io.EOF.
+func (s *clientStream) trailerError() error {
+	t := s.resp.Trailer.Get(ErrorTrailer)
+	if t == "" {
+		return io.EOF
+	}
+	code, message, _ := strings.Cut(t, " ")
+	e := &Error{Code: http.StatusInternalServerError, Message: message}
+	if n, err := strconv.Atoi(code); err == nil {
+		e.Code = n
+	}
+	return e
+}
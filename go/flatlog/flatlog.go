@@ -0,0 +1,505 @@
+// Package flatlog implements an append-only log of FlatBuffers records, such
+// as a stream of events, which can be read back in order or by record number.
+//
+// A log file starts with the magic "FLOG" and a little-endian uint32 version.
+// Each record is then a little-endian uint32 length, the CRC-32C (Castagnoli)
+// of the buffer, and the buffer, zero padded to a multiple of 8 bytes so that
+// every buffer is 8 byte aligned in the file.
+//
+// Closing a Writer appends an index: a record, marked by the high bit of its
+// length, holding a FlatBuffer equivalent to
+//
+//	table Index {
+//	  offsets:[ulong];  // file offset of each record
+//	}
+//	file_identifier "FLIX";
+//
+// followed by a 12 byte trailer of the little-endian uint64 file offset of the
+// index record and "FLIX". A Reader seeks to a record through the index
+// without reading the ones before it. A log that was not closed, say because
+// the writer crashed, has no index, so it is scanned instead, and a torn or
+// corrupt record at its end is dropped along with anything after it.
+package flatlog
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+var (
+	// ErrNotLog is returned when a file does not start like a log.
+	ErrNotLog = errors.New("flatlog: not a record log")
+	// ErrCorrupt is returned when a record does not match its checksum, or
+	// does not fit in the file.
+	ErrCorrupt = errors.New("flatlog: corrupt record")
+)
+
+const (
+	magic            = "FLOG"
+	version          = 1
+	fileHeaderSize   = 8
+	recordHeaderSize = 8
+	trailerSize      = 12
+	indexIdentifier  = "FLIX"
+	alignment        = 8
+
+	// indexFlag marks the length of the index record.
+	indexFlag = 1 << 31
+	// maxRecordSize is the size of the largest FlatBuffer.
+	maxRecordSize = indexFlag - 1
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+var zeros [alignment]byte
+
+func padding(n int64) int64 {
+	return -n & (alignment - 1)
+}
+
+// Writer appends records to a log file. It is not safe for concurrent use.
+type Writer struct {
+	f       *os.File
+	w       *bufio.Writer
+	off     int64    // offset of the next record
+	offsets []uint64 // offsets of the records
+	builder *flatbuffers.Builder
+}
+
+// Create creates the log file at path, truncating it if it exists.
+func Create(path string) (*Writer, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	w, err := newWriter(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// OpenWriter opens the log file at path to append records to it, creating it
+// if it does not exist. The index of a closed log is removed, to be written
+// again by Close. A log that was not closed is scanned, and a torn or corrupt
+// record at its end is truncated, along with anything after it, so that the
+// new records follow the last intact one.
+func OpenWriter(path string) (*Writer, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666)
+	if err != nil {
+		return nil, err
+	}
+	w, err := openWriter(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+func openWriter(f *os.File) (*Writer, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() == 0 {
+		return newWriter(f)
+	}
+	ix, end, err := loadIndex(f, fi.Size())
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Truncate(end); err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(end, io.SeekStart); err != nil {
+		return nil, err
+	}
+	offsets := make([]uint64, ix.n)
+	for i := range offsets {
+		offsets[i] = uint64(ix.offset(i))
+	}
+	return &Writer{
+		f:       f,
+		w:       bufio.NewWriter(f),
+		off:     end,
+		offsets: offsets,
+		builder: flatbuffers.NewBuilder(0),
+	}, nil
+}
+
+// newWriter writes the file header to the empty file f.
+func newWriter(f *os.File) (*Writer, error) {
+	var h [fileHeaderSize]byte
+	copy(h[:], magic)
+	binary.LittleEndian.PutUint32(h[len(magic):], version)
+	if _, err := f.Write(h[:]); err != nil {
+		return nil, err
+	}
+	return &Writer{
+		f:       f,
+		w:       bufio.NewWriter(f),
+		off:     fileHeaderSize,
+		builder: flatbuffers.NewBuilder(0),
+	}, nil
+}
+
+// Append writes buf, a finished FlatBuffer without a size prefix, as the next
+// record, and returns its number. Records are buffered until Flush, Sync or
+// Close.
+func (w *Writer) Append(buf []byte) (int, error) {
+	if len(buf) > maxRecordSize {
+		return 0, fmt.Errorf("flatlog: record of %d bytes is too large", len(buf))
+	}
+	off := w.off
+	if err := w.write(buf, 0); err != nil {
+		return 0, err
+	}
+	w.offsets = append(w.offsets, uint64(off))
+	return len(w.offsets) - 1, nil
+}
+
+func (w *Writer) write(buf []byte, flags uint32) error {
+	var h [recordHeaderSize]byte
+	binary.LittleEndian.PutUint32(h[:4], uint32(len(buf))|flags)
+	binary.LittleEndian.PutUint32(h[4:], crc32.Checksum(buf, crcTable))
+	pad := padding(int64(len(buf)))
+	if _, err := w.w.Write(h[:]); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(buf); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(zeros[:pad]); err != nil {
+		return err
+	}
+	w.off += recordHeaderSize + int64(len(buf)) + pad
+	return nil
+}
+
+// Len returns the number of records in the log.
+func (w *Writer) Len() int {
+	return len(w.offsets)
+}
+
+// Flush writes the buffered records to the file.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// Sync writes the buffered records to the file and commits it to stable
+// storage, so that they survive a crash.
+func (w *Writer) Sync() error {
+	if err := w.w.Flush(); err != nil {
+		return err
+	}
+	return w.f.Sync()
+}
+
+// Close writes the index and the trailer, syncs the file and closes it.
+func (w *Writer) Close() error {
+	indexOffset := w.off
+	err := w.write(buildIndex(w.builder, w.offsets), indexFlag)
+	if err == nil {
+		var t [trailerSize]byte
+		binary.LittleEndian.PutUint64(t[:8], uint64(indexOffset))
+		copy(t[8:], indexIdentifier)
+		_, err = w.w.Write(t[:])
+	}
+	if err == nil {
+		err = w.Sync()
+	}
+	if cerr := w.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func buildIndex(b *flatbuffers.Builder, offsets []uint64) []byte {
+	b.Reset()
+	b.StartVector(flatbuffers.SizeUint64, len(offsets), flatbuffers.SizeUint64)
+	for i := len(offsets) - 1; i >= 0; i-- {
+		b.PrependUint64(offsets[i])
+	}
+	vec := b.EndVector(len(offsets))
+	b.StartObject(1)
+	b.PrependUOffsetTSlot(0, vec, 0)
+	b.FinishWithFileIdentifier(b.EndObject(), []byte(indexIdentifier))
+	return b.FinishedBytes()
+}
+
+// index is the table of record offsets of a log.
+type index struct {
+	t   flatbuffers.Table
+	vec flatbuffers.UOffsetT // position of the first offset
+	n   int
+}
+
+func (ix *index) offset(i int) int64 {
+	return int64(ix.t.GetUint64(ix.vec + flatbuffers.UOffsetT(i*flatbuffers.SizeUint64)))
+}
+
+func parseIndex(buf []byte) (ix index, err error) {
+	if len(buf) < flatbuffers.SizeUOffsetT+len(indexIdentifier) ||
+		!flatbuffers.BufferHasIdentifier(buf, indexIdentifier) {
+		return ix, ErrCorrupt
+	}
+	// An index with out of bounds offsets makes the table accessors panic.
+	defer func() {
+		if recover() != nil {
+			err = ErrCorrupt
+		}
+	}()
+	ix.t = flatbuffers.Table{Bytes: buf, Pos: flatbuffers.GetUOffsetT(buf)}
+	if o := flatbuffers.UOffsetT(ix.t.Offset(4)); o != 0 {
+		ix.vec = ix.t.Vector(o)
+		ix.n = ix.t.VectorLen(o)
+	}
+	if uint64(ix.vec)+uint64(ix.n)*flatbuffers.SizeUint64 > uint64(len(buf)) {
+		return index{}, ErrCorrupt
+	}
+	return ix, nil
+}
+
+// loadIndex returns the index of the log of size bytes in r, and the offset
+// where its records end. It reads the index of a closed log, and otherwise
+// scans the records up to the first one that is torn or corrupt.
+func loadIndex(r io.ReaderAt, size int64) (ix index, end int64, err error) {
+	var h [fileHeaderSize]byte
+	if _, err := r.ReadAt(h[:], 0); err != nil {
+		if err == io.EOF {
+			err = ErrNotLog
+		}
+		return ix, 0, err
+	}
+	if string(h[:len(magic)]) != magic {
+		return ix, 0, ErrNotLog
+	}
+	if v := binary.LittleEndian.Uint32(h[len(magic):]); v != version {
+		return ix, 0, fmt.Errorf("flatlog: unsupported version %d", v)
+	}
+	if ix, end, ok := readTrailer(r, size); ok {
+		return ix, end, nil
+	}
+	var offsets []uint64
+	var buf []byte
+	end = fileHeaderSize
+	for end < size {
+		data, isIndex, next, err := readRecord(r, end, size, buf)
+		if err == ErrCorrupt || isIndex {
+			break
+		}
+		if err != nil {
+			return ix, 0, err
+		}
+		offsets = append(offsets, uint64(end))
+		buf, end = data, next
+	}
+	ix, err = parseIndex(buildIndex(flatbuffers.NewBuilder(0), offsets))
+	return ix, end, err
+}
+
+// readTrailer reads the index of a closed log, and returns the offset of the
+// index record.
+func readTrailer(r io.ReaderAt, size int64) (ix index, indexOffset int64, ok bool) {
+	limit := size - trailerSize
+	if limit < fileHeaderSize {
+		return ix, 0, false
+	}
+	var t [trailerSize]byte
+	if _, err := r.ReadAt(t[:], limit); err != nil || string(t[8:]) != indexIdentifier {
+		return ix, 0, false
+	}
+	indexOffset = int64(binary.LittleEndian.Uint64(t[:8]))
+	if indexOffset < fileHeaderSize || indexOffset > limit {
+		return ix, 0, false
+	}
+	buf, isIndex, next, err := readRecord(r, indexOffset, limit, nil)
+	if err != nil || !isIndex || next != limit {
+		return ix, 0, false
+	}
+	ix, err = parseIndex(buf)
+	return ix, indexOffset, err == nil
+}
+
+// readRecord reads the record at off, which must end by limit, into buf, and
+// returns its buffer, whether it is the index, and the offset of the next
+// record.
+func readRecord(r io.ReaderAt, off, limit int64, buf []byte) (data []byte, isIndex bool, next int64, err error) {
+	var h [recordHeaderSize]byte
+	if limit-off < recordHeaderSize {
+		return nil, false, 0, ErrCorrupt
+	}
+	if _, err := r.ReadAt(h[:], off); err != nil {
+		return nil, false, 0, err
+	}
+	length := binary.LittleEndian.Uint32(h[:4])
+	isIndex = length&indexFlag != 0
+	n := int64(length &^ indexFlag)
+	if n > limit-off-recordHeaderSize {
+		return nil, false, 0, ErrCorrupt
+	}
+	if int64(cap(buf)) < n {
+		buf = make([]byte, n)
+	}
+	data = buf[:n]
+	if _, err := r.ReadAt(data, off+recordHeaderSize); err != nil {
+		return nil, false, 0, err
+	}
+	if crc32.Checksum(data, crcTable) != binary.LittleEndian.Uint32(h[4:]) {
+		return nil, false, 0, ErrCorrupt
+	}
+	return data, isIndex, off + recordHeaderSize + n + padding(n), nil
+}
+
+// Reader reads the records of a log by number. It is safe for concurrent
+// use if its io.ReaderAt is.
+type Reader struct {
+	r      io.ReaderAt
+	size   int64
+	index  index
+	closer io.Closer
+}
+
+// Open opens the log file at path for reading.
+func Open(path string) (*Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	r, err := NewReader(f, fi.Size())
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	r.closer = f
+	return r, nil
+}
+
+// NewReader returns a Reader of the log of size bytes in r. The index of a
+// log that was not closed is rebuilt by reading all of its records, which
+// stop before the first torn or corrupt one.
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	ix, _, err := loadIndex(r, size)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{r: r, size: size, index: ix}, nil
+}
+
+// Len returns the number of records in the log.
+func (r *Reader) Len() int {
+	return r.index.n
+}
+
+// Record reads record i and checks its checksum. It returns a finished
+// FlatBuffer without a size prefix.
+func (r *Reader) Record(i int) ([]byte, error) {
+	return r.read(i, nil)
+}
+
+// Root reads record i like Record, and initializes fb, a generated table
+// such as *Monster, as its root.
+func (r *Reader) Root(i int, fb flatbuffers.FlatBuffer) error {
+	buf, err := r.read(i, nil)
+	if err != nil {
+		return err
+	}
+	flatbuffers.GetRootAs(buf, 0, fb)
+	return nil
+}
+
+func (r *Reader) read(i int, buf []byte) ([]byte, error) {
+	if i < 0 || i >= r.index.n {
+		return nil, fmt.Errorf("flatlog: record %d out of range [0, %d)", i, r.index.n)
+	}
+	data, isIndex, _, err := readRecord(r.r, r.index.offset(i), r.size, buf)
+	if err == nil && isIndex {
+		err = ErrCorrupt
+	}
+	if err != nil {
+		return nil, fmt.Errorf("flatlog: record %d: %w", i, err)
+	}
+	return data, nil
+}
+
+// Records returns an Iterator over the records of the log, in order.
+func (r *Reader) Records() *Iterator {
+	return &Iterator{r: r, i: -1}
+}
+
+// Close closes the file opened by Open. It does nothing for a Reader
+// returned by NewReader.
+func (r *Reader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
+
+// Iterator reads the records of a log in order, like a bufio.Scanner:
+//
+//	var monster example.Monster
+//	for it := r.Records(); it.Next(); {
+//		it.Root(&monster)
+//		// ...
+//	}
+//
+// Its buffer is reused, so a record is only valid until the next call to
+// Next.
+type Iterator struct {
+	r   *Reader
+	i   int
+	buf []byte
+	err error
+}
+
+// Next reads the next record, and reports whether there was one. It returns
+// false at the end of the log or on an error, which Err returns.
+func (it *Iterator) Next() bool {
+	if it.err != nil || it.i+1 >= it.r.Len() {
+		return false
+	}
+	data, err := it.r.read(it.i+1, it.buf)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.i++
+	it.buf = data
+	return true
+}
+
+// Index returns the number of the current record.
+func (it *Iterator) Index() int {
+	return it.i
+}
+
+// Bytes returns the buffer of the current record.
+func (it *Iterator) Bytes() []byte {
+	return it.buf
+}
+
+// Root initializes fb, a generated table such as *Monster, as the root of the
+// current record.
+func (it *Iterator) Root(fb flatbuffers.FlatBuffer) {
+	flatbuffers.GetRootAs(it.buf, 0, fb)
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,275 @@
+// Package flatrpc implements a FlatBuffers ClientCodec and ServerCodec for
+// the net/rpc package, in the manner of net/rpc/jsonrpc.
+//
+// Each request and response is a header followed by a body, both written as
+// size-prefixed FlatBuffers. The header is a table equivalent to:
+//
+//	table Header {
+//	  service_method:string;
+//	  seq:ulong;
+//	  error:string;
+//	}
+//
+// Bodies are marshaled with flatbuffers.FlatbuffersCodec, so the arguments
+// of a call may be a finished *flatbuffers.Builder, a finished []byte or an
+// object-API value such as *MonsterT, and a reply is received into a
+// generated table such as *Stat. On the server, a method of an rpc_service
+// takes the generated table of its request and fills in the object-API value
+// of its response:
+//
+//	func (s *Storage) Store(in *example.Monster, out *example.StatT) error
+//
+// Since net/rpc names a method by what follows the last dot, registering the
+// receiver with the fully qualified name of the service, such as
+// "MyGame.Example.MonsterStorage", lets clients call
+// "MyGame.Example.MonsterStorage.Store". net/rpc has no streams, so only the
+// rpc_service methods without a streaming attribute can be served.
+package flatrpc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/rpc"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// ErrMalformedHeader is returned when a header cannot be read.
+var ErrMalformedHeader = errors.New("flatrpc: malformed header")
+
+// Field slots of the header table.
+const (
+	headerServiceMethod = iota
+	headerSeq
+	headerError
+	headerFields
+)
+
+// maxMessageSize is the size of the largest FlatBuffer.
+const maxMessageSize = 1<<31 - 1
+
+type header struct {
+	serviceMethod string
+	seq           uint64
+	err           string
+}
+
+// conn reads and writes the size-prefixed messages of a connection.
+type conn struct {
+	rwc     io.ReadWriteCloser
+	r       *bufio.Reader
+	w       *bufio.Writer
+	codec   flatbuffers.FlatbuffersCodec
+	builder *flatbuffers.Builder
+}
+
+func newConn(rwc io.ReadWriteCloser) conn {
+	return conn{
+		rwc:     rwc,
+		r:       bufio.NewReader(rwc),
+		w:       bufio.NewWriter(rwc),
+		builder: flatbuffers.NewBuilder(64),
+	}
+}
+
+// write writes a header and a body, which is nil for an error.
+func (c *conn) write(h header, body []byte) error {
+	b := c.builder
+	b.Reset()
+	var errOffset flatbuffers.UOffsetT
+	if h.err != "" {
+		errOffset = b.CreateString(h.err)
+	}
+	method := b.CreateString(h.serviceMethod)
+	b.StartObject(headerFields)
+	b.PrependUint64Slot(headerSeq, h.seq, 0)
+	b.PrependUOffsetTSlot(headerServiceMethod, method, 0)
+	if errOffset != 0 {
+		b.PrependUOffsetTSlot(headerError, errOffset, 0)
+	}
+	b.FinishSizePrefixed(b.EndObject())
+	if _, err := c.w.Write(b.FinishedBytes()); err != nil {
+		return err
+	}
+	var prefix [flatbuffers.SizeUOffsetT]byte
+	binary.LittleEndian.PutUint32(prefix[:], uint32(len(body)))
+	if _, err := c.w.Write(prefix[:]); err != nil {
+		return err
+	}
+	if _, err := c.w.Write(body); err != nil {
+		return err
+	}
+	return c.w.Flush()
+}
+
+// readMessage reads a size-prefixed message and returns it without its size
+// prefix.
+func (c *conn) readMessage() ([]byte, error) {
+	var prefix [flatbuffers.SizeUOffsetT]byte
+	if _, err := io.ReadFull(c.r, prefix[:]); err != nil {
+		return nil, err
+	}
+	size := binary.LittleEndian.Uint32(prefix[:])
+	if size > maxMessageSize {
+		return nil, fmt.Errorf("flatrpc: message of %d bytes is too large", size)
+	}
+	// The message grows as it is read, so that a corrupt size prefix cannot
+	// make us allocate more than the connection holds.
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, c.r, int64(size)); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *conn) readHeader() (h header, err error) {
+	buf, err := c.readMessage()
+	if err != nil {
+		return h, err
+	}
+	// A header with out of bounds offsets makes the table accessors panic.
+	defer func() {
+		if recover() != nil {
+			err = ErrMalformedHeader
+		}
+	}()
+	if len(buf) < flatbuffers.SizeUOffsetT {
+		return h, ErrMalformedHeader
+	}
+	t := flatbuffers.Table{Bytes: buf, Pos: flatbuffers.GetUOffsetT(buf)}
+	if o := flatbuffers.UOffsetT(t.Offset(slot(headerServiceMethod))); o != 0 {
+		h.serviceMethod = t.String(o + t.Pos)
+	}
+	if o := flatbuffers.UOffsetT(t.Offset(slot(headerSeq))); o != 0 {
+		h.seq = t.GetUint64(o + t.Pos)
+	}
+	if o := flatbuffers.UOffsetT(t.Offset(slot(headerError))); o != 0 {
+		h.err = t.String(o + t.Pos)
+	}
+	return h, nil
+}
+
+// readBody reads a body into x, or discards it if x is nil.
+func (c *conn) readBody(x interface{}) error {
+	buf, err := c.readMessage()
+	if err != nil || x == nil {
+		return err
+	}
+	return c.codec.Unmarshal(buf, x)
+}
+
+func slot(field int) flatbuffers.VOffsetT {
+	return flatbuffers.VOffsetT((field + 2) * flatbuffers.SizeVOffsetT)
+}
+
+type serverCodec struct {
+	conn
+}
+
+// NewServerCodec returns a new rpc.ServerCodec using FlatBuffers on conn.
+func NewServerCodec(conn io.ReadWriteCloser) rpc.ServerCodec {
+	return &serverCodec{newConn(conn)}
+}
+
+func (c *serverCodec) ReadRequestHeader(r *rpc.Request) error {
+	h, err := c.readHeader()
+	if err != nil {
+		return err
+	}
+	r.ServiceMethod = h.serviceMethod
+	r.Seq = h.seq
+	return nil
+}
+
+func (c *serverCodec) ReadRequestBody(x interface{}) error {
+	return c.readBody(x)
+}
+
+func (c *serverCodec) WriteResponse(r *rpc.Response, x interface{}) error {
+	h := header{serviceMethod: r.ServiceMethod, seq: r.Seq, err: r.Error}
+	if h.err != "" {
+		// x is a placeholder then, not a message.
+		return c.write(h, nil)
+	}
+	body, err := c.codec.Marshal(x)
+	if err != nil {
+		// Let the client know, rather than leaving the call hanging.
+		h.err = err.Error()
+		if werr := c.write(h, nil); werr != nil {
+			return werr
+		}
+		return err
+	}
+	return c.write(h, body)
+}
+
+func (c *serverCodec) Close() error {
+	return c.rwc.Close()
+}
+
+type clientCodec struct {
+	conn
+}
+
+// NewClientCodec returns a new rpc.ClientCodec using FlatBuffers on conn.
+func NewClientCodec(conn io.ReadWriteCloser) rpc.ClientCodec {
+	return &clientCodec{newConn(conn)}
+}
+
+func (c *clientCodec) WriteRequest(r *rpc.Request, x interface{}) error {
+	body, err := c.codec.Marshal(x)
+	if err != nil {
+		return err
+	}
+	return c.write(header{serviceMethod: r.ServiceMethod, seq: r.Seq}, body)
+}
+
+func (c *clientCodec) ReadResponseHeader(r *rpc.Response) error {
+	h, err := c.readHeader()
+	if err != nil {
+		return err
+	}
+	r.ServiceMethod = h.serviceMethod
+	r.Seq = h.seq
+	r.Error = h.err
+	return nil
+}
+
+func (c *clientCodec) ReadResponseBody(x interface{}) error {
+	return c.readBody(x)
+}
+
+func (c *clientCodec) Close() error {
+	return c.rwc.Close()
+}
+
+// NewClient returns a new rpc.Client to handle requests to the set of
+// services at the other end of the connection.
+func NewClient(conn io.ReadWriteCloser) *rpc.Client {
+	return rpc.NewClientWithCodec(NewClientCodec(conn))
+}
+
+// Dial connects to a FlatBuffers RPC server at the specified network
+// address.
+func Dial(network, address string) (*rpc.Client, error) {
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(conn), nil
+}
+
+// ServeConn runs the FlatBuffers RPC server on a single connection, with
+// the services registered on rpc.DefaultServer. ServeConn blocks, serving
+// the connection until the client hangs up.
+func ServeConn(conn io.ReadWriteCloser) {
+	rpc.ServeCodec(NewServerCodec(conn))
+}
@@ -0,0 +1,339 @@
+package gogen
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/google/flatbuffers/go/reflection"
+)
+
+// genEnum generates an enum type with its constants, name maps and String
+// method.
+func (g *generator) genEnum(e *reflection.EnumT, code *strings.Builder) {
+	def := newDefinition(e.Name)
+	g.curNamespace = def.namespaceKey()
+	enumType := g.enumTypeName(e)
+
+	maxNameLength := 0
+	for _, v := range e.Values {
+		if len(v.Name) > maxNameLength {
+			maxNameLength = len(v.Name)
+		}
+	}
+	pad := func(v *reflection.EnumValT) string {
+		return strings.Repeat(" ", maxNameLength-len(v.Name))
+	}
+
+	genComment(e.Documentation, code, "")
+	code.WriteString("type " + enumType + " " + genTypeBasic(e.UnderlyingType.BaseType) + "\n\n")
+	code.WriteString("const (\n")
+	for _, v := range e.Values {
+		genComment(v.Documentation, code, "\t")
+		code.WriteString("\t" + enumVariant(e, v) + " " + pad(v) + enumType + " = " + enumValue(e, v) + "\n")
+	}
+	code.WriteString(")\n\n")
+
+	code.WriteString("var EnumNames" + def.name + " = map[" + enumType + "]string{\n")
+	for _, v := range e.Values {
+		code.WriteString("\t" + enumVariant(e, v) + ": " + pad(v) + "\"" + v.Name + "\",\n")
+	}
+	code.WriteString("}\n\n")
+
+	code.WriteString("var EnumValues" + typeName(def.name) + " = map[string]" + enumType + "{\n")
+	for _, v := range e.Values {
+		code.WriteString("\t\"" + v.Name + "\": " + pad(v) + enumVariant(e, v) + ",\n")
+	}
+	code.WriteString("}\n\n")
+
+	_, bitFlags := attribute(e.Attributes, "bit_flags")
+	name := typeName(def.name)
+	code.WriteString("func (v " + name + ") String() string {\n")
+	code.WriteString("\tif s, ok := EnumNames" + name + "[v]; ok {\n")
+	code.WriteString("\t\treturn s\n")
+	code.WriteString("\t}\n")
+	if bitFlags {
+		code.WriteString("\tif s := v.format(\"|\"); s != \"\" {\n")
+		code.WriteString("\t\treturn s\n")
+		code.WriteString("\t}\n")
+	}
+	code.WriteString("\treturn \"" + def.name + "(\" + strconv.FormatInt(int64(v), 10) + \")\"\n")
+	code.WriteString("}\n\n")
+
+	g.genEnumValidation(e, bitFlags, code)
+	if bitFlags {
+		g.needsStrings = true
+		g.genEnumFlags(e, code)
+	}
+	g.genEnumText(e, bitFlags, code)
+}
+
+// genEnumValidation generates the list of values and the IsValid method of an
+// enum type.
+func (g *generator) genEnumValidation(e *reflection.EnumT, bitFlags bool, code *strings.Builder) {
+	name := typeName(newDefinition(e.Name).name)
+	code.WriteString("// " + name + "Values returns the values of " + name + ", in declaration order.\n")
+	code.WriteString("func " + name + "Values() []" + name + " {\n")
+	code.WriteString("\treturn []" + name + "{\n")
+	for _, v := range e.Values {
+		code.WriteString("\t\t" + enumVariant(e, v) + ",\n")
+	}
+	code.WriteString("\t}\n")
+	code.WriteString("}\n\n")
+
+	if bitFlags {
+		code.WriteString("// IsValid reports whether v is a combination of the flags of " + name + ".\n")
+		code.WriteString("func (v " + name + ") IsValid() bool {\n")
+		code.WriteString("\treturn v&^(")
+		for i, v := range e.Values {
+			if i > 0 {
+				code.WriteString("|")
+			}
+			code.WriteString(enumVariant(e, v))
+		}
+		code.WriteString(") == 0\n")
+		code.WriteString("}\n\n")
+	} else {
+		code.WriteString("// IsValid reports whether v is a value of " + name + ".\n")
+		code.WriteString("func (v " + name + ") IsValid() bool {\n")
+		code.WriteString("\t_, ok := EnumNames" + name + "[v]\n")
+		code.WriteString("\treturn ok\n")
+		code.WriteString("}\n\n")
+	}
+}
+
+// genEnumFlags generates the set operations of a bit_flags enum type.
+func (g *generator) genEnumFlags(e *reflection.EnumT, code *strings.Builder) {
+	name := typeName(newDefinition(e.Name).name)
+	code.WriteString("// Has reports whether all of flags are set in v.\n")
+	code.WriteString("func (v " + name + ") Has(flags " + name + ") bool {\n")
+	code.WriteString("\treturn v&flags == flags\n")
+	code.WriteString("}\n\n")
+	code.WriteString("// Set sets flags in v.\n")
+	code.WriteString("func (v *" + name + ") Set(flags " + name + ") {\n")
+	code.WriteString("\t*v |= flags\n")
+	code.WriteString("}\n\n")
+	code.WriteString("// Clear clears flags in v.\n")
+	code.WriteString("func (v *" + name + ") Clear(flags " + name + ") {\n")
+	code.WriteString("\t*v &^= flags\n")
+	code.WriteString("}\n\n")
+
+	code.WriteString("// format joins the names of the flags set in v with sep, or returns \"\" if v\n// is not a combination of flags.\n")
+	code.WriteString("func (v " + name + ") format(sep string) string {\n")
+	code.WriteString("\tif v == 0 || !v.IsValid() {\n")
+	code.WriteString("\t\treturn \"\"\n")
+	code.WriteString("\t}\n")
+	code.WriteString("\tvar names []string\n")
+	code.WriteString("\tfor _, f := range " + name + "Values() {\n")
+	code.WriteString("\t\tif v.Has(f) {\n")
+	code.WriteString("\t\t\tnames = append(names, EnumNames" + name + "[f])\n")
+	code.WriteString("\t\t}\n")
+	code.WriteString("\t}\n")
+	code.WriteString("\treturn strings.Join(names, sep)\n")
+	code.WriteString("}\n\n")
+}
+
+// genEnumText generates the text marshaling methods and the parser of an enum
+// type.
+func (g *generator) genEnumText(e *reflection.EnumT, bitFlags bool, code *strings.Builder) {
+	name := typeName(newDefinition(e.Name).name)
+	base := e.UnderlyingType.BaseType
+	bits := strconv.Itoa(scalarSizes[base] * 8)
+	format, parse := "strconv.FormatInt(int64(v), 10)", "strconv.ParseInt("
+	if isUnsigned(base) {
+		format, parse = "strconv.FormatUint(uint64(v), 10)", "strconv.ParseUint("
+	}
+
+	if bitFlags {
+		code.WriteString("// MarshalText formats v like flatc's JSON output: as the name of a value, as\n// the names of its flags separated by spaces, or as a number.\n")
+	} else {
+		code.WriteString("// MarshalText formats v like flatc's JSON output: as the name of a value, or\n// as a number.\n")
+	}
+	code.WriteString("func (v " + name + ") MarshalText() ([]byte, error) {\n")
+	code.WriteString("\tif s, ok := EnumNames" + name + "[v]; ok {\n")
+	code.WriteString("\t\treturn []byte(s), nil\n")
+	code.WriteString("\t}\n")
+	if bitFlags {
+		code.WriteString("\tif s := v.format(\" \"); s != \"\" {\n")
+		code.WriteString("\t\treturn []byte(s), nil\n")
+		code.WriteString("\t}\n")
+	}
+	code.WriteString("\treturn []byte(" + format + "), nil\n")
+	code.WriteString("}\n\n")
+
+	code.WriteString("// UnmarshalText parses text like Parse" + name + ".\n")
+	code.WriteString("func (v *" + name + ") UnmarshalText(text []byte) error {\n")
+	code.WriteString("\tx, err := Parse" + name + "(string(text))\n")
+	code.WriteString("\tif err != nil {\n")
+	code.WriteString("\t\treturn err\n")
+	code.WriteString("\t}\n")
+	code.WriteString("\t*v = x\n")
+	code.WriteString("\treturn nil\n")
+	code.WriteString("}\n\n")
+
+	invalid := "errors.New(\"invalid " + name + ": \" + strconv.Quote(s))"
+	if bitFlags {
+		code.WriteString("// Parse" + name + " parses the names or numbers of " + name + " flags, separated by\n// spaces as in flatc's JSON or by \"|\" as in String.\n")
+		code.WriteString("func Parse" + name + "(s string) (" + name + ", error) {\n")
+		code.WriteString("\tfields := strings.FieldsFunc(s, func(r rune) bool { return r == ' ' || r == '|' })\n")
+		code.WriteString("\tif len(fields) == 0 {\n")
+		code.WriteString("\t\treturn 0, " + invalid + "\n")
+		code.WriteString("\t}\n")
+		code.WriteString("\tvar v " + name + "\n")
+		code.WriteString("\tfor _, f := range fields {\n")
+		code.WriteString("\t\tif x, ok := EnumValues" + name + "[f]; ok {\n")
+		code.WriteString("\t\t\tv |= x\n")
+		code.WriteString("\t\t\tcontinue\n")
+		code.WriteString("\t\t}\n")
+		code.WriteString("\t\tn, err := " + parse + "f, 10, " + bits + ")\n")
+		code.WriteString("\t\tif err != nil {\n")
+		code.WriteString("\t\t\treturn 0, " + invalid + "\n")
+		code.WriteString("\t\t}\n")
+		code.WriteString("\t\tv |= " + name + "(n)\n")
+		code.WriteString("\t}\n")
+		code.WriteString("\treturn v, nil\n")
+	} else {
+		code.WriteString("// Parse" + name + " parses the name or the number of a " + name + " value.\n")
+		code.WriteString("func Parse" + name + "(s string) (" + name + ", error) {\n")
+		code.WriteString("\tif v, ok := EnumValues" + name + "[s]; ok {\n")
+		code.WriteString("\t\treturn v, nil\n")
+		code.WriteString("\t}\n")
+		code.WriteString("\tn, err := " + parse + "s, 10, " + bits + ")\n")
+		code.WriteString("\tif err != nil {\n")
+		code.WriteString("\t\treturn 0, " + invalid + "\n")
+		code.WriteString("\t}\n")
+		code.WriteString("\treturn " + name + "(n), nil\n")
+	}
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) genNativeUnion(e *reflection.EnumT, code *strings.Builder) {
+	name := newDefinition(e.Name).name
+	code.WriteString("type " + objectTypeName(name) + " struct {\n")
+	code.WriteString("\tType " + typeName(name) + "\n")
+	code.WriteString("\tValue interface{}\n")
+	code.WriteString("}\n\n")
+
+	code.WriteString("// Reset sets t to the zero " + objectTypeName(name) + ".\n")
+	code.WriteString("func (t *" + objectTypeName(name) + ") Reset() {\n")
+	code.WriteString("\t*t = " + objectTypeName(name) + "{}\n")
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) genNativeUnionPack(e *reflection.EnumT, code *strings.Builder) {
+	name := newDefinition(e.Name).name
+	code.WriteString("func (t *" + objectTypeName(name) + ") Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {\n")
+	code.WriteString("\tif t == nil {\n\t\treturn 0\n\t}\n")
+	code.WriteString("\tswitch t.Type {\n")
+	for _, v := range e.Values {
+		if v.Value == 0 {
+			continue
+		}
+		code.WriteString("\tcase " + enumVariant(e, v) + ":\n")
+		code.WriteString("\t\treturn t.Value.(" + g.nativeType(g.fieldType(v.UnionType)) + ").Pack(builder)\n")
+	}
+	code.WriteString("\t}\n")
+	code.WriteString("\treturn 0\n")
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) genNativeUnionUnPack(e *reflection.EnumT, code *strings.Builder) {
+	name := newDefinition(e.Name).name
+	nativeType := g.qualify(e.Name, objectTypeName(name))
+	code.WriteString("func (rcv " + typeName(name) + ") UnPack(table flatbuffers.Table) *" + objectTypeName(name) + " {\n")
+	code.WriteString("\treturn rcv.UnPackTo(table, nil, nil)\n")
+	code.WriteString("}\n\n")
+
+	code.WriteString("// UnPackTo unpacks the member of the union in table into t, reusing t and the\n")
+	code.WriteString("// value it holds if they are not nil, and returns t, or nil if rcv is not a\n")
+	code.WriteString("// member. Strings are taken from interner, which may be nil.\n")
+	code.WriteString("func (rcv " + typeName(name) + ") UnPackTo(table flatbuffers.Table, t *" + objectTypeName(name) +
+		", interner *flatbuffers.Interner) *" + objectTypeName(name) + " {\n")
+	code.WriteString("\tswitch rcv {\n")
+	for _, v := range e.Values {
+		if v.Value == 0 {
+			continue
+		}
+		member := g.fieldType(v.UnionType).object
+		code.WriteString("\tcase " + enumVariant(e, v) + ":\n")
+		code.WriteString("\t\tvar x " + g.qualify(member.Name, newDefinition(member.Name).name) + "\n")
+		code.WriteString("\t\tx.Init(table.Bytes, table.Pos)\n")
+		code.WriteString("\t\tif t == nil {\n")
+		code.WriteString("\t\t\tt = &" + nativeType + "{}\n")
+		code.WriteString("\t\t}\n")
+		code.WriteString("\t\tv, _ := t.Value.(" + g.nativeType(g.fieldType(v.UnionType)) + ")\n")
+		code.WriteString("\t\tif v == nil {\n")
+		code.WriteString("\t\t\tv = &" + g.nativeObjectName(member) + "{}\n")
+		code.WriteString("\t\t}\n")
+		code.WriteString("\t\tx.UnPackToInterned(v, interner)\n")
+		code.WriteString("\t\tt.Type, t.Value = " + enumVariant(e, v) + ", v\n")
+		code.WriteString("\t\treturn t\n")
+	}
+	code.WriteString("\t}\n")
+	code.WriteString("\treturn nil\n")
+	code.WriteString("}\n\n")
+}
+
+// genEnumRandom generates a function that takes a random value of an enum
+// from r.
+func (g *generator) genEnumRandom(e *reflection.EnumT, code *strings.Builder) {
+	name := typeName(newDefinition(e.Name).name)
+	code.WriteString("// Random" + name + " returns one of the values of " + name + " from r.\n")
+	code.WriteString("func Random" + name + "(r *flatbuffers.Random) " + name + " {\n")
+	code.WriteString("\tvalues := " + name + "Values()\n")
+	code.WriteString("\treturn values[r.Intn(len(values))]\n")
+	code.WriteString("}\n\n")
+}
+
+// genUnionRandom generates a function that makes a random member of a union,
+// or none.
+func (g *generator) genUnionRandom(e *reflection.EnumT, code *strings.Builder) {
+	name := newDefinition(e.Name).name
+	nativeType := objectTypeName(name)
+	members := 0
+	for _, v := range e.Values {
+		if v.Value != 0 {
+			members++
+		}
+	}
+	code.WriteString("// Random" + nativeType + " returns a random member of " + typeName(name) + " from r, or nil.\n")
+	code.WriteString("func Random" + nativeType + "(r *flatbuffers.Random) *" + nativeType + " {\n")
+	code.WriteString("\tif !r.Child() {\n\t\treturn nil\n\t}\n")
+	code.WriteString("\tswitch r.Intn(" + strconv.Itoa(members) + ") {\n")
+	member := 0
+	for _, v := range e.Values {
+		if v.Value == 0 {
+			continue
+		}
+		code.WriteString("\tcase " + strconv.Itoa(member) + ":\n")
+		code.WriteString("\t\treturn &" + nativeType + "{Type: " + enumVariant(e, v) + ", Value: " +
+			g.randomValue(g.fieldType(v.UnionType)) + "}\n")
+		member++
+	}
+	code.WriteString("\t}\n")
+	code.WriteString("\treturn nil\n")
+	code.WriteString("}\n\n")
+}
+
+// genUnionSplice generates a method that copies the member of a union, for
+// the Splice methods of the tables that hold it.
+func (g *generator) genUnionSplice(e *reflection.EnumT, code *strings.Builder) {
+	name := newDefinition(e.Name).name
+	code.WriteString("// Splice writes a copy of the member of the union in table to builder, and\n")
+	code.WriteString("// returns its offset.\n")
+	code.WriteString("func (rcv " + typeName(name) +
+		") Splice(builder *flatbuffers.Builder, table flatbuffers.Table) flatbuffers.UOffsetT {\n")
+	code.WriteString("\tswitch rcv {\n")
+	for _, v := range e.Values {
+		if v.Value == 0 {
+			continue
+		}
+		member := g.fieldType(v.UnionType).object
+		code.WriteString("\tcase " + enumVariant(e, v) + ":\n")
+		code.WriteString("\t\tvar x " + g.qualify(member.Name, newDefinition(member.Name).name) + "\n")
+		code.WriteString("\t\tx.Init(table.Bytes, table.Pos)\n")
+		code.WriteString("\t\treturn x.Splice(builder)\n")
+	}
+	code.WriteString("\t}\n")
+	code.WriteString("\treturn 0\n")
+	code.WriteString("}\n\n")
+}
@@ -0,0 +1,676 @@
+// Package gogen generates Go code from a binary schema (.bfbs, from `flatc
+// --binary --schema`). It is a port of flatc's Go generator and writes the
+// same files as `flatc --go`, so Go bindings can be regenerated without a C++
+// toolchain. The flatc-gen-go command wraps it for use with `go generate`.
+//
+// Like flatc, it rejects schemas that Go does not support, such as unions of
+// structs or strings. Unlike flatc, it writes no file for a union declared in
+// an included schema, where `flatc --go --gen-object-api` writes one holding
+// only the object API of the union.
+package gogen
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/flatbuffers/go/reflection"
+)
+
+// Options selects what is generated. Each option mirrors the flatc flag
+// named in its comment.
+type Options struct {
+	// ObjectAPI generates the T types with Pack and UnPack methods
+	// (--gen-object-api).
+	ObjectAPI bool
+	// Namespace places all generated code in this dotted namespace instead of
+	// the schema's (--go-namespace).
+	Namespace string
+	// Import is the import path of the FlatBuffers library, by default
+	// github.com/google/flatbuffers/go (--go-import).
+	Import string
+	// ModuleName prefixes the import paths of generated packages
+	// (--go-module-name).
+	ModuleName string
+	// All generates the types of included schemas too (--gen-all). It has no
+	// effect if the schema was built without --bfbs-filenames, in which case
+	// every type is generated.
+	All bool
+}
+
+// File is a generated Go source file.
+type File struct {
+	// Path is slash-separated and relative to the output directory, such as
+	// `MyGame/Example/Monster.go`.
+	Path    string
+	Content []byte
+}
+
+// ErrInvalidSchema is returned by Generate if its input is not a binary
+// schema.
+var ErrInvalidSchema = errors.New("gogen: not a binary schema (.bfbs)")
+
+// Generate returns the Go files for the types in the binary schema bfbs: one
+// per enum, struct and table, in a directory per namespace.
+func Generate(bfbs []byte, opts Options) ([]File, error) {
+	if len(bfbs) < 8 || !reflection.SchemaBufferHasIdentifier(bfbs) {
+		return nil, ErrInvalidSchema
+	}
+	schema, err := unpackSchema(bfbs)
+	if err != nil {
+		return nil, err
+	}
+	g := &generator{schema: schema, opts: opts}
+	if opts.Namespace != "" {
+		g.goNamespace = strings.Split(opts.Namespace, ".")
+	}
+	return g.generate()
+}
+
+func unpackSchema(bfbs []byte) (schema *reflection.SchemaT, err error) {
+	defer func() {
+		if recover() != nil {
+			schema, err = nil, ErrInvalidSchema
+		}
+	}()
+	return reflection.GetRootAsSchema(bfbs, 0).UnPack(), nil
+}
+
+// definition is the name of an enum, struct or table split from its
+// namespace.
+type definition struct {
+	name      string
+	namespace []string
+}
+
+func newDefinition(fullName string) definition {
+	parts := strings.Split(fullName, ".")
+	return definition{name: parts[len(parts)-1], namespace: parts[:len(parts)-1]}
+}
+
+func (d definition) namespaceKey() string { return strings.Join(d.namespace, ".") }
+
+// fieldType is a reflection.Type with its enum or object resolved.
+type fieldType struct {
+	base, element reflection.BaseType
+	object        *reflection.ObjectT
+	enum          *reflection.EnumT
+}
+
+func (t fieldType) vectorType() fieldType {
+	return fieldType{base: t.element, object: t.object, enum: t.enum}
+}
+
+func isScalar(t reflection.BaseType) bool {
+	return t >= reflection.BaseTypeUType && t <= reflection.BaseTypeDouble
+}
+
+func isUnsigned(t reflection.BaseType) bool {
+	switch t {
+	case reflection.BaseTypeUType, reflection.BaseTypeUByte, reflection.BaseTypeUShort,
+		reflection.BaseTypeUInt, reflection.BaseTypeULong:
+		return true
+	}
+	return false
+}
+
+func isStruct(t fieldType) bool {
+	return t.base == reflection.BaseTypeObj && t.object.IsStruct
+}
+
+func isOptionalScalar(f *reflection.FieldT) bool {
+	return isScalar(f.Type.BaseType) && f.Optional
+}
+
+func attribute(attrs []*reflection.KeyValueT, key string) (string, bool) {
+	for _, kv := range attrs {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return "", false
+}
+
+func hasKey(o *reflection.ObjectT) bool {
+	for _, f := range o.Fields {
+		if f.Key {
+			return true
+		}
+	}
+	return false
+}
+
+type generator struct {
+	schema      *reflection.SchemaT
+	opts        Options
+	goNamespace []string
+	// roots are the schema files that were compiled, as opposed to included.
+	roots map[string]bool
+
+	curNamespace string
+	// imports holds, for each imported namespace, the first type used from
+	// it.
+	imports       map[string]definition
+	needsMath     bool
+	needsBytes    bool
+	needsFlathash bool
+	needsStrings  bool
+
+	files []File
+}
+
+func (g *generator) generate() ([]File, error) {
+	included := map[string]bool{}
+	for _, f := range g.schema.FbsFiles {
+		for _, name := range f.IncludedFilenames {
+			included[name] = true
+		}
+	}
+	g.roots = map[string]bool{}
+	for _, f := range g.schema.FbsFiles {
+		if !included[f.Filename] {
+			g.roots[f.Filename] = true
+		}
+	}
+	// Fields are stored sorted by name; generate them in declaration order.
+	for _, o := range g.schema.Objects {
+		sort.SliceStable(o.Fields, func(i, j int) bool { return o.Fields[i].Id < o.Fields[j].Id })
+	}
+
+	for _, e := range g.schema.Enums {
+		if !g.inScope(e.DeclarationFile) {
+			continue
+		}
+		if err := g.checkUnionSupported(e); err != nil {
+			return nil, err
+		}
+		g.resetImports()
+		needsImports := false
+		var code strings.Builder
+		g.genEnum(e, &code)
+		if e.IsUnion && g.opts.ObjectAPI {
+			g.genNativeUnion(e, &code)
+			g.genNativeUnionPack(e, &code)
+			g.genNativeUnionUnPack(e, &code)
+			g.genUnionSplice(e, &code)
+			g.genUnionRandom(e, &code)
+			needsImports = true
+		} else if g.opts.ObjectAPI {
+			g.genEnumRandom(e, &code)
+			needsImports = true
+		}
+		g.saveType(newDefinition(e.Name), code.String(), needsImports, true)
+	}
+
+	for _, o := range g.schema.Objects {
+		if !g.inScope(o.DeclarationFile) {
+			continue
+		}
+		if err := g.checkSupported(o); err != nil {
+			return nil, err
+		}
+		g.resetImports()
+		var code strings.Builder
+		g.genStruct(o, &code)
+		g.saveType(newDefinition(o.Name), code.String(), true, false)
+	}
+	return g.files, nil
+}
+
+// inScope reports whether a type declared in file is generated. By default
+// only the types of the compiled schema are, which is told apart from its
+// includes as the file no other file includes.
+func (g *generator) inScope(file string) bool {
+	return g.opts.All || file == "" || len(g.roots) == 0 || g.roots[file]
+}
+
+func (g *generator) checkSupported(o *reflection.ObjectT) error {
+	for _, f := range o.Fields {
+		t := f.Type
+		switch {
+		case t.BaseType == reflection.BaseTypeArray:
+			return fmt.Errorf("gogen: %s.%s: fixed length arrays are not supported in Go", o.Name, f.Name)
+		case t.BaseType == reflection.BaseTypeVector64 || f.Offset64:
+			return fmt.Errorf("gogen: %s.%s: 64-bit offsets are not supported in Go", o.Name, f.Name)
+		case t.BaseType == reflection.BaseTypeVector && t.Element == reflection.BaseTypeUnion:
+			return fmt.Errorf("gogen: %s.%s: vectors of unions are not supported in Go", o.Name, f.Name)
+		}
+	}
+	return nil
+}
+
+// checkUnionSupported returns an error if e is a union with a member that is
+// not a table, as flatc does for Go.
+func (g *generator) checkUnionSupported(e *reflection.EnumT) error {
+	if !e.IsUnion {
+		return nil
+	}
+	for _, v := range e.Values {
+		if v.Value == 0 {
+			continue
+		}
+		if v.UnionType == nil {
+			return fmt.Errorf("gogen: %s.%s: union member has no type", e.Name, v.Name)
+		}
+		if member := g.fieldType(v.UnionType).object; member == nil || member.IsStruct {
+			return fmt.Errorf("gogen: %s.%s: unions of structs and strings are not supported in Go", e.Name, v.Name)
+		}
+	}
+	return nil
+}
+
+func (g *generator) fieldType(t *reflection.TypeT) fieldType {
+	ft := fieldType{base: t.BaseType, element: t.Element}
+	base := t.BaseType
+	if base == reflection.BaseTypeVector || base == reflection.BaseTypeArray {
+		base = t.Element
+	}
+	if t.Index >= 0 {
+		if base == reflection.BaseTypeObj {
+			ft.object = g.schema.Objects[t.Index]
+		} else {
+			ft.enum = g.schema.Enums[t.Index]
+		}
+	}
+	return ft
+}
+
+// nestedFlatBuffer returns the root table of a `nested_flatbuffer` field. The
+// attribute names it relative to the namespace of the owning table.
+func (g *generator) nestedFlatBuffer(owner *reflection.ObjectT, f *reflection.FieldT) *reflection.ObjectT {
+	name, ok := attribute(f.Attributes, "nested_flatbuffer")
+	if !ok {
+		return nil
+	}
+	ns := newDefinition(owner.Name).namespace
+	for i := len(ns); i >= 0; i-- {
+		full := strings.Join(append(ns[:i:i], name), ".")
+		for _, o := range g.schema.Objects {
+			if o.Name == full {
+				return o
+			}
+		}
+	}
+	return nil
+}
+
+func (g *generator) resetImports() {
+	g.imports = map[string]definition{}
+	g.needsBytes = false
+	g.needsFlathash = false
+	g.needsMath = false
+	g.needsStrings = false
+}
+
+// qualify prefixes name with the import name of fullName's package if it is
+// used outside of its namespace.
+func (g *generator) qualify(fullName, name string) string {
+	def := newDefinition(fullName)
+	key := def.namespaceKey()
+	if key == g.curNamespace {
+		return name
+	}
+	if _, ok := g.imports[key]; !ok {
+		g.imports[key] = def
+	}
+	if len(def.namespace) == 0 {
+		return def.name + "." + name
+	}
+	return namespaceName(def.namespace) + "." + name
+}
+
+// namespaceImportPath returns the import path of a namespace, e.g.
+// MyGame/Example.
+func (g *generator) namespaceImportPath(ns []string) string {
+	path := strings.Join(ns, "/")
+	if g.opts.ModuleName != "" {
+		path = g.opts.ModuleName + "/" + path
+	}
+	return path
+}
+
+func genComment(doc []string, code *strings.Builder, prefix string) {
+	for _, line := range doc {
+		code.WriteString(prefix + "///" + line + "\n")
+	}
+}
+
+// offsetPrefix begins the body of most field accessors, which first look up
+// the field's offset.
+func offsetPrefix(f *reflection.FieldT) string {
+	return "{\n\to := flatbuffers.UOffsetT(rcv._tab.Offset(" + strconv.Itoa(int(f.Offset)) + "))\n\tif o != 0 {\n"
+}
+
+var goTypes = map[reflection.BaseType]string{
+	reflection.BaseTypeNone:   "byte",
+	reflection.BaseTypeUType:  "byte",
+	reflection.BaseTypeBool:   "bool",
+	reflection.BaseTypeByte:   "int8",
+	reflection.BaseTypeUByte:  "byte",
+	reflection.BaseTypeShort:  "int16",
+	reflection.BaseTypeUShort: "uint16",
+	reflection.BaseTypeInt:    "int32",
+	reflection.BaseTypeUInt:   "uint32",
+	reflection.BaseTypeLong:   "int64",
+	reflection.BaseTypeULong:  "uint64",
+	reflection.BaseTypeFloat:  "float32",
+	reflection.BaseTypeDouble: "float64",
+}
+
+func genTypeBasic(t reflection.BaseType) string {
+	if s, ok := goTypes[t]; ok {
+		return s
+	}
+	return "int"
+}
+
+var scalarSizes = map[reflection.BaseType]int{
+	reflection.BaseTypeNone:   1,
+	reflection.BaseTypeUType:  1,
+	reflection.BaseTypeBool:   1,
+	reflection.BaseTypeByte:   1,
+	reflection.BaseTypeUByte:  1,
+	reflection.BaseTypeShort:  2,
+	reflection.BaseTypeUShort: 2,
+	reflection.BaseTypeInt:    4,
+	reflection.BaseTypeUInt:   4,
+	reflection.BaseTypeLong:   8,
+	reflection.BaseTypeULong:  8,
+	reflection.BaseTypeFloat:  4,
+	reflection.BaseTypeDouble: 8,
+}
+
+func inlineSize(t fieldType) int {
+	if isStruct(t) {
+		return int(t.object.Bytesize)
+	}
+	if size, ok := scalarSizes[t.base]; ok {
+		return size
+	}
+	return 4
+}
+
+func inlineAlignment(t fieldType) int {
+	if isStruct(t) {
+		return int(t.object.Minalign)
+	}
+	if size, ok := scalarSizes[t.base]; ok {
+		return size
+	}
+	return 4
+}
+
+func (g *generator) enumTypeName(e *reflection.EnumT) string {
+	return g.qualify(e.Name, typeName(newDefinition(e.Name).name))
+}
+
+func enumVariant(e *reflection.EnumT, v *reflection.EnumValT) string {
+	return typeName(newDefinition(e.Name).name) + typeName(v.Name)
+}
+
+func enumValue(e *reflection.EnumT, v *reflection.EnumValT) string {
+	if e.UnderlyingType.BaseType == reflection.BaseTypeULong {
+		return strconv.FormatUint(uint64(v.Value), 10)
+	}
+	return strconv.FormatInt(v.Value, 10)
+}
+
+// genGetter returns the function that reads a value of type t.
+func (g *generator) genGetter(t fieldType) string {
+	switch t.base {
+	case reflection.BaseTypeString:
+		return "rcv._tab.ByteVector"
+	case reflection.BaseTypeUnion:
+		return "rcv._tab.Union"
+	case reflection.BaseTypeVector:
+		return g.genGetter(t.vectorType())
+	}
+	return "rcv._tab.Get" + methodName(genTypeBasic(t.base))
+}
+
+// genMethod returns the suffix of the builder method that adds the field.
+func genMethod(t fieldType) string {
+	if isScalar(t.base) {
+		return methodName(genTypeBasic(t.base))
+	}
+	if isStruct(t) {
+		return "Struct"
+	}
+	return "UOffsetT"
+}
+
+func (g *generator) genTypePointer(t fieldType) string {
+	switch t.base {
+	case reflection.BaseTypeString:
+		return "[]byte"
+	case reflection.BaseTypeVector:
+		return g.genTypeGet(t.vectorType())
+	case reflection.BaseTypeObj:
+		return g.qualify(t.object.Name, newDefinition(t.object.Name).name)
+	}
+	return "*flatbuffers.Table"
+}
+
+func (g *generator) genTypeGet(t fieldType) string {
+	if t.enum != nil {
+		return g.enumTypeName(t.enum)
+	}
+	if isScalar(t.base) {
+		return genTypeBasic(t.base)
+	}
+	return g.genTypePointer(t)
+}
+
+func (g *generator) typeName(f *reflection.FieldT) string {
+	prefix := ""
+	if isOptionalScalar(f) {
+		prefix = "*"
+	}
+	return prefix + g.genTypeGet(g.fieldType(f.Type))
+}
+
+// castToEnum converts value to t if t is an enum.
+func (g *generator) castToEnum(t fieldType, value string) string {
+	if t.enum == nil {
+		return value
+	}
+	return g.genTypeGet(t) + "(" + value + ")"
+}
+
+// castToBaseType converts value to the underlying type of t if t is an enum.
+func castToBaseType(t fieldType, value string) string {
+	if t.enum == nil {
+		return value
+	}
+	return genTypeBasic(t.base) + "(" + value + ")"
+}
+
+// hashedValue returns an expression hashing the string value with the
+// algorithm named by the field's hash attribute, converted to t.
+func (g *generator) hashedValue(f *reflection.FieldT, t fieldType, value string) string {
+	// "fnv1a_32" maps to flathash.Fnv1aHash32.
+	algorithm, _ := attribute(f.Attributes, "hash")
+	sep := strings.IndexByte(algorithm, '_')
+	function := strings.ToUpper(algorithm[:1]) + algorithm[1:sep] + "Hash" + algorithm[sep+1:]
+	g.needsFlathash = true
+	return g.genTypeGet(t) + "(flathash." + function + "(" + value + "))"
+}
+
+func isHashed(f *reflection.FieldT) bool {
+	_, ok := attribute(f.Attributes, "hash")
+	return ok
+}
+
+func (g *generator) genConstant(f *reflection.FieldT) string {
+	if isOptionalScalar(f) {
+		return "nil"
+	}
+	switch f.Type.BaseType {
+	case reflection.BaseTypeBool:
+		if f.DefaultInteger == 0 {
+			return "false"
+		}
+		return "true"
+	case reflection.BaseTypeFloat, reflection.BaseTypeDouble:
+		floatType := "float64"
+		if f.Type.BaseType == reflection.BaseTypeFloat {
+			floatType = "float32"
+		}
+		v := f.DefaultReal
+		switch {
+		case math.IsNaN(v):
+			g.needsMath = true
+			return floatType + "(math.NaN())"
+		case math.IsInf(v, 1):
+			g.needsMath = true
+			return floatType + "(math.Inf(1))"
+		case math.IsInf(v, -1):
+			g.needsMath = true
+			return floatType + "(math.Inf(-1))"
+		}
+		// flatc prints defaults as written in the schema, with ".0" added to
+		// whole numbers.
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+		return s
+	case reflection.BaseTypeULong:
+		return strconv.FormatUint(uint64(f.DefaultInteger), 10)
+	}
+	return strconv.FormatInt(f.DefaultInteger, 10)
+}
+
+func (g *generator) nativeType(t fieldType) string {
+	switch {
+	case isScalar(t.base):
+		if t.enum == nil {
+			return genTypeBasic(t.base)
+		}
+		return g.enumTypeName(t.enum)
+	case t.base == reflection.BaseTypeString:
+		return "string"
+	case t.base == reflection.BaseTypeVector:
+		return "[]" + g.nativeType(t.vectorType())
+	case t.base == reflection.BaseTypeObj:
+		return "*" + g.qualify(t.object.Name, objectTypeName(newDefinition(t.object.Name).name))
+	case t.base == reflection.BaseTypeUnion:
+		return "*" + g.qualify(t.enum.Name, objectTypeName(newDefinition(t.enum.Name).name))
+	}
+	panic("gogen: unexpected type " + t.base.String())
+}
+
+// nativeFieldType returns the object API type of a table field.
+// nested_flatbuffer fields hold the unpacked nested root rather than its
+// bytes.
+func (g *generator) nativeFieldType(owner *reflection.ObjectT, f *reflection.FieldT) string {
+	if nested := g.nestedFlatBuffer(owner, f); nested != nil {
+		return "*" + g.qualify(nested.Name, objectTypeName(newDefinition(nested.Name).name))
+	}
+	return g.nativeType(g.fieldType(f.Type))
+}
+
+// beginFile declares the package and its imports.
+func (g *generator) beginFile(pkg string, needsImports, isEnum bool, code *strings.Builder) {
+	code.WriteString("// Code generated by the FlatBuffers compiler. DO NOT EDIT.\n\n")
+	code.WriteString("package " + pkg + "\n\n")
+	if !needsImports {
+		if isEnum {
+			code.WriteString("import (\n")
+			code.WriteString("\t\"errors\"\n")
+			code.WriteString("\t\"strconv\"\n")
+			if g.needsStrings {
+				code.WriteString("\t\"strings\"\n")
+			}
+			code.WriteString(")\n\n")
+		}
+		if g.needsMath {
+			// math is needed to support non-finite scalar default values.
+			code.WriteString("import \"math\"\n\n")
+		}
+		return
+	}
+	code.WriteString("import (\n")
+	// Standard imports, in alphabetical order for go fmt.
+	if g.needsBytes {
+		code.WriteString("\t\"bytes\"\n")
+	}
+	if isEnum {
+		code.WriteString("\t\"errors\"\n")
+	}
+	flatbuffersImport := g.opts.Import
+	if flatbuffersImport == "" {
+		flatbuffersImport = "github.com/google/flatbuffers/go"
+	}
+	code.WriteString("\tflatbuffers \"" + flatbuffersImport + "\"\n")
+	if g.needsFlathash {
+		code.WriteString("\tflathash \"" + flatbuffersImport + "/flathash\"\n")
+	}
+	if g.needsMath {
+		code.WriteString("\t\"math\"\n")
+	}
+	if isEnum {
+		code.WriteString("\t\"strconv\"\n")
+	}
+	if g.needsStrings {
+		code.WriteString("\t\"strings\"\n")
+	}
+	if len(g.imports) > 0 {
+		imports := make([]definition, 0, len(g.imports))
+		for _, def := range g.imports {
+			imports = append(imports, def)
+		}
+		sort.Slice(imports, func(i, j int) bool {
+			return namespaceLess(imports[i].namespace, imports[j].namespace)
+		})
+		code.WriteString("\n")
+		for _, def := range imports {
+			if len(def.namespace) == 0 {
+				code.WriteString("\t" + def.name + " \"" + def.name + "\"\n")
+			} else {
+				code.WriteString("\t" + namespaceName(def.namespace) + " \"" + g.namespaceImportPath(def.namespace) + "\"\n")
+			}
+		}
+	}
+	code.WriteString(")\n\n")
+}
+
+func namespaceLess(a, b []string) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
+
+// saveType adds the file holding the code of one type.
+func (g *generator) saveType(def definition, classCode string, needsImports, isEnum bool) {
+	if classCode == "" {
+		return
+	}
+	ns := def.namespace
+	if len(g.goNamespace) > 0 {
+		ns = g.goNamespace
+	}
+	pkg := def.name
+	if len(ns) > 0 {
+		pkg = ns[len(ns)-1]
+	}
+	var code strings.Builder
+	g.beginFile(pkg, needsImports, isEnum, &code)
+	code.WriteString(classCode)
+	// Strip extra newlines at the end of the file to make it gofmt-clean.
+	content := code.String()
+	for len(content) > 2 && strings.HasSuffix(content, "\n\n") {
+		content = content[:len(content)-1]
+	}
+	path := def.name + ".go"
+	if len(ns) > 0 {
+		path = strings.Join(ns, "/") + "/" + path
+	}
+	g.files = append(g.files, File{Path: path, Content: []byte(content)})
+}
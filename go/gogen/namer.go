@@ -0,0 +1,114 @@
+package gogen
+
+import "strings"
+
+// goKeywords are escaped with a trailing underscore when they appear as
+// identifiers, see https://golang.org/ref/spec#Keywords.
+var goKeywords = map[string]bool{
+	"break": true, "default": true, "func": true, "interface": true,
+	"select": true, "case": true, "defer": true, "go": true, "map": true,
+	"struct": true, "chan": true, "else": true, "goto": true, "package": true,
+	"switch": true, "const": true, "fallthrough": true, "if": true,
+	"range": true, "type": true, "continue": true, "for": true, "import": true,
+	"return": true, "var": true,
+}
+
+// The helpers below follow the naming rules of flatc's Go generator: types,
+// enum variants and namespaces keep the schema spelling, methods, functions
+// and fields are UpperCamelCase and variables lowerCamelCase.
+
+func escapeKeyword(s string) string {
+	if goKeywords[s] {
+		return s + "_"
+	}
+	return s
+}
+
+func typeName(s string) string { return escapeKeyword(s) }
+
+func objectTypeName(s string) string { return typeName(s) + "T" }
+
+func methodName(s string) string { return escapeKeyword(toCamelCase(camelToSnake(s), true)) }
+
+func variableName(s string) string {
+	return escapeKeyword(toCamelCase(camelToSnake(s), false))
+}
+
+// namespaceName is the import name of a namespace, e.g. MyGame__Example.
+func namespaceName(components []string) string {
+	names := make([]string, len(components))
+	for i, c := range components {
+		names[i] = escapeKeyword(c)
+	}
+	return strings.Join(names, "__")
+}
+
+func isLower(c byte) bool { return c >= 'a' && c <= 'z' }
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isAlpha(c byte) bool { return isLower(c) || (c >= 'A' && c <= 'Z') }
+
+func toLower(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+func toUpper(c byte) byte {
+	if isLower(c) {
+		return c - 'a' + 'A'
+	}
+	return c
+}
+
+func camelToSnake(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case i == 0:
+			b.WriteByte(toLower(c))
+		case c == '_':
+			b.WriteByte('_')
+		case !isLower(c):
+			// Prevent duplicate underscores for Upper_Snake_Case strings and
+			// UPPERCASE strings.
+			if isLower(s[i-1]) || (isDigit(s[i-1]) && !isDigit(c)) {
+				b.WriteByte('_')
+			}
+			b.WriteByte(toLower(c))
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func toCamelCase(s string, upper bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case i == 0 && c == '_':
+			// A leading underscore is kept, but the letter after it is
+			// still capitalized.
+			b.WriteByte(c)
+			if i+1 < len(s) && isAlpha(s[i+1]) {
+				i++
+				b.WriteByte(toUpper(s[i]))
+			}
+		case i == 0 && upper:
+			b.WriteByte(toUpper(c))
+		case i == 0:
+			b.WriteByte(toLower(c))
+		case c == '_' && i+1 < len(s):
+			i++
+			b.WriteByte(toUpper(s[i]))
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,770 @@
+package gogen
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/google/flatbuffers/go/reflection"
+)
+
+func nativeName(o *reflection.ObjectT) string {
+	return objectTypeName(newDefinition(o.Name).name)
+}
+
+func isUnionType(t fieldType) bool {
+	return isScalar(t.base) && t.enum != nil && t.enum.IsUnion
+}
+
+// genNativeStruct generates the object API type of a struct or table, with
+// its Pack and UnPack methods.
+func (g *generator) genNativeStruct(o *reflection.ObjectT, code *strings.Builder) {
+	code.WriteString("type " + nativeName(o) + " struct {\n")
+	for _, f := range o.Fields {
+		if f.Deprecated || isUnionType(g.fieldType(f.Type)) {
+			continue
+		}
+		code.WriteString("\t" + methodName(f.Name) + " ")
+		if isOptionalScalar(f) {
+			code.WriteString("*")
+		}
+		code.WriteString(g.nativeFieldType(o, f) + " `json:\"" + f.Name + "\"`\n")
+	}
+	code.WriteString("}\n\n")
+
+	if !o.IsStruct {
+		g.genNativeTablePack(o, code)
+		g.genNativeTableUnPack(o, code)
+		g.genTableSplice(o, code)
+		g.genTableMask(o, code)
+		g.genNativeTableHashSetters(o, code)
+		g.genTableRandom(o, code)
+	} else {
+		g.genNativeStructPack(o, code)
+		g.genNativeStructUnPack(o, code)
+		g.genStructRandom(o, code)
+	}
+}
+
+func (g *generator) genNativeTablePack(o *reflection.ObjectT, code *strings.Builder) {
+	structType := objectName(o)
+	code.WriteString("func (t *" + nativeName(o) + ") Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {\n")
+	code.WriteString("\tif t == nil {\n\t\treturn 0\n\t}\n")
+	for _, f := range o.Fields {
+		t := g.fieldType(f.Type)
+		if f.Deprecated || isScalar(t.base) {
+			continue
+		}
+		field := methodName(f.Name)
+		fieldVar := variableName(f.Name)
+		offset := fieldVar + "Offset"
+
+		switch nested := g.nestedFlatBuffer(o, f); {
+		case nested != nil:
+			nestedBuilder := fieldVar + "Builder"
+			code.WriteString("\t" + offset + " := flatbuffers.UOffsetT(0)\n")
+			code.WriteString("\tif t." + field + " != nil {\n")
+			code.WriteString("\t\t" + nestedBuilder + " := flatbuffers.NewBuilder(0)\n")
+			code.WriteString("\t\t" + g.qualify(nested.Name, "Finish"+objectName(nested)+"Buffer") +
+				"(" + nestedBuilder + ", t." + field + ".Pack(" + nestedBuilder + "))\n")
+			code.WriteString("\t\t" + offset + " = " + structType + "Make" + field +
+				"Vector(builder, " + nestedBuilder + ")\n")
+			code.WriteString("\t}\n")
+		case t.base == reflection.BaseTypeString && f.Required:
+			// A required string is written even if empty, as in C++.
+			code.WriteString("\t" + offset + " := builder.CreateString(t." + field + ")\n")
+		case t.base == reflection.BaseTypeString:
+			code.WriteString("\t" + offset + " := flatbuffers.UOffsetT(0)\n")
+			code.WriteString("\tif t." + field + " != \"\" {\n")
+			code.WriteString("\t\t" + offset + " = builder.CreateString(t." + field + ")\n")
+			code.WriteString("\t}\n")
+		case t.base == reflection.BaseTypeVector && t.element == reflection.BaseTypeUByte && t.enum == nil:
+			code.WriteString("\t" + offset + " := flatbuffers.UOffsetT(0)\n")
+			code.WriteString("\tif t." + field + " != nil {\n")
+			code.WriteString("\t\t" + offset + " = builder.CreateByteString(t." + field + ")\n")
+			code.WriteString("\t}\n")
+		case t.base == reflection.BaseTypeVector:
+			length := fieldVar + "Length"
+			offsets := fieldVar + "Offsets"
+			code.WriteString("\t" + offset + " := flatbuffers.UOffsetT(0)\n")
+			code.WriteString("\tif t." + field + " != nil {\n")
+			code.WriteString("\t\t" + length + " := len(t." + field + ")\n")
+			if t.element == reflection.BaseTypeString {
+				code.WriteString("\t\t" + offsets + " := make([]flatbuffers.UOffsetT, " + length + ")\n")
+				code.WriteString("\t\tfor j := 0; j < " + length + "; j++ {\n")
+				code.WriteString("\t\t\t" + offsets + "[j] = builder.CreateString(t." + field + "[j])\n")
+				code.WriteString("\t\t}\n")
+			} else if t.element == reflection.BaseTypeObj && !t.object.IsStruct {
+				code.WriteString("\t\t" + offsets + " := make([]flatbuffers.UOffsetT, " + length + ")\n")
+				code.WriteString("\t\tfor j := 0; j < " + length + "; j++ {\n")
+				code.WriteString("\t\t\t" + offsets + "[j] = t." + field + "[j].Pack(builder)\n")
+				code.WriteString("\t\t}\n")
+			}
+			code.WriteString("\t\t" + structType + "Start" + field + "Vector(builder, " + length + ")\n")
+			code.WriteString("\t\tfor j := " + length + " - 1; j >= 0; j-- {\n")
+			if vectorType := t.vectorType(); isScalar(t.element) {
+				code.WriteString("\t\t\tbuilder.Prepend" + methodName(genTypeBasic(t.element)) + "(" +
+					castToBaseType(vectorType, "t."+field+"[j]") + ")\n")
+			} else if isStruct(vectorType) {
+				code.WriteString("\t\t\tt." + field + "[j].Pack(builder)\n")
+			} else {
+				code.WriteString("\t\t\tbuilder.PrependUOffsetT(" + offsets + "[j])\n")
+			}
+			code.WriteString("\t\t}\n")
+			code.WriteString("\t\t" + offset + " = builder.EndVector(" + length + ")\n")
+			code.WriteString("\t}\n")
+		case t.base == reflection.BaseTypeObj:
+			if t.object.IsStruct {
+				continue
+			}
+			code.WriteString("\t" + offset + " := t." + field + ".Pack(builder)\n")
+		case t.base == reflection.BaseTypeUnion:
+			code.WriteString("\t" + offset + " := t." + field + ".Pack(builder)\n\n")
+		}
+	}
+
+	code.WriteString("\t" + structType + "Start(builder)\n")
+	for _, f := range o.Fields {
+		if f.Deprecated {
+			continue
+		}
+		t := g.fieldType(f.Type)
+		field := methodName(f.Name)
+		offset := variableName(f.Name) + "Offset"
+
+		if isScalar(t.base) {
+			prefix := ""
+			if isOptionalScalar(f) {
+				code.WriteString("\tif t." + field + " != nil {\n\t")
+				prefix = "*"
+			}
+			if !isUnionType(t) {
+				code.WriteString("\t" + structType + "Add" + field + "(builder, " + prefix + "t." + field + ")\n")
+			}
+			if isOptionalScalar(f) {
+				code.WriteString("\t}\n")
+			}
+			continue
+		}
+		if isStruct(t) {
+			code.WriteString("\t" + offset + " := t." + field + ".Pack(builder)\n")
+		} else if t.base == reflection.BaseTypeUnion {
+			code.WriteString("\tif t." + field + " != nil {\n")
+			code.WriteString("\t\t" + structType + "Add" + methodName(f.Name+"_type") +
+				"(builder, t." + field + ".Type)\n")
+			code.WriteString("\t}\n")
+		}
+		code.WriteString("\t" + structType + "Add" + field + "(builder, " + offset + ")\n")
+	}
+	code.WriteString("\treturn " + structType + "End(builder)\n")
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) genNativeTableUnPack(o *reflection.ObjectT, code *strings.Builder) {
+	structType := objectName(o)
+	code.WriteString("// UnPackTo unpacks rcv into t, reusing the vectors and objects t already holds,\n")
+	code.WriteString("// which must not be shared with other values.\n")
+	code.WriteString("func (rcv *" + structType + ") UnPackTo(t *" + nativeName(o) + ") {\n")
+	code.WriteString("\trcv.UnPackToInterned(t, nil)\n")
+	code.WriteString("}\n\n")
+
+	code.WriteString("// UnPackToInterned is UnPackTo taking the strings it allocates from interner,\n")
+	code.WriteString("// which may be nil.\n")
+	code.WriteString("func (rcv *" + structType + ") UnPackToInterned(t *" + nativeName(o) + ", interner *flatbuffers.Interner) {\n")
+	for _, f := range o.Fields {
+		if f.Deprecated {
+			continue
+		}
+		t := g.fieldType(f.Type)
+		field := methodName(f.Name)
+		fieldVar := variableName(f.Name)
+		length := fieldVar + "Length"
+
+		switch {
+		case isOptionalScalar(f):
+			code.WriteString("\tif o := flatbuffers.UOffsetT(rcv._tab.Offset(" + strconv.Itoa(int(f.Offset)) + ")); o != 0 {\n")
+			code.WriteString("\t\tif t." + field + " == nil {\n")
+			code.WriteString("\t\t\tt." + field + " = new(" + g.genTypeGet(t) + ")\n")
+			code.WriteString("\t\t}\n")
+			code.WriteString("\t\t*t." + field + " = " + g.castToEnum(t, g.genGetter(t)+"(o + rcv._tab.Pos)") + "\n")
+			code.WriteString("\t} else {\n")
+			code.WriteString("\t\tt." + field + " = nil\n")
+			code.WriteString("\t}\n")
+		case isScalar(t.base):
+			if isUnionType(t) {
+				continue
+			}
+			code.WriteString("\tt." + field + " = rcv." + field + "()\n")
+		case g.nestedFlatBuffer(o, f) != nil:
+			code.WriteString("\tif x := rcv." + field + "NestedRoot(); x != nil {\n")
+			g.genReuseObject(g.nestedFlatBuffer(o, f), "t."+field, code)
+			code.WriteString("\t\tx.UnPackToInterned(t." + field + ", interner)\n")
+			code.WriteString("\t} else {\n")
+			code.WriteString("\t\tt." + field + " = nil\n")
+			code.WriteString("\t}\n")
+		case t.base == reflection.BaseTypeString:
+			code.WriteString("\tif x := rcv." + field + "(); string(x) != t." + field + " {\n")
+			code.WriteString("\t\tt." + field + " = interner.Intern(x)\n")
+			code.WriteString("\t}\n")
+		case t.base == reflection.BaseTypeVector && t.element == reflection.BaseTypeUByte && t.enum == nil:
+			code.WriteString("\tt." + field + " = rcv." + field + "Bytes()\n")
+		case t.base == reflection.BaseTypeVector:
+			code.WriteString("\t" + length + " := rcv." + field + "Length()\n")
+			// An absent vector is unpacked as nil, so that it is packed as
+			// absent rather than empty.
+			code.WriteString("\tif rcv._tab.Offset(" + strconv.Itoa(int(f.Offset)) + ") == 0 {\n")
+			code.WriteString("\t\tt." + field + " = nil\n")
+			code.WriteString("\t} else if t." + field + " == nil || cap(t." + field + ") < " + length + " {\n")
+			code.WriteString("\t\tt." + field + " = make(" + g.nativeType(t) + ", " + length + ")\n")
+			code.WriteString("\t} else {\n")
+			code.WriteString("\t\tt." + field + " = t." + field + "[:" + length + "]\n")
+			code.WriteString("\t}\n")
+			code.WriteString("\tfor j := 0; j < " + length + "; j++ {\n")
+			switch {
+			case isScalar(t.element):
+				code.WriteString("\t\tt." + field + "[j] = rcv." + field + "(j)\n")
+			case t.element == reflection.BaseTypeString:
+				code.WriteString("\t\tif x := rcv." + field + "(j); string(x) != t." + field + "[j] {\n")
+				code.WriteString("\t\t\tt." + field + "[j] = interner.Intern(x)\n")
+				code.WriteString("\t\t}\n")
+			case t.element == reflection.BaseTypeObj:
+				code.WriteString("\t\tx := " + g.qualify(t.object.Name, newDefinition(t.object.Name).name) + "{}\n")
+				code.WriteString("\t\trcv." + field + "(&x, j)\n")
+				code.WriteString("\t\tif t." + field + "[j] == nil {\n")
+				code.WriteString("\t\t\tt." + field + "[j] = &" + g.nativeObjectName(t.object) + "{}\n")
+				code.WriteString("\t\t}\n")
+				code.WriteString("\t\t" + unPackToCall(t.object, "t."+field+"[j]") + "\n")
+			}
+			code.WriteString("\t}\n")
+		case t.base == reflection.BaseTypeObj:
+			code.WriteString("\tif x := rcv." + field + "(&" + g.qualify(t.object.Name, newDefinition(t.object.Name).name) + "{}); x != nil {\n")
+			g.genReuseObject(t.object, "t."+field, code)
+			code.WriteString("\t\t" + unPackToCall(t.object, "t."+field) + "\n")
+			code.WriteString("\t} else {\n")
+			code.WriteString("\t\tt." + field + " = nil\n")
+			code.WriteString("\t}\n")
+		case t.base == reflection.BaseTypeUnion:
+			fieldTable := fieldVar + "Table"
+			code.WriteString("\t" + fieldTable + " := flatbuffers.Table{}\n")
+			code.WriteString("\tif rcv." + field + "(&" + fieldTable + ") {\n")
+			code.WriteString("\t\tt." + field + " = rcv." + methodName(f.Name+"_type") +
+				"().UnPackTo(" + fieldTable + ", t." + field + ", interner)\n")
+			code.WriteString("\t} else {\n")
+			code.WriteString("\t\tt." + field + " = nil\n")
+			code.WriteString("\t}\n")
+		}
+	}
+	code.WriteString("}\n\n")
+	g.genNativeUnPack(o, code)
+	g.genNativeReset(o, code)
+}
+
+// nativeObjectName returns the name of the object API type of o, qualified
+// if it is in another package.
+func (g *generator) nativeObjectName(o *reflection.ObjectT) string {
+	return g.qualify(o.Name, nativeName(o))
+}
+
+// genReuseObject generates the statement that allocates the object API type
+// of o in target, unless target already holds one to reuse.
+func (g *generator) genReuseObject(o *reflection.ObjectT, target string, code *strings.Builder) {
+	code.WriteString("\t\tif " + target + " == nil {\n")
+	code.WriteString("\t\t\t" + target + " = &" + g.nativeObjectName(o) + "{}\n")
+	code.WriteString("\t\t}\n")
+}
+
+// unPackToCall returns the call that unpacks x, an accessor of o, into
+// target, interning strings if o is a table.
+func unPackToCall(o *reflection.ObjectT, target string) string {
+	if o.IsStruct {
+		return "x.UnPackTo(" + target + ")"
+	}
+	return "x.UnPackToInterned(" + target + ", interner)"
+}
+
+// genNativeReset generates the Reset method of the object API type of a
+// table or struct, which keeps the capacity of vectors, and the nested
+// structs of a struct, for UnPackTo to reuse.
+func (g *generator) genNativeReset(o *reflection.ObjectT, code *strings.Builder) {
+	name := nativeName(o)
+	var kept strings.Builder
+	for _, f := range o.Fields {
+		if f.Deprecated {
+			continue
+		}
+		t := g.fieldType(f.Type)
+		var reused bool
+		if o.IsStruct {
+			reused = t.base == reflection.BaseTypeObj
+		} else {
+			reused = t.base == reflection.BaseTypeVector && !(t.element == reflection.BaseTypeUByte && t.enum == nil)
+		}
+		if !reused {
+			continue
+		}
+		field := methodName(f.Name)
+		kept.WriteString("\t\t" + field + ": t." + field)
+		if !o.IsStruct {
+			kept.WriteString("[:0]")
+		}
+		kept.WriteString(",\n")
+	}
+
+	if o.IsStruct {
+		code.WriteString("// Reset sets t to the zero " + name + ", resetting its nested structs.\n")
+	} else {
+		code.WriteString("// Reset sets t to the zero " + name + ", except that its vectors keep\n")
+		code.WriteString("// their capacity for UnPackTo, which sets those its table lacks to nil.\n")
+		code.WriteString("// A vector that was not nil is packed as empty until then.\n")
+	}
+	code.WriteString("func (t *" + name + ") Reset() {\n")
+	if kept.Len() == 0 {
+		code.WriteString("\t*t = " + name + "{}\n")
+	} else {
+		code.WriteString("\t*t = " + name + "{\n" + kept.String() + "\t}\n")
+	}
+	if o.IsStruct {
+		for _, f := range o.Fields {
+			if f.Type.BaseType != reflection.BaseTypeObj {
+				continue
+			}
+			field := methodName(f.Name)
+			code.WriteString("\tif t." + field + " != nil {\n")
+			code.WriteString("\t\tt." + field + ".Reset()\n")
+			code.WriteString("\t}\n")
+		}
+	}
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) genNativeUnPack(o *reflection.ObjectT, code *strings.Builder) {
+	code.WriteString("func (rcv *" + objectName(o) + ") UnPack() *" + nativeName(o) + " {\n")
+	code.WriteString("\tif rcv == nil {\n\t\treturn nil\n\t}\n")
+	code.WriteString("\tt := &" + nativeName(o) + "{}\n")
+	code.WriteString("\trcv.UnPackTo(t)\n")
+	code.WriteString("\treturn t\n")
+	code.WriteString("}\n\n")
+}
+
+// genTableSplice generates a method that copies a table and the data it
+// references into a Builder, for Builder.Splice.
+func (g *generator) genTableSplice(o *reflection.ObjectT, code *strings.Builder) {
+	structType := objectName(o)
+	code.WriteString("// Splice writes a copy of the " + structType + " and the data it references to\n")
+	code.WriteString("// builder, and returns its offset, like Pack without unpacking it.\n")
+	code.WriteString("func (rcv *" + structType + ") Splice(builder *flatbuffers.Builder) flatbuffers.UOffsetT {\n")
+	code.WriteString("\tif rcv == nil {\n\t\treturn 0\n\t}\n")
+	g.genTableCopy(o, false, code)
+}
+
+// genTableCopy generates the body of Splice, or of ProjectTo if masked, which
+// copies only the fields selected by mask.
+func (g *generator) genTableCopy(o *reflection.ObjectT, masked bool, code *strings.Builder) {
+	structType := objectName(o)
+	for _, f := range o.Fields {
+		t := g.fieldType(f.Type)
+		if f.Deprecated || isScalar(t.base) || isStruct(t) {
+			continue
+		}
+		field := methodName(f.Name)
+		fieldVar := variableName(f.Name)
+		offset := fieldVar + "Offset"
+		vtableOffset := "flatbuffers.UOffsetT(rcv._tab.Offset(" + strconv.Itoa(int(f.Offset)) + "))"
+		selected := ""
+		if masked {
+			selected = " && " + g.maskSelects(o, f)
+		}
+
+		switch vectorType := t.vectorType(); {
+		case t.base == reflection.BaseTypeString:
+			code.WriteString("\t" + offset + " := flatbuffers.UOffsetT(0)\n")
+			code.WriteString("\tif o := " + vtableOffset + "; o != 0" + selected + " {\n")
+			code.WriteString("\t\t" + offset + " = builder.CreateByteString(rcv._tab.ByteVector(o + rcv._tab.Pos))\n")
+			code.WriteString("\t}\n")
+		case t.base == reflection.BaseTypeVector && (isScalar(t.element) || isStruct(vectorType)):
+			// A nested flatbuffer keeps the alignment of its largest scalars.
+			alignment := inlineAlignment(vectorType)
+			if g.nestedFlatBuffer(o, f) != nil {
+				alignment = 8
+			}
+			code.WriteString("\t" + offset + " := flatbuffers.UOffsetT(0)\n")
+			code.WriteString("\tif o := " + vtableOffset + "; o != 0" + selected + " {\n")
+			code.WriteString("\t\t" + offset + " = builder.SpliceVector(&rcv._tab, o, " +
+				strconv.Itoa(inlineSize(vectorType)) + ", " + strconv.Itoa(alignment) + ")\n")
+			code.WriteString("\t}\n")
+		case t.base == reflection.BaseTypeVector:
+			length := fieldVar + "Length"
+			offsets := fieldVar + "Offsets"
+			code.WriteString("\t" + offset + " := flatbuffers.UOffsetT(0)\n")
+			code.WriteString("\tif " + vtableOffset + " != 0" + selected + " {\n")
+			code.WriteString("\t\t" + length + " := rcv." + field + "Length()\n")
+			code.WriteString("\t\t" + offsets + " := make([]flatbuffers.UOffsetT, " + length + ")\n")
+			code.WriteString("\t\tfor j := 0; j < " + length + "; j++ {\n")
+			if t.element == reflection.BaseTypeString {
+				code.WriteString("\t\t\t" + offsets + "[j] = builder.CreateByteString(rcv." + field + "(j))\n")
+			} else {
+				code.WriteString("\t\t\tx := " + g.qualify(t.object.Name, newDefinition(t.object.Name).name) + "{}\n")
+				code.WriteString("\t\t\trcv." + field + "(&x, j)\n")
+				if masked {
+					code.WriteString("\t\t\t" + offsets + "[j] = x.ProjectTo(builder, mask." + field + ")\n")
+				} else {
+					code.WriteString("\t\t\t" + offsets + "[j] = x.Splice(builder)\n")
+				}
+			}
+			code.WriteString("\t\t}\n")
+			code.WriteString("\t\t" + structType + "Start" + field + "Vector(builder, " + length + ")\n")
+			code.WriteString("\t\tfor j := " + length + " - 1; j >= 0; j-- {\n")
+			code.WriteString("\t\t\tbuilder.PrependUOffsetT(" + offsets + "[j])\n")
+			code.WriteString("\t\t}\n")
+			code.WriteString("\t\t" + offset + " = builder.EndVector(" + length + ")\n")
+			code.WriteString("\t}\n")
+		case t.base == reflection.BaseTypeObj && masked:
+			code.WriteString("\t" + offset + " := flatbuffers.UOffsetT(0)\n")
+			code.WriteString("\tif " + g.maskSelects(o, f) + " {\n")
+			code.WriteString("\t\t" + offset + " = rcv." + field + "(nil).ProjectTo(builder, mask." + field + ")\n")
+			code.WriteString("\t}\n")
+		case t.base == reflection.BaseTypeObj:
+			code.WriteString("\t" + offset + " := rcv." + field + "(nil).Splice(builder)\n")
+		case t.base == reflection.BaseTypeUnion:
+			fieldTable := fieldVar + "Table"
+			code.WriteString("\t" + offset + " := flatbuffers.UOffsetT(0)\n")
+			code.WriteString("\t" + fieldTable + " := flatbuffers.Table{}\n")
+			code.WriteString("\tif ")
+			if masked {
+				code.WriteString(g.maskSelects(o, f) + " && ")
+			}
+			code.WriteString("rcv." + field + "(&" + fieldTable + ") {\n")
+			code.WriteString("\t\t" + offset + " = rcv." + methodName(f.Name+"_type") +
+				"().Splice(builder, " + fieldTable + ")\n")
+			code.WriteString("\t}\n")
+		}
+	}
+
+	code.WriteString("\t" + structType + "Start(builder)\n")
+	for _, f := range o.Fields {
+		if f.Deprecated {
+			continue
+		}
+		t := g.fieldType(f.Type)
+		field := methodName(f.Name)
+		add := structType + "Add" + field
+		selected := ""
+		if masked {
+			selected = " && " + g.maskSelects(o, f)
+		}
+
+		switch {
+		case isOptionalScalar(f):
+			code.WriteString("\tif x := rcv." + field + "(); x != nil" + selected + " {\n")
+			code.WriteString("\t\t" + add + "(builder, *x)\n")
+			code.WriteString("\t}\n")
+		case isScalar(t.base) && masked:
+			code.WriteString("\tif " + g.maskSelects(o, f) + " {\n")
+			code.WriteString("\t\t" + add + "(builder, rcv." + field + "())\n")
+			code.WriteString("\t}\n")
+		case isScalar(t.base):
+			code.WriteString("\t" + add + "(builder, rcv." + field + "())\n")
+		case isStruct(t):
+			code.WriteString("\tif o := flatbuffers.UOffsetT(rcv._tab.Offset(" + strconv.Itoa(int(f.Offset)) + ")); o != 0" + selected + " {\n")
+			code.WriteString("\t\t" + add + "(builder, builder.SpliceStruct(&rcv._tab, o, " +
+				strconv.Itoa(int(t.object.Bytesize)) + ", " + strconv.Itoa(int(t.object.Minalign)) + "))\n")
+			code.WriteString("\t}\n")
+		default:
+			code.WriteString("\t" + add + "(builder, " + variableName(f.Name) + "Offset)\n")
+		}
+	}
+	code.WriteString("\treturn " + structType + "End(builder)\n")
+	code.WriteString("}\n\n")
+}
+
+// randomName returns the name of the function that makes a random object API
+// type of o.
+func (g *generator) randomName(o *reflection.ObjectT) string {
+	return g.qualify(o.Name, "Random"+nativeName(o))
+}
+
+// randomValue returns an expression taking a random value of t, which is not
+// a vector or union, from r.
+func (g *generator) randomValue(t fieldType) string {
+	switch {
+	case t.enum != nil:
+		return g.qualify(t.enum.Name, "Random"+typeName(newDefinition(t.enum.Name).name)) + "(r)"
+	case isScalar(t.base):
+		return "r." + methodName(genTypeBasic(t.base)) + "()"
+	case t.base == reflection.BaseTypeString:
+		return "r.Text()"
+	}
+	return g.randomName(t.object) + "(r)"
+}
+
+// genTableRandom generates a function that makes the object API type of a
+// table with random fields. Fields that are not required are set at random,
+// and child tables only above the maximum depth of r.
+func (g *generator) genTableRandom(o *reflection.ObjectT, code *strings.Builder) {
+	nativeType := nativeName(o)
+	code.WriteString("// Random" + nativeType + " returns a " + nativeType + " with random fields from r. Required\n")
+	code.WriteString("// fields are always set.\n")
+	code.WriteString("func Random" + nativeType + "(r *flatbuffers.Random) *" + nativeType + " {\n")
+	code.WriteString("\tr.Enter()\n")
+	code.WriteString("\tdefer r.Leave()\n")
+	code.WriteString("\tt := &" + nativeType + "{}\n")
+	for _, f := range o.Fields {
+		t := g.fieldType(f.Type)
+		if f.Deprecated || isUnionType(t) {
+			continue
+		}
+		field := methodName(f.Name)
+
+		switch {
+		case isOptionalScalar(f):
+			code.WriteString("\tif r.Optional() {\n")
+			code.WriteString("\t\tv := " + g.randomValue(t) + "\n")
+			code.WriteString("\t\tt." + field + " = &v\n")
+			code.WriteString("\t}\n")
+			continue
+		case isScalar(t.base) || t.base == reflection.BaseTypeString:
+			code.WriteString("\tt." + field + " = " + g.randomValue(t) + "\n")
+			continue
+		case t.base == reflection.BaseTypeUnion:
+			code.WriteString("\tt." + field + " = " + g.qualify(t.enum.Name, "Random"+objectTypeName(newDefinition(t.enum.Name).name)) + "(r)\n")
+			continue
+		}
+
+		// Vectors, structs and tables are set at random unless required, and
+		// tables not below the maximum depth.
+		nested := g.nestedFlatBuffer(o, f)
+		table := nested != nil || t.object != nil && !t.object.IsStruct
+		indent := "\t"
+		if !f.Required {
+			if table {
+				code.WriteString("\tif r.Child() {\n")
+			} else {
+				code.WriteString("\tif r.Optional() {\n")
+			}
+			indent += "\t"
+		}
+		switch {
+		case nested != nil:
+			code.WriteString(indent + "t." + field + " = " + g.randomName(nested) + "(r)\n")
+		case t.base == reflection.BaseTypeVector:
+			code.WriteString(indent + "t." + field + " = make(" + g.nativeType(t) + ", r.Length())\n")
+			code.WriteString(indent + "for j := range t." + field + " {\n")
+			code.WriteString(indent + "\tt." + field + "[j] = " + g.randomValue(t.vectorType()) + "\n")
+			code.WriteString(indent + "}\n")
+		default:
+			code.WriteString(indent + "t." + field + " = " + g.randomValue(t) + "\n")
+		}
+		if !f.Required {
+			code.WriteString("\t}\n")
+		}
+	}
+	code.WriteString("\treturn t\n")
+	code.WriteString("}\n\n")
+}
+
+// genStructRandom generates a function that makes the object API type of a
+// struct with random fields.
+func (g *generator) genStructRandom(o *reflection.ObjectT, code *strings.Builder) {
+	nativeType := nativeName(o)
+	code.WriteString("// Random" + nativeType + " returns a " + nativeType + " with random fields from r.\n")
+	code.WriteString("func Random" + nativeType + "(r *flatbuffers.Random) *" + nativeType + " {\n")
+	code.WriteString("\treturn &" + nativeType + "{\n")
+	for _, f := range o.Fields {
+		if f.Deprecated {
+			continue
+		}
+		code.WriteString("\t\t" + methodName(f.Name) + ": " + g.randomValue(g.fieldType(f.Type)) + ",\n")
+	}
+	code.WriteString("\t}\n")
+	code.WriteString("}\n\n")
+}
+
+// maskSelects returns the condition that mask selects f, a field of o. The
+// type field of a union is selected with the union.
+func (g *generator) maskSelects(o *reflection.ObjectT, f *reflection.FieldT) string {
+	if isUnionType(g.fieldType(f.Type)) {
+		name := strings.TrimSuffix(f.Name, "_type")
+		for _, union := range o.Fields {
+			if union.Name == name {
+				f = union
+			}
+		}
+	}
+	condition := "mask." + methodName(f.Name)
+	if g.isTableField(f) {
+		return condition + " != nil"
+	}
+	return condition
+}
+
+// isTableField reports whether f is a table or a vector of tables, which a
+// mask selects with the mask of the table.
+func (g *generator) isTableField(f *reflection.FieldT) bool {
+	t := g.fieldType(f.Type)
+	return (t.base == reflection.BaseTypeObj || t.base == reflection.BaseTypeVector && t.element == reflection.BaseTypeObj) &&
+		!t.object.IsStruct
+}
+
+// genTableMask generates the mask type of a table, which selects its fields,
+// and the UnPackWithMask and ProjectTo methods that take it.
+func (g *generator) genTableMask(o *reflection.ObjectT, code *strings.Builder) {
+	structType := objectName(o)
+	maskType := structType + "Mask"
+	nativeType := nativeName(o)
+
+	code.WriteString("// " + maskType + " selects fields of a " + structType + " for UnPackWithMask and ProjectTo.\n")
+	code.WriteString("// A table, or a vector of tables, is selected by a mask of the fields of its\n")
+	code.WriteString("// tables. All selects every field, including those of nested tables, and a\n")
+	code.WriteString("// nil mask selects none.\n")
+	code.WriteString("type " + maskType + " struct {\n")
+	code.WriteString("\tAll bool\n")
+	for _, f := range o.Fields {
+		if f.Deprecated || isUnionType(g.fieldType(f.Type)) {
+			continue
+		}
+		code.WriteString("\t" + methodName(f.Name) + " ")
+		if g.isTableField(f) {
+			child := g.fieldType(f.Type).object
+			code.WriteString("*" + g.qualify(child.Name, newDefinition(child.Name).name+"Mask"))
+		} else {
+			code.WriteString("bool")
+		}
+		code.WriteString("\n")
+	}
+	code.WriteString("}\n\n")
+
+	code.WriteString("// UnPackWithMask unpacks the fields of rcv selected by mask into a new\n")
+	code.WriteString("// " + nativeType + ", leaving the others zero.\n")
+	code.WriteString("func (rcv *" + structType + ") UnPackWithMask(mask *" + maskType + ") *" + nativeType + " {\n")
+	code.WriteString("\tif rcv == nil {\n\t\treturn nil\n\t}\n")
+	code.WriteString("\tif mask == nil {\n\t\tmask = &" + maskType + "{}\n\t}\n")
+	code.WriteString("\tif mask.All {\n\t\treturn rcv.UnPack()\n\t}\n")
+	code.WriteString("\tt := &" + nativeType + "{}\n")
+	for _, f := range o.Fields {
+		t := g.fieldType(f.Type)
+		if f.Deprecated || isUnionType(t) {
+			continue
+		}
+		field := methodName(f.Name)
+		fieldVar := variableName(f.Name)
+
+		code.WriteString("\tif " + g.maskSelects(o, f) + " {\n")
+		switch {
+		case isScalar(t.base):
+			code.WriteString("\t\tt." + field + " = rcv." + field + "()\n")
+		case g.nestedFlatBuffer(o, f) != nil:
+			code.WriteString("\t\tt." + field + " = rcv." + field + "NestedRoot().UnPack()\n")
+		case t.base == reflection.BaseTypeString:
+			code.WriteString("\t\tt." + field + " = string(rcv." + field + "())\n")
+		case t.base == reflection.BaseTypeVector && t.element == reflection.BaseTypeUByte && t.enum == nil:
+			code.WriteString("\t\tt." + field + " = rcv." + field + "Bytes()\n")
+		case t.base == reflection.BaseTypeVector:
+			length := fieldVar + "Length"
+			code.WriteString("\t\t" + length + " := rcv." + field + "Length()\n")
+			code.WriteString("\t\tt." + field + " = make(" + g.nativeType(t) + ", " + length + ")\n")
+			code.WriteString("\t\tfor j := 0; j < " + length + "; j++ {\n")
+			switch {
+			case isScalar(t.element):
+				code.WriteString("\t\t\tt." + field + "[j] = rcv." + field + "(j)\n")
+			case t.element == reflection.BaseTypeString:
+				code.WriteString("\t\t\tt." + field + "[j] = string(rcv." + field + "(j))\n")
+			case t.element == reflection.BaseTypeObj:
+				code.WriteString("\t\t\tx := " + g.qualify(t.object.Name, newDefinition(t.object.Name).name) + "{}\n")
+				code.WriteString("\t\t\trcv." + field + "(&x, j)\n")
+				if t.object.IsStruct {
+					code.WriteString("\t\t\tt." + field + "[j] = x.UnPack()\n")
+				} else {
+					code.WriteString("\t\t\tt." + field + "[j] = x.UnPackWithMask(mask." + field + ")\n")
+				}
+			}
+			code.WriteString("\t\t}\n")
+		case t.base == reflection.BaseTypeObj && t.object.IsStruct:
+			code.WriteString("\t\tt." + field + " = rcv." + field + "(nil).UnPack()\n")
+		case t.base == reflection.BaseTypeObj:
+			code.WriteString("\t\tt." + field + " = rcv." + field + "(nil).UnPackWithMask(mask." + field + ")\n")
+		case t.base == reflection.BaseTypeUnion:
+			fieldTable := fieldVar + "Table"
+			code.WriteString("\t\t" + fieldTable + " := flatbuffers.Table{}\n")
+			code.WriteString("\t\tif rcv." + field + "(&" + fieldTable + ") {\n")
+			code.WriteString("\t\t\tt." + field + " = rcv." + methodName(f.Name+"_type") + "().UnPack(" + fieldTable + ")\n")
+			code.WriteString("\t\t}\n")
+		}
+		code.WriteString("\t}\n")
+	}
+	code.WriteString("\treturn t\n")
+	code.WriteString("}\n\n")
+
+	code.WriteString("// ProjectTo writes a copy of the fields of the " + structType + " selected by mask, and\n")
+	code.WriteString("// the data they reference, to builder, and returns its offset. Dropping a\n")
+	code.WriteString("// required field records an error in builder.\n")
+	code.WriteString("func (rcv *" + structType + ") ProjectTo(builder *flatbuffers.Builder, mask *" + maskType + ") flatbuffers.UOffsetT {\n")
+	code.WriteString("\tif rcv == nil {\n\t\treturn 0\n\t}\n")
+	code.WriteString("\tif mask == nil {\n\t\tmask = &" + maskType + "{}\n\t}\n")
+	code.WriteString("\tif mask.All {\n\t\treturn rcv.Splice(builder)\n\t}\n")
+	g.genTableCopy(o, true, code)
+}
+
+// genNativeTableHashSetters generates setters that store the hash of a string
+// in each hashed field.
+func (g *generator) genNativeTableHashSetters(o *reflection.ObjectT, code *strings.Builder) {
+	for _, f := range o.Fields {
+		if f.Deprecated || !isHashed(f) {
+			continue
+		}
+		t := g.fieldType(f.Type)
+		field := methodName(f.Name)
+		code.WriteString("func (t *" + nativeName(o) + ") Set" + field)
+		if t.base == reflection.BaseTypeVector {
+			code.WriteString("FromStrings(s []string) {\n")
+			code.WriteString("\tt." + field + " = make(" + g.nativeType(t) + ", len(s))\n")
+			code.WriteString("\tfor j := range s {\n")
+			code.WriteString("\t\tt." + field + "[j] = " + g.hashedValue(f, t.vectorType(), "s[j]") + "\n")
+			code.WriteString("\t}\n")
+		} else {
+			code.WriteString("FromString(s string) {\n")
+			if isOptionalScalar(f) {
+				code.WriteString("\tv := " + g.hashedValue(f, t, "s") + "\n")
+				code.WriteString("\tt." + field + " = &v\n")
+			} else {
+				code.WriteString("\tt." + field + " = " + g.hashedValue(f, t, "s") + "\n")
+			}
+		}
+		code.WriteString("}\n\n")
+	}
+}
+
+func (g *generator) genNativeStructPack(o *reflection.ObjectT, code *strings.Builder) {
+	code.WriteString("func (t *" + nativeName(o) + ") Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {\n")
+	code.WriteString("\tif t == nil {\n\t\treturn 0\n\t}\n")
+	code.WriteString("\treturn Create" + objectName(o) + "(builder")
+	g.structPackArgs(o, "", code)
+	code.WriteString(")\n")
+	code.WriteString("}\n")
+}
+
+func (g *generator) structPackArgs(o *reflection.ObjectT, namePrefix string, code *strings.Builder) {
+	for _, f := range o.Fields {
+		if t := g.fieldType(f.Type); t.base == reflection.BaseTypeObj {
+			g.structPackArgs(t.object, namePrefix+methodName(f.Name)+".", code)
+		} else {
+			code.WriteString(", t." + namePrefix + methodName(f.Name))
+		}
+	}
+}
+
+func (g *generator) genNativeStructUnPack(o *reflection.ObjectT, code *strings.Builder) {
+	code.WriteString("func (rcv *" + objectName(o) + ") UnPackTo(t *" + nativeName(o) + ") {\n")
+	for _, f := range o.Fields {
+		field := methodName(f.Name)
+		if f.Type.BaseType == reflection.BaseTypeObj {
+			code.WriteString("\tif t." + field + " == nil {\n")
+			code.WriteString("\t\tt." + field + " = &" + g.nativeObjectName(g.fieldType(f.Type).object) + "{}\n")
+			code.WriteString("\t}\n")
+			code.WriteString("\trcv." + field + "(nil).UnPackTo(t." + field + ")\n")
+		} else {
+			code.WriteString("\tt." + field + " = rcv." + field + "()\n")
+		}
+	}
+	code.WriteString("}\n\n")
+	g.genNativeUnPack(o, code)
+	g.genNativeReset(o, code)
+}
@@ -0,0 +1,638 @@
+package gogen
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/google/flatbuffers/go/reflection"
+)
+
+// genStruct generates the accessors and builders of a struct or table, and
+// its object API type if requested.
+func (g *generator) genStruct(o *reflection.ObjectT, code *strings.Builder) {
+	g.curNamespace = newDefinition(o.Name).namespaceKey()
+
+	genComment(o.Documentation, code, "")
+	if g.opts.ObjectAPI {
+		g.genNativeStruct(o, code)
+	}
+	g.beginClass(o, code)
+	if !o.IsStruct {
+		// Generate a special accessor for the table that has been declared as
+		// the root type.
+		g.newRootTypeFromBuffer(o, code)
+	}
+	// Generate the Init method that sets the field in a pre-existing accessor
+	// object. This is to allow object reuse.
+	g.initializeExisting(o, code)
+	g.genTableAccessor(o, code)
+
+	for _, f := range o.Fields {
+		if f.Deprecated {
+			continue
+		}
+		g.genStructAccessor(o, f, code)
+		g.genStructMutator(o, f, code)
+		if !o.IsStruct && f.Key {
+			g.genKey(o, f, code)
+			g.genKeyCompare(o, f, code)
+			g.genLookupByKey(o, f, code)
+		}
+	}
+
+	if o.IsStruct {
+		g.genStructBuilder(o, code)
+	} else {
+		g.genTableBuilders(o, code)
+	}
+}
+
+func objectName(o *reflection.ObjectT) string {
+	return typeName(newDefinition(o.Name).name)
+}
+
+func genReceiver(o *reflection.ObjectT, code *strings.Builder) {
+	code.WriteString("func (rcv *" + objectName(o) + ")")
+}
+
+func (g *generator) beginClass(o *reflection.ObjectT, code *strings.Builder) {
+	code.WriteString("type " + objectName(o) + " struct {\n\t")
+	// _ is reserved in flatbuffers field names, so no chance of name conflict.
+	code.WriteString("_tab ")
+	if o.IsStruct {
+		code.WriteString("flatbuffers.Struct")
+	} else {
+		code.WriteString("flatbuffers.Table")
+	}
+	code.WriteString("\n}\n\n")
+}
+
+func (g *generator) newRootTypeFromBuffer(o *reflection.ObjectT, code *strings.Builder) {
+	structType := objectName(o)
+	hasFileIdentifier := g.schema.RootTable != nil && g.schema.RootTable.Name == o.Name &&
+		g.schema.FileIdent != ""
+	if hasFileIdentifier {
+		code.WriteString("const " + structType + "Identifier = \"" + g.schema.FileIdent + "\"\n\n")
+		code.WriteString("// " + structType + "RootType describes " + structType + " buffers to a flatbuffers.Registry.\n")
+		code.WriteString("var " + structType + "RootType = flatbuffers.RootType{\n")
+		code.WriteString("\tName:       \"" + o.Name + "\",\n")
+		code.WriteString("\tIdentifier: " + structType + "Identifier,\n")
+		code.WriteString("\tNew:        func() flatbuffers.FlatBuffer { return &" + structType + "{} },\n")
+		code.WriteString("}\n\n")
+	}
+
+	for _, sizePrefix := range []string{"", "SizePrefixed"} {
+		code.WriteString("func Get" + sizePrefix + "RootAs" + structType)
+		code.WriteString("(buf []byte, offset flatbuffers.UOffsetT) *" + structType + " {\n")
+		if sizePrefix == "" {
+			code.WriteString("\tn := flatbuffers.GetUOffsetT(buf[offset:])\n")
+		} else {
+			code.WriteString("\tn := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])\n")
+		}
+		code.WriteString("\tx := &" + structType + "{}\n")
+		if sizePrefix == "" {
+			code.WriteString("\tx.Init(buf, n+offset)\n")
+		} else {
+			code.WriteString("\tx.Init(buf, n+offset+flatbuffers.SizeUint32)\n")
+		}
+		code.WriteString("\treturn x\n")
+		code.WriteString("}\n\n")
+
+		code.WriteString("func Finish" + sizePrefix + structType +
+			"Buffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {\n")
+		if hasFileIdentifier {
+			code.WriteString("\tidentifierBytes := []byte(" + structType + "Identifier)\n")
+			code.WriteString("\tbuilder.Finish" + sizePrefix + "WithFileIdentifier(offset, identifierBytes)\n")
+		} else {
+			code.WriteString("\tbuilder.Finish" + sizePrefix + "(offset)\n")
+		}
+		code.WriteString("}\n\n")
+
+		if hasFileIdentifier {
+			code.WriteString("func " + sizePrefix + structType + "BufferHasIdentifier(buf []byte) bool {\n")
+			code.WriteString("\treturn flatbuffers." + sizePrefix + "BufferHasIdentifier(buf, " +
+				structType + "Identifier)\n")
+			code.WriteString("}\n\n")
+		}
+	}
+}
+
+func (g *generator) initializeExisting(o *reflection.ObjectT, code *strings.Builder) {
+	genReceiver(o, code)
+	code.WriteString(" Init(buf []byte, i flatbuffers.UOffsetT) {\n")
+	code.WriteString("\trcv._tab.Bytes = buf\n")
+	code.WriteString("\trcv._tab.Pos = i\n")
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) genTableAccessor(o *reflection.ObjectT, code *strings.Builder) {
+	genReceiver(o, code)
+	code.WriteString(" Table() flatbuffers.Table {\n")
+	if o.IsStruct {
+		code.WriteString("\treturn rcv._tab.Table\n")
+	} else {
+		code.WriteString("\treturn rcv._tab\n")
+	}
+	code.WriteString("}\n\n")
+}
+
+// genStructAccessor generates the getters of a field, conditioned on its
+// type.
+func (g *generator) genStructAccessor(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	genComment(f.Documentation, code, "")
+	t := g.fieldType(f.Type)
+	if isScalar(t.base) {
+		if o.IsStruct {
+			g.getScalarFieldOfStruct(o, f, code)
+		} else {
+			g.getScalarFieldOfTable(o, f, code)
+		}
+	} else {
+		switch t.base {
+		case reflection.BaseTypeObj:
+			if o.IsStruct {
+				g.getStructFieldOfStruct(o, f, code)
+			} else {
+				g.getStructFieldOfTable(o, f, code)
+			}
+		case reflection.BaseTypeString:
+			g.getStringField(o, f, code)
+		case reflection.BaseTypeVector:
+			if vectorType := t.vectorType(); vectorType.base == reflection.BaseTypeObj {
+				g.getMemberOfVectorOfStruct(o, f, code)
+				// Only keyed tables can be looked up by key.
+				if !vectorType.object.IsStruct && hasKey(vectorType.object) {
+					g.getMemberOfVectorOfStructByKey(o, f, code)
+				}
+			} else {
+				g.getMemberOfVectorOfNonStruct(o, f, code)
+			}
+		case reflection.BaseTypeUnion:
+			g.getUnionField(o, f, code)
+		}
+	}
+	if t.base == reflection.BaseTypeVector {
+		g.getVectorLen(o, f, code)
+		if t.element == reflection.BaseTypeUByte {
+			g.getUByteSlice(o, f, code)
+		}
+		if nested := g.nestedFlatBuffer(o, f); nested != nil {
+			g.getNestedFlatBufferRoot(o, f, nested, code)
+		}
+	}
+}
+
+func (g *generator) getVectorLen(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	genReceiver(o, code)
+	code.WriteString(" " + methodName(f.Name) + "Length() int " + offsetPrefix(f))
+	code.WriteString("\t\treturn rcv._tab.VectorLen(o)\n\t}\n")
+	code.WriteString("\treturn 0\n}\n\n")
+}
+
+func (g *generator) getUByteSlice(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	genReceiver(o, code)
+	code.WriteString(" " + methodName(f.Name) + "Bytes() []byte " + offsetPrefix(f))
+	code.WriteString("\t\treturn rcv._tab.ByteVector(o + rcv._tab.Pos)\n\t}\n")
+	code.WriteString("\treturn nil\n}\n\n")
+}
+
+func (g *generator) getNestedFlatBufferRoot(o *reflection.ObjectT, f *reflection.FieldT, nested *reflection.ObjectT, code *strings.Builder) {
+	nestedName := objectName(nested)
+	nestedType := g.qualify(nested.Name, nestedName)
+	genReceiver(o, code)
+	code.WriteString(" " + methodName(f.Name) + "NestedRoot() *" + nestedType + " " + offsetPrefix(f))
+	code.WriteString("\t\treturn " + g.qualify(nested.Name, "GetRootAs"+nestedName))
+	code.WriteString("(rcv._tab.Bytes, rcv._tab.Vector(o))\n\t}\n")
+	code.WriteString("\treturn nil\n}\n\n")
+}
+
+func (g *generator) getScalarFieldOfStruct(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	t := g.fieldType(f.Type)
+	getter := g.genGetter(t)
+	genReceiver(o, code)
+	code.WriteString(" " + methodName(f.Name) + "() " + g.typeName(f) + " {\n")
+	code.WriteString("\treturn " + g.castToEnum(t,
+		getter+"(rcv._tab.Pos + flatbuffers.UOffsetT("+strconv.Itoa(int(f.Offset))+"))"))
+	code.WriteString("\n}\n")
+}
+
+func (g *generator) getScalarFieldOfTable(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	t := g.fieldType(f.Type)
+	getter := g.genGetter(t)
+	genReceiver(o, code)
+	code.WriteString(" " + methodName(f.Name) + "() " + g.typeName(f) + " " + offsetPrefix(f))
+	if isOptionalScalar(f) {
+		code.WriteString("\t\tv := ")
+	} else {
+		code.WriteString("\t\treturn ")
+	}
+	code.WriteString(g.castToEnum(t, getter+"(o + rcv._tab.Pos)"))
+	if isOptionalScalar(f) {
+		code.WriteString("\n\t\treturn &v")
+	}
+	code.WriteString("\n\t}\n")
+	code.WriteString("\treturn " + g.genConstant(f) + "\n")
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) getStructFieldOfStruct(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	typ := g.typeName(f)
+	genReceiver(o, code)
+	code.WriteString(" " + methodName(f.Name) + "(obj *" + typ + ") *" + typ + " {\n")
+	code.WriteString("\tif obj == nil {\n")
+	code.WriteString("\t\tobj = new(" + typ + ")\n")
+	code.WriteString("\t}\n")
+	code.WriteString("\tobj.Init(rcv._tab.Bytes, rcv._tab.Pos+" + strconv.Itoa(int(f.Offset)) + ")")
+	code.WriteString("\n\treturn obj\n")
+	code.WriteString("}\n")
+}
+
+func (g *generator) getStructFieldOfTable(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	typ := g.typeName(f)
+	genReceiver(o, code)
+	code.WriteString(" " + methodName(f.Name) + "(obj *" + typ + ") *" + typ + " " + offsetPrefix(f))
+	if g.fieldType(f.Type).object.IsStruct {
+		code.WriteString("\t\tx := o + rcv._tab.Pos\n")
+	} else {
+		code.WriteString("\t\tx := rcv._tab.Indirect(o + rcv._tab.Pos)\n")
+	}
+	code.WriteString("\t\tif obj == nil {\n")
+	code.WriteString("\t\t\tobj = new(" + typ + ")\n")
+	code.WriteString("\t\t}\n")
+	code.WriteString("\t\tobj.Init(rcv._tab.Bytes, x)\n")
+	code.WriteString("\t\treturn obj\n\t}\n\treturn nil\n")
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) getStringField(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	genReceiver(o, code)
+	code.WriteString(" " + methodName(f.Name) + "() " + g.typeName(f) + " " + offsetPrefix(f))
+	code.WriteString("\t\treturn " + g.genGetter(g.fieldType(f.Type)))
+	code.WriteString("(o + rcv._tab.Pos)\n\t}\n\treturn nil\n")
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) getUnionField(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	t := g.fieldType(f.Type)
+	genReceiver(o, code)
+	code.WriteString(" " + methodName(f.Name) + "(obj " + g.genTypePointer(t) + ") bool " + offsetPrefix(f))
+	code.WriteString("\t\t" + g.genGetter(t) + "(obj, o)\n\t\treturn true\n\t}\n")
+	code.WriteString("\treturn false\n")
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) getMemberOfVectorOfStruct(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	vectorType := g.fieldType(f.Type).vectorType()
+	genReceiver(o, code)
+	code.WriteString(" " + methodName(f.Name) + "(obj *" + g.typeName(f) + ", j int) bool " + offsetPrefix(f))
+	code.WriteString("\t\tx := rcv._tab.Vector(o)\n")
+	code.WriteString("\t\tx += flatbuffers.UOffsetT(j) * " + strconv.Itoa(inlineSize(vectorType)) + "\n")
+	if !vectorType.object.IsStruct {
+		code.WriteString("\t\tx = rcv._tab.Indirect(x)\n")
+	}
+	code.WriteString("\t\tobj.Init(rcv._tab.Bytes, x)\n")
+	code.WriteString("\t\treturn true\n\t}\n")
+	code.WriteString("\treturn false\n")
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) getMemberOfVectorOfStructByKey(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	var keyField *reflection.FieldT
+	for _, vf := range g.fieldType(f.Type).object.Fields {
+		if vf.Key {
+			keyField = vf
+			break
+		}
+	}
+	genReceiver(o, code)
+	code.WriteString(" " + methodName(f.Name) + "ByKey(obj *" + g.typeName(f) + ", key " +
+		g.nativeType(g.fieldType(keyField.Type)) + ") bool " + offsetPrefix(f))
+	code.WriteString("\t\tx := rcv._tab.Vector(o)\n")
+	code.WriteString("\t\treturn obj.LookupByKey(key, x, rcv._tab.Bytes)\n")
+	code.WriteString("\t}\n")
+	code.WriteString("\treturn false\n")
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) getMemberOfVectorOfNonStruct(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	t := g.fieldType(f.Type)
+	vectorType := t.vectorType()
+	genReceiver(o, code)
+	code.WriteString(" " + methodName(f.Name) + "(j int) " + g.typeName(f) + " " + offsetPrefix(f))
+	code.WriteString("\t\ta := rcv._tab.Vector(o)\n")
+	code.WriteString("\t\treturn " + g.castToEnum(t,
+		g.genGetter(t)+"(a + flatbuffers.UOffsetT(j*"+strconv.Itoa(inlineSize(vectorType))+"))"))
+	code.WriteString("\n\t}\n")
+	switch vectorType.base {
+	case reflection.BaseTypeString:
+		code.WriteString("\treturn nil\n")
+	case reflection.BaseTypeBool:
+		code.WriteString("\treturn false\n")
+	default:
+		code.WriteString("\treturn 0\n")
+	}
+	code.WriteString("}\n\n")
+}
+
+// genStructMutator generates the setters of a field, conditioned on its type.
+func (g *generator) genStructMutator(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	genComment(f.Documentation, code, "")
+	t := g.fieldType(f.Type)
+	if isScalar(t.base) {
+		if o.IsStruct {
+			g.mutateScalarFieldOfStruct(o, f, code)
+		} else {
+			g.mutateScalarFieldOfTable(o, f, code)
+		}
+	} else if t.base == reflection.BaseTypeVector && isScalar(t.element) {
+		g.mutateElementOfVectorOfNonStruct(o, f, code)
+	}
+}
+
+func (g *generator) mutateScalarFieldOfStruct(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	t := g.fieldType(f.Type)
+	setter := "rcv._tab.Mutate" + methodName(genTypeBasic(t.base))
+	genReceiver(o, code)
+	code.WriteString(" Mutate" + methodName(f.Name))
+	code.WriteString("(n " + g.genTypeGet(t) + ") bool {\n\treturn " + setter)
+	code.WriteString("(rcv._tab.Pos+flatbuffers.UOffsetT(" + strconv.Itoa(int(f.Offset)) + "), ")
+	code.WriteString(castToBaseType(t, "n") + ")\n}\n\n")
+}
+
+func (g *generator) mutateScalarFieldOfTable(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	t := g.fieldType(f.Type)
+	setter := "rcv._tab.Mutate" + methodName(genTypeBasic(t.base)) + "Slot"
+	genReceiver(o, code)
+	code.WriteString(" Mutate" + methodName(f.Name))
+	code.WriteString("(n " + g.genTypeGet(t) + ") bool {\n\treturn ")
+	code.WriteString(setter + "(" + strconv.Itoa(int(f.Offset)) + ", " + castToBaseType(t, "n") + ")\n")
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) mutateElementOfVectorOfNonStruct(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	vectorType := g.fieldType(f.Type).vectorType()
+	setter := "rcv._tab.Mutate" + methodName(genTypeBasic(vectorType.base))
+	genReceiver(o, code)
+	code.WriteString(" Mutate" + methodName(f.Name))
+	code.WriteString("(j int, n " + g.typeName(f) + ") bool " + offsetPrefix(f))
+	code.WriteString("\t\ta := rcv._tab.Vector(o)\n")
+	code.WriteString("\t\treturn " + setter + "(a+flatbuffers.UOffsetT(j*" + strconv.Itoa(inlineSize(vectorType)) + "), ")
+	code.WriteString(castToBaseType(vectorType, "n") + ")\n")
+	code.WriteString("\t}\n")
+	code.WriteString("\treturn false\n")
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) genKey(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	name := objectName(o)
+	value := "obj." + methodName(f.Name) + "()"
+	code.WriteString("// " + name + "Key returns the key of the " + name + " at off in buf, for\n")
+	code.WriteString("// Builder.CreateVectorOfSortedTablesByKey.\n")
+	code.WriteString("func " + name + "Key(off flatbuffers.UOffsetT, buf []byte) flatbuffers.Key {\n")
+	code.WriteString("\tobj := " + name + "{}\n")
+	code.WriteString("\tobj.Init(buf, flatbuffers.UOffsetT(len(buf))-off)\n")
+	switch t := f.Type.BaseType; {
+	case t == reflection.BaseTypeString:
+		code.WriteString("\treturn flatbuffers.StringKey(" + value + ")\n")
+	case t == reflection.BaseTypeBool:
+		code.WriteString("\treturn flatbuffers.BoolKey(" + value + ")\n")
+	case t == reflection.BaseTypeFloat || t == reflection.BaseTypeDouble:
+		code.WriteString("\treturn flatbuffers.Float64Key(float64(" + value + "))\n")
+	case isUnsigned(t):
+		code.WriteString("\treturn flatbuffers.Uint64Key(uint64(" + value + "))\n")
+	default:
+		code.WriteString("\treturn flatbuffers.Int64Key(int64(" + value + "))\n")
+	}
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) genKeyCompare(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	name := objectName(o)
+	code.WriteString("func " + name + "KeyCompare(o1, o2 flatbuffers.UOffsetT, buf []byte) bool {\n")
+	code.WriteString("\treturn " + name + "Key(o1, buf).Compare(" + name + "Key(o2, buf)) < 0\n")
+	code.WriteString("}\n\n")
+}
+
+func (g *generator) genLookupByKey(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	isString := f.Type.BaseType == reflection.BaseTypeString
+	method := methodName(f.Name)
+	genReceiver(o, code)
+	code.WriteString(" LookupByKey(key " + g.nativeType(g.fieldType(f.Type)) +
+		", vectorLocation flatbuffers.UOffsetT, buf []byte) bool {\n")
+	code.WriteString("\tspan := flatbuffers.GetUOffsetT(buf[vectorLocation-4:])\n")
+	code.WriteString("\tstart := flatbuffers.UOffsetT(0)\n")
+	if isString {
+		code.WriteString("\tbKey := []byte(key)\n")
+	}
+	code.WriteString("\tfor span != 0 {\n")
+	code.WriteString("\t\tmiddle := span / 2\n")
+	code.WriteString("\t\ttableOffset := flatbuffers.GetIndirectOffset(buf, vectorLocation+4*(start+middle))\n")
+	code.WriteString("\t\tobj := &" + objectName(o) + "{}\n")
+	code.WriteString("\t\tobj.Init(buf, tableOffset)\n")
+	if isString {
+		g.needsBytes = true
+		code.WriteString("\t\tcomp := bytes.Compare(obj." + method + "(), bKey)\n")
+	} else {
+		code.WriteString("\t\tval := obj." + method + "()\n")
+		code.WriteString("\t\tcomp := 0\n")
+		code.WriteString("\t\tif val > key {\n")
+		code.WriteString("\t\t\tcomp = 1\n")
+		code.WriteString("\t\t} else if val < key {\n")
+		code.WriteString("\t\t\tcomp = -1\n")
+		code.WriteString("\t\t}\n")
+	}
+	code.WriteString("\t\tif comp > 0 {\n")
+	code.WriteString("\t\t\tspan = middle\n")
+	code.WriteString("\t\t} else if comp < 0 {\n")
+	code.WriteString("\t\t\tmiddle += 1\n")
+	code.WriteString("\t\t\tstart += middle\n")
+	code.WriteString("\t\t\tspan -= middle\n")
+	code.WriteString("\t\t} else {\n")
+	code.WriteString("\t\t\trcv.Init(buf, tableOffset)\n")
+	code.WriteString("\t\t\treturn true\n")
+	code.WriteString("\t\t}\n")
+	code.WriteString("\t}\n")
+	code.WriteString("\treturn false\n")
+	code.WriteString("}\n\n")
+}
+
+// genStructBuilder generates the Create function of a struct, which takes
+// the fields of nested structs as separate arguments.
+func (g *generator) genStructBuilder(o *reflection.ObjectT, code *strings.Builder) {
+	if !strings.HasSuffix(code.String(), "\n\n") {
+		// A previous mutator has not put an extra new line.
+		code.WriteString("\n")
+	}
+	code.WriteString("func Create" + newDefinition(o.Name).name + "(builder *flatbuffers.Builder")
+	g.structBuilderArgs(o, "", code)
+	code.WriteString(") flatbuffers.UOffsetT {\n")
+	g.structBuilderBody(o, "", code)
+	code.WriteString("\treturn builder.Offset()\n")
+	code.WriteString("}\n")
+}
+
+func (g *generator) structBuilderArgs(o *reflection.ObjectT, namePrefix string, code *strings.Builder) {
+	for _, f := range o.Fields {
+		if t := g.fieldType(f.Type); isStruct(t) {
+			// Prefix the arguments of a nested struct with the field name, so
+			// they don't clash.
+			g.structBuilderArgs(t.object, namePrefix+f.Name+"_", code)
+		} else {
+			code.WriteString(", " + namePrefix + variableName(f.Name) + " " + g.typeName(f))
+		}
+	}
+}
+
+func (g *generator) structBuilderBody(o *reflection.ObjectT, namePrefix string, code *strings.Builder) {
+	code.WriteString("\tbuilder.Prep(" + strconv.Itoa(int(o.Minalign)) + ", " + strconv.Itoa(int(o.Bytesize)) + ")\n")
+	for i := len(o.Fields) - 1; i >= 0; i-- {
+		f := o.Fields[i]
+		if f.Padding != 0 {
+			code.WriteString("\tbuilder.Pad(" + strconv.Itoa(int(f.Padding)) + ")\n")
+		}
+		if t := g.fieldType(f.Type); isStruct(t) {
+			g.structBuilderBody(t.object, namePrefix+f.Name+"_", code)
+		} else {
+			code.WriteString("\tbuilder.Prepend" + genMethod(t) + "(" +
+				castToBaseType(t, namePrefix+variableName(f.Name)) + ")\n")
+		}
+	}
+}
+
+// genTableBuilders generates the functions that build a table field by
+// field.
+func (g *generator) genTableBuilders(o *reflection.ObjectT, code *strings.Builder) {
+	name := objectName(o)
+	code.WriteString("func " + name + "Start(builder *flatbuffers.Builder) {\n")
+	code.WriteString("\tbuilder.StartObject(" + strconv.Itoa(len(o.Fields)) + ")\n}\n")
+
+	for i, f := range o.Fields {
+		if f.Deprecated {
+			continue
+		}
+		t := g.fieldType(f.Type)
+		g.buildFieldOfTable(o, f, i, code)
+		if isHashed(f) && isScalar(t.base) {
+			g.buildHashedFieldOfTable(o, f, code)
+		}
+		if t.base == reflection.BaseTypeVector {
+			g.buildVectorOfTable(o, f, code)
+		}
+		if g.nestedFlatBuffer(o, f) != nil {
+			code.WriteString("func " + name + "Make" + methodName(f.Name) +
+				"Vector(builder *flatbuffers.Builder, nested *flatbuffers.Builder) flatbuffers.UOffsetT {\n")
+			code.WriteString("\treturn builder.CreateNestedFlatBuffer(nested)\n")
+			code.WriteString("}\n")
+		}
+	}
+
+	code.WriteString("func " + name + "End(builder *flatbuffers.Builder) flatbuffers.UOffsetT {\n")
+	for i, f := range o.Fields {
+		if f.Deprecated || !f.Required {
+			continue
+		}
+		code.WriteString("\tbuilder.Required(" + strconv.Itoa(i) + ", \"" + o.Name + "." + f.Name + "\")\n")
+	}
+	code.WriteString("\treturn builder.EndObject()\n}\n")
+	g.genTableBuilderType(o, code)
+}
+
+// genTableBuilderType generates a builder type with chainable Add methods,
+// which starts the table when it is created, and checks the required fields
+// when it is finished.
+func (g *generator) genTableBuilderType(o *reflection.ObjectT, code *strings.Builder) {
+	name := objectName(o)
+	builderType := name + "Builder"
+	code.WriteString("\n// " + builderType + " builds a " + name + " with chainable Add methods. It panics\n")
+	code.WriteString("// if a field is added after Finish, which checks the required fields.\n")
+	code.WriteString("type " + builderType + " struct {\n")
+	code.WriteString("\ttable flatbuffers.TableBuilder\n")
+	code.WriteString("}\n\n")
+
+	code.WriteString("// New" + builderType + " starts a " + name + " in builder.\n")
+	code.WriteString("func New" + builderType + "(builder *flatbuffers.Builder) *" + builderType + " {\n")
+	code.WriteString("\trcv := &" + builderType + "{}\n")
+	code.WriteString("\trcv.table.Start(builder, \"" + o.Name + "\", " + strconv.Itoa(len(o.Fields)) + ")\n")
+	code.WriteString("\treturn rcv\n")
+	code.WriteString("}\n")
+
+	for i, f := range o.Fields {
+		if f.Deprecated {
+			continue
+		}
+		t := g.fieldType(f.Type)
+		fieldVar := variableName(f.Name)
+		code.WriteString("func (rcv *" + builderType + ") Add" + methodName(f.Name) + "(" + fieldVar + " ")
+		if !isScalar(t.base) {
+			code.WriteString("flatbuffers.UOffsetT")
+		} else {
+			code.WriteString(g.genTypeGet(t))
+		}
+		code.WriteString(") *" + builderType + " {\n")
+		code.WriteString("\t" + name + "Add" + methodName(f.Name) + "(rcv.table.Field(" + strconv.Itoa(i) + "), " + fieldVar + ")\n")
+		code.WriteString("\treturn rcv\n")
+		code.WriteString("}\n")
+	}
+
+	code.WriteString("\n// Finish ends the " + name + " and returns its offset, and an error\n")
+	code.WriteString("// wrapping flatbuffers.ErrRequiredField if a required field was not added.\n")
+	code.WriteString("func (rcv *" + builderType + ") Finish() (flatbuffers.UOffsetT, error) {\n")
+	for i, f := range o.Fields {
+		if f.Deprecated || !f.Required {
+			continue
+		}
+		code.WriteString("\trcv.table.Required(" + strconv.Itoa(i) + ", \"" + o.Name + "." + f.Name + "\")\n")
+	}
+	code.WriteString("\treturn " + name + "End(rcv.table.End()), rcv.table.Err()\n")
+	code.WriteString("}\n")
+}
+
+func (g *generator) buildFieldOfTable(o *reflection.ObjectT, f *reflection.FieldT, slot int, code *strings.Builder) {
+	t := g.fieldType(f.Type)
+	fieldVar := variableName(f.Name)
+	offset := !isScalar(t.base) && !o.IsStruct
+	code.WriteString("func " + objectName(o) + "Add" + methodName(f.Name))
+	code.WriteString("(builder *flatbuffers.Builder, " + fieldVar + " ")
+	if offset {
+		code.WriteString("flatbuffers.UOffsetT")
+	} else {
+		code.WriteString(g.genTypeGet(t))
+	}
+	code.WriteString(") {\n\tbuilder.Prepend" + genMethod(t))
+	if isOptionalScalar(f) {
+		code.WriteString("(")
+	} else {
+		code.WriteString("Slot(" + strconv.Itoa(slot) + ", ")
+	}
+	if offset {
+		code.WriteString("flatbuffers.UOffsetT(" + fieldVar + ")")
+	} else {
+		code.WriteString(castToBaseType(t, fieldVar))
+	}
+	if isOptionalScalar(f) {
+		code.WriteString(")\n")
+		code.WriteString("\tbuilder.Slot(" + strconv.Itoa(slot))
+	} else {
+		code.WriteString(", " + g.genConstant(f))
+	}
+	code.WriteString(")\n")
+	code.WriteString("}\n")
+}
+
+// buildHashedFieldOfTable sets a hashed field from the string it is a hash
+// of.
+func (g *generator) buildHashedFieldOfTable(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	fieldVar := variableName(f.Name)
+	add := objectName(o) + "Add" + methodName(f.Name)
+	code.WriteString("func " + add + "FromString(builder *flatbuffers.Builder, " + fieldVar + " string) {\n")
+	code.WriteString("\t" + add + "(builder, " + g.hashedValue(f, g.fieldType(f.Type), fieldVar) + ")\n")
+	code.WriteString("}\n")
+}
+
+func (g *generator) buildVectorOfTable(o *reflection.ObjectT, f *reflection.FieldT, code *strings.Builder) {
+	vectorType := g.fieldType(f.Type).vectorType()
+	code.WriteString("func " + objectName(o) + "Start" + methodName(f.Name))
+	code.WriteString("Vector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {\n")
+	code.WriteString("\treturn builder.StartVector(" + strconv.Itoa(inlineSize(vectorType)) +
+		", numElems, " + strconv.Itoa(inlineAlignment(vectorType)) + ")\n}\n")
+}
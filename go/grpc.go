@@ -1,21 +1,123 @@
 package flatbuffers
 
+import (
+	"errors"
+	"fmt"
+)
+
 // Codec implements gRPC-go Codec which is used to encode and decode messages.
 var Codec = "flatbuffers"
 
+// Errors returned by FlatbuffersCodec.Unmarshal for a payload that cannot be
+// read. They are wrapped, so test for them with errors.Is.
+var (
+	ErrBufferTooShort     = errors.New("flatbuffers: buffer is too short")
+	ErrInvalidRootOffset  = errors.New("flatbuffers: root offset is out of bounds")
+	ErrIdentifierMismatch = errors.New("flatbuffers: unexpected file identifier")
+)
+
+// BufferOwnership says what FlatbuffersCodec.Unmarshal does with the receive
+// buffer.
+type BufferOwnership int
+
+const (
+	// RetainBuffer initializes the message on the receive buffer itself. It
+	// is the default, and is safe as long as the transport does not reuse
+	// the buffer after Unmarshal returns.
+	RetainBuffer BufferOwnership = iota
+	// CopyBuffer initializes the message on a copy of the receive buffer,
+	// so the message stays valid whatever the transport does with it.
+	CopyBuffer
+)
+
 // FlatbuffersCodec defines the interface gRPC uses to encode and decode messages.  Note
 // that implementations of this interface must be thread safe; a Codec's
 // methods can be called from concurrent goroutines.
-type FlatbuffersCodec struct{}
+//
+// The zero value does no checks beyond the bounds of the root offset.
+// Malformed payloads are reported as errors rather than panics, but a payload
+// with a valid root can still make the accessors of the received message
+// panic unless Verify rejects it.
+type FlatbuffersCodec struct {
+	// Identifier, if not empty, is the file identifier every received buffer
+	// must have. Messages of Pack-able objects are also finished with it.
+	Identifier string
 
-// Marshal returns the wire format of v.
-func (FlatbuffersCodec) Marshal(v interface{}) ([]byte, error) {
-	return v.(*Builder).FinishedBytes(), nil
+	// Verify, if not nil, is called with every received buffer before the
+	// message is initialized, and the error it returns fails the call.
+	Verify func(buf []byte) error
+
+	// Ownership says whether received messages retain or copy the receive
+	// buffer.
+	Ownership BufferOwnership
+}
+
+// Marshal returns the wire format of v, which is a finished *Builder, a
+// finished buffer ([]byte) or an object-API value with a Pack method, such as
+// *MonsterT. The error recorded by the Builder, such as a missing required
+// field, is returned instead.
+func (c FlatbuffersCodec) Marshal(v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case *Builder:
+		if v == nil || !v.finished {
+			return nil, errors.New("flatbuffers: cannot marshal an unfinished Builder")
+		}
+		if v.err != nil {
+			return nil, v.err
+		}
+		return v.FinishedBytes(), nil
+	case []byte:
+		return v, nil
+	case packer:
+		if c.Identifier != "" && len(c.Identifier) != fileIdentifierLength {
+			return nil, fmt.Errorf("flatbuffers: file identifier %q is not %d bytes", c.Identifier, fileIdentifierLength)
+		}
+		b := NewBuilder(0)
+		if c.Identifier != "" {
+			b.FinishWithFileIdentifier(v.Pack(b), []byte(c.Identifier))
+		} else {
+			b.Finish(v.Pack(b))
+		}
+		if b.err != nil {
+			return nil, b.err
+		}
+		return b.FinishedBytes(), nil
+	}
+	return nil, fmt.Errorf("flatbuffers: cannot marshal %T", v)
 }
 
-// Unmarshal parses the wire format into v.
-func (FlatbuffersCodec) Unmarshal(data []byte, v interface{}) error {
-	v.(flatbuffersInit).Init(data, GetUOffsetT(data))
+// Unmarshal parses the wire format into v, which is a generated table such
+// as *Monster. It checks the root offset, then the Identifier and Verify
+// checks, before initializing v.
+func (c FlatbuffersCodec) Unmarshal(data []byte, v interface{}) error {
+	msg, ok := v.(flatbuffersInit)
+	if !ok {
+		return fmt.Errorf("flatbuffers: cannot unmarshal into %T", v)
+	}
+	if len(data) < SizeUOffsetT {
+		return fmt.Errorf("%w: %d bytes", ErrBufferTooShort, len(data))
+	}
+	root := GetUOffsetT(data)
+	if uint64(root)+SizeSOffsetT > uint64(len(data)) {
+		return fmt.Errorf("%w: %d in %d bytes", ErrInvalidRootOffset, root, len(data))
+	}
+	if c.Identifier != "" {
+		if len(data) < SizeUOffsetT+fileIdentifierLength {
+			return fmt.Errorf("%w: %d bytes", ErrBufferTooShort, len(data))
+		}
+		if id := GetBufferIdentifier(data); id != c.Identifier {
+			return fmt.Errorf("%w: got %q, want %q", ErrIdentifierMismatch, id, c.Identifier)
+		}
+	}
+	if c.Verify != nil {
+		if err := c.Verify(data); err != nil {
+			return err
+		}
+	}
+	if c.Ownership == CopyBuffer {
+		data = append([]byte(nil), data...)
+	}
+	msg.Init(data, root)
 	return nil
 }
 
@@ -36,3 +138,7 @@ func (FlatbuffersCodec) Name() string {
 type flatbuffersInit interface {
 	Init(data []byte, i UOffsetT)
 }
+
+type packer interface {
+	Pack(builder *Builder) UOffsetT
+}
@@ -0,0 +1,204 @@
+package idl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/flatbuffers/go/reflection"
+)
+
+// parseEnum parses an enum or a union declared in file.
+func (p *parser) parseEnum(union bool, file string) error {
+	doc := p.lex.doc
+	if err := p.next(); err != nil {
+		return err
+	}
+	name, err := p.identifier()
+	if err != nil {
+		return err
+	}
+	q := qualify(p.namespace, name)
+	if p.enums[q] != nil {
+		return p.errorf("enum already exists: %s", q)
+	}
+	e := &enum{name: name, namespace: p.namespace, union: union, byName: map[string]*enumVal{}, attrs: attributes{}, doc: doc, file: file}
+	e.underlying = typ{base: reflection.BaseTypeInt, enum: e}
+	if union {
+		e.underlying.base = reflection.BaseTypeUType
+	}
+	p.enums[q] = e
+	p.enumList = append(p.enumList, e)
+
+	if !p.is(':') {
+		if !union {
+			return p.errorf("must specify the underlying integer type for this enum (e.g. ': short', which was the default).")
+		}
+	} else {
+		if err := p.next(); err != nil {
+			return err
+		}
+		t, err := p.parseType()
+		if err != nil {
+			return err
+		}
+		if !isInteger(t.base) || t.base == reflection.BaseTypeBool {
+			kind := "enum"
+			if union {
+				kind = "union"
+			}
+			return p.errorf("underlying %stype must be integral", kind)
+		}
+		e.underlying = typ{base: t.base, enum: e}
+	}
+	if err := p.parseMetadata(e.attrs); err != nil {
+		return err
+	}
+	if e.attrs["force_align"] != nil {
+		return p.errorf("`force_align` is not a valid attribute for Enums. ")
+	}
+	if err := p.expect('{'); err != nil {
+		return err
+	}
+	// Unions, and enums without values, start with NONE.
+	if union || p.is('}') {
+		if err := p.addEnumVal(e, &enumVal{name: "NONE", attrs: attributes{}}, false, p.position()); err != nil {
+			return err
+		}
+	}
+	for !p.is('}') {
+		v := &enumVal{name: p.lex.text, doc: p.lex.doc, attrs: attributes{}}
+		// at is the position of the name, then of the value if it has one.
+		at := p.position()
+		next := len(e.values) > 0
+		if next {
+			v.value = e.values[len(e.values)-1].value
+		}
+		if err := p.expect(tokenIdentifier); err != nil {
+			return err
+		}
+		if union {
+			full, err := p.parseNamespacing(v.name)
+			if err != nil {
+				return err
+			}
+			// Union values can't be namespaced, so the namespace becomes part
+			// of their name.
+			v.name = strings.ReplaceAll(full, ".", "_")
+			if p.is(':') {
+				if err := p.next(); err != nil {
+					return err
+				}
+				if v.unionType, err = p.parseType(); err != nil {
+					return err
+				}
+				if v.unionType.base != reflection.BaseTypeObj && v.unionType.base != reflection.BaseTypeString {
+					return p.errorf("union value type may only be table/struct/string")
+				}
+			} else {
+				v.unionType = typ{base: reflection.BaseTypeObj, obj: p.lookupObject(full, true, false, at)}
+			}
+		}
+		if p.is('=') {
+			if err := p.next(); err != nil {
+				return err
+			}
+			at = p.position()
+			b := reflection.BaseTypeLong
+			if e.underlying.base == reflection.BaseTypeULong {
+				b = reflection.BaseTypeULong
+			}
+			if v.value, err = parseInteger(p.lex.text, b); err != nil {
+				return p.errorf("enum value does not fit, \"%s\"", p.lex.text)
+			}
+			next = false
+			if err := p.expect(tokenInteger); err != nil {
+				return err
+			}
+		}
+		if err := p.parseMetadata(v.attrs); err != nil {
+			return err
+		}
+		if err := p.addEnumVal(e, v, next, at); err != nil {
+			return err
+		}
+		if !p.is(',') {
+			break
+		}
+		if err := p.next(); err != nil {
+			return err
+		}
+	}
+	if err := p.expect('}'); err != nil {
+		return err
+	}
+
+	base := e.underlying.base
+	if e.attrs["bit_flags"] != nil {
+		width := uint64(8 * sizes[base])
+		for _, v := range e.values {
+			u := uint64(v.value)
+			if !isUnsigned(base) && u == width-1 {
+				return p.errorf("underlying type of bit_flags enum must be unsigned")
+			}
+			if u >= width {
+				return p.errorf("bit flag out of range of underlying integral type")
+			}
+			v.value = int64(1) << u
+		}
+	}
+	sort.SliceStable(e.values, func(i, j int) bool {
+		a, b := e.values[i], e.values[j]
+		if a.value == b.value {
+			return a.name < b.name
+		}
+		if base == reflection.BaseTypeULong {
+			return uint64(a.value) < uint64(b.value)
+		}
+		return a.value < b.value
+	})
+	for i := 1; i < len(e.values); i++ {
+		if a, b := e.values[i-1], e.values[i]; a.value == b.value {
+			return p.errorf("all enum values must be unique: %s and %s are both %d", a.name, b.name, b.value)
+		}
+	}
+	if p.types[q] {
+		return p.errorf("datatype already exists: %s", q)
+	}
+	p.types[q] = true
+	return nil
+}
+
+// addEnumVal checks that the value of v, at at, fits the underlying type of
+// e, after adding one to the value before it if next is set, and adds v to e.
+func (p *parser) addEnumVal(e *enum, v *enumVal, next bool, at position) error {
+	m := int64(0)
+	if next {
+		m = 1
+	}
+	lo, hi := integerRange(e.underlying.base)
+	plus := ""
+	if next {
+		plus = " + 1"
+	}
+	if e.underlying.base == reflection.BaseTypeULong {
+		if uint64(v.value) > hi-uint64(m) {
+			return at.errorf("enum value does not fit, \"%d%s\" out of %s", uint64(v.value), plus, intervalString(e.underlying.base))
+		}
+	} else if v.value < lo || v.value > int64(hi)-m {
+		return at.errorf("enum value does not fit, \"%d%s\" out of %s", v.value, plus, intervalString(e.underlying.base))
+	}
+	v.value += m
+	if e.byName[v.name] != nil {
+		return at.errorf("enum value already exists: %s", v.name)
+	}
+	e.byName[v.name] = v
+	e.values = append(e.values, v)
+	return nil
+}
+
+// intervalString describes the range of an integer type in errors.
+func intervalString(b reflection.BaseType) string {
+	lo, hi := integerRange(b)
+	return fmt.Sprintf("[%d; %d]", lo, hi)
+}
@@ -0,0 +1,326 @@
+// Package idl parses FlatBuffers schemas (.fbs) into the reflection.Schema
+// that `flatc --binary --schema` writes, so that Go tools such as gogen,
+// annotator and flatdiff can work from a schema without flatc. It follows
+// flatc's parser: included files, namespaces, attributes, enums, unions,
+// structs, tables with their defaults and optional scalars, and rpc services
+// give the same schema, field ids, offsets and struct layouts.
+package idl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+	"github.com/google/flatbuffers/go/reflection"
+)
+
+// Options configure Parse. Each option mirrors the flatc flag named in its
+// comment.
+type Options struct {
+	// IncludePaths are searched for included files that are not found next
+	// to the file including them (-I).
+	IncludePaths []string
+	// ProjectRoot, if set, records the files of the schema and the file
+	// declaring each type, relative to it (--bfbs-filenames).
+	ProjectRoot string
+	// Comments keeps the documentation comments (--bfbs-comments).
+	Comments bool
+	// Builtins keeps the attributes known to flatc, such as id and
+	// bit_flags, rather than only those declared with `attribute`
+	// (--bfbs-builtins).
+	Builtins bool
+}
+
+// Error is an error in a schema, at a line and column of one of its files.
+type Error struct {
+	File         string
+	Line, Column int
+	Msg          string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, e.Msg)
+}
+
+// Parse parses the schema in filename and the files it includes.
+func Parse(filename string, opts Options) (*reflection.SchemaT, error) {
+	p := newParser(opts)
+	if err := p.parseFile(filename); err != nil {
+		return nil, err
+	}
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	return p.schema(), nil
+}
+
+// Compile parses the schema in filename like Parse, and returns it as a
+// binary schema (.bfbs).
+func Compile(filename string, opts Options) ([]byte, error) {
+	schema, err := Parse(filename, opts)
+	if err != nil {
+		return nil, err
+	}
+	b := flatbuffers.NewBuilder(0)
+	b.FinishWithFileIdentifier(schema.Pack(b), []byte("BFBS"))
+	if err := b.Err(); err != nil {
+		return nil, err
+	}
+	return b.FinishedBytes(), nil
+}
+
+// parser holds the definitions of all the files of a schema.
+type parser struct {
+	opts Options
+	lex  *lexer
+	// namespace is the current namespace of the file being parsed.
+	namespace []string
+
+	// objects and enums are keyed by their qualified names, except for
+	// objects used before their declaration, which are keyed by the name
+	// they were used by.
+	objects    map[string]*object
+	objectList []*object
+	enums      map[string]*enum
+	enumList   []*enum
+	services   map[string]*service
+	// types has the qualified names of the declared enums, structs and
+	// tables.
+	types map[string]bool
+	// attributes has the known attributes, and whether they are built in.
+	attributes map[string]bool
+
+	// files has the files parsed or being parsed, by absolute path, and the
+	// absolute paths of the files each includes.
+	files map[string][]string
+
+	root           *object
+	fileIdentifier string
+	fileExtension  string
+	features       reflection.AdvancedFeatures
+}
+
+var builtinAttributes = []string{
+	"deprecated", "required", "key", "shared", "hash", "id", "force_align",
+	"bit_flags", "original_order", "nested_flatbuffer", "csharp_partial",
+	"streaming", "idempotent", "cpp_type", "cpp_ptr_type", "cpp_ptr_type_get",
+	"cpp_str_type", "cpp_str_flex_ctor", "native_inline",
+	"native_custom_alloc", "native_type", "native_type_pack_name",
+	"native_default", "flexbuffer", "private", "offset64", "vector64",
+}
+
+// fileIdentifierLength is the length of file identifiers.
+const fileIdentifierLength = 4
+
+func newParser(opts Options) *parser {
+	p := &parser{
+		opts:       opts,
+		objects:    map[string]*object{},
+		enums:      map[string]*enum{},
+		services:   map[string]*service{},
+		types:      map[string]bool{},
+		attributes: map[string]bool{},
+		files:      map[string][]string{},
+	}
+	for _, a := range builtinAttributes {
+		p.attributes[a] = true
+	}
+	return p
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// parseFile parses filename, after the files it includes, unless it was
+// parsed already.
+func (p *parser) parseFile(filename string) error {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return err
+	}
+	if _, ok := p.files[abs]; ok {
+		return nil
+	}
+	p.files[abs] = []string{}
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	// Included files are parsed from the start, and the including file is
+	// resumed afterwards.
+	lex, namespace := p.lex, p.namespace
+	defer func() {
+		p.lex, p.namespace = lex, namespace
+	}()
+	p.lex, p.namespace = newLexer(filename, string(src)), nil
+	if err := p.next(); err != nil {
+		return err
+	}
+	if p.is(tokenEOF) {
+		return p.errorf("input file is empty")
+	}
+	for {
+		if p.isIdent("native_include") {
+			if err := p.next(); err != nil {
+				return err
+			}
+			if err := p.expect(tokenString); err != nil {
+				return err
+			}
+		} else if p.isIdent("include") {
+			if err := p.next(); err != nil {
+				return err
+			}
+			if !p.is(tokenString) {
+				return p.expect(tokenString)
+			}
+			name := filepath.FromSlash(p.lex.text)
+			path := filepath.Join(filepath.Dir(filename), name)
+			for i := 0; !fileExists(path) && i < len(p.opts.IncludePaths); i++ {
+				path = filepath.Join(p.opts.IncludePaths[i], name)
+			}
+			if !fileExists(path) {
+				return p.errorf("unable to locate include file: %s", filepath.ToSlash(name))
+			}
+			if err := p.next(); err != nil {
+				return err
+			}
+			included, err := filepath.Abs(path)
+			if err != nil {
+				return err
+			}
+			p.files[abs] = append(p.files[abs], included)
+			if err := p.parseFile(path); err != nil {
+				return err
+			}
+			// The root type and file identifier and extension of included
+			// files are not those of the schema.
+			p.root, p.fileIdentifier, p.fileExtension = nil, "", ""
+		} else {
+			break
+		}
+		if err := p.expect(';'); err != nil {
+			return err
+		}
+	}
+	return p.parseDeclarations(abs)
+}
+
+// parseDeclarations parses the declarations following the includes of the
+// file at the absolute path file.
+func (p *parser) parseDeclarations(file string) error {
+	for !p.is(tokenEOF) {
+		var err error
+		switch {
+		case p.isIdent("namespace"):
+			err = p.parseNamespace()
+		case p.isIdent("enum"):
+			err = p.parseEnum(false, file)
+		case p.isIdent("union"):
+			err = p.parseEnum(true, file)
+		case p.isIdent("root_type"):
+			err = p.parseRootType()
+		case p.isIdent("file_identifier"):
+			if err := p.next(); err != nil {
+				return err
+			}
+			p.fileIdentifier = p.lex.text
+			if err := p.expect(tokenString); err != nil {
+				return err
+			}
+			if len(p.fileIdentifier) != fileIdentifierLength {
+				return p.errorf("file_identifier must be exactly %d characters", fileIdentifierLength)
+			}
+			err = p.expect(';')
+		case p.isIdent("file_extension"):
+			if err := p.next(); err != nil {
+				return err
+			}
+			p.fileExtension = p.lex.text
+			if err := p.expect(tokenString); err != nil {
+				return err
+			}
+			err = p.expect(';')
+		case p.isIdent("include"):
+			return p.errorf("includes must come before declarations")
+		case p.isIdent("attribute"):
+			if err := p.next(); err != nil {
+				return err
+			}
+			name := p.lex.text
+			if p.is(tokenIdentifier) {
+				err = p.next()
+			} else {
+				err = p.expect(tokenString)
+			}
+			if err != nil {
+				return err
+			}
+			p.attributes[name] = false
+			err = p.expect(';')
+		case p.isIdent("rpc_service"):
+			err = p.parseService(file)
+		case p.is('{'):
+			return p.errorf("JSON data is not supported in schemas")
+		default:
+			err = p.parseDecl(file)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// check verifies that all the tables and structs used were declared.
+func (p *parser) check() error {
+	for _, o := range p.objectList {
+		if o.predecl {
+			return &Error{File: o.use.file, Line: o.use.line, Column: o.use.column,
+				Msg: "type referenced but not defined (check namespace): " + o.name}
+		}
+	}
+	return nil
+}
+
+// declarationFile returns the path of the absolute path file relative to
+// the project root, such as //monster_test.fbs, as recorded by
+// --bfbs-filenames.
+func (p *parser) declarationFile(file string) string {
+	if p.opts.ProjectRoot == "" {
+		return ""
+	}
+	root, err := filepath.Abs(p.opts.ProjectRoot)
+	if err != nil {
+		return ""
+	}
+	rel, err := filepath.Rel(root, file)
+	if err != nil {
+		return ""
+	}
+	return "//" + filepath.ToSlash(rel)
+}
+
+// schemaFiles returns the files of the schema and those they include, as
+// recorded by --bfbs-filenames.
+func (p *parser) schemaFiles() []*reflection.SchemaFileT {
+	var files []*reflection.SchemaFileT
+	for file, includes := range p.files {
+		f := &reflection.SchemaFileT{Filename: p.declarationFile(file), IncludedFilenames: []string{}}
+		seen := map[string]bool{}
+		for _, included := range includes {
+			if name := p.declarationFile(included); !seen[name] {
+				seen[name] = true
+				f.IncludedFilenames = append(f.IncludedFilenames, name)
+			}
+		}
+		sort.Strings(f.IncludedFilenames)
+		files = append(files, f)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Filename < files[j].Filename })
+	return files
+}
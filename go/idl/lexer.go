@@ -0,0 +1,345 @@
+package idl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Tokens other than single characters, which are their own code.
+const (
+	tokenEOF = 256 + iota
+	tokenString
+	tokenInteger
+	tokenFloat
+	tokenIdentifier
+)
+
+// lexer splits the source of a schema file into tokens, like flatc's
+// Parser::Next.
+type lexer struct {
+	file string
+	src  string
+	pos  int
+	line int
+	// lineStart is the position of the first character of the current line.
+	lineStart int
+
+	// token is the current token, text its identifier, string or number, and
+	// doc the documentation comments that came right before it.
+	token int
+	text  string
+	doc   []string
+	// trivial reports whether a string token has only printable ASCII
+	// characters and no escapes.
+	trivial bool
+	// tokenLine and tokenColumn locate the current token for errors.
+	tokenLine, tokenColumn int
+}
+
+func newLexer(file, src string) *lexer {
+	src = strings.TrimPrefix(src, "\xef\xbb\xbf")
+	return &lexer{file: file, src: src, line: 1}
+}
+
+// errorf returns an Error at the current token.
+func (l *lexer) errorf(format string, args ...interface{}) error {
+	return &Error{File: l.file, Line: l.tokenLine, Column: l.tokenColumn, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (l *lexer) peek(i int) byte {
+	if l.pos+i < len(l.src) {
+		return l.src[l.pos+i]
+	}
+	return 0
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || (c|0x20) >= 'a' && (c|0x20) <= 'f'
+}
+
+func isIdentifierStart(c byte) bool {
+	return (c|0x20) >= 'a' && (c|0x20) <= 'z' || c == '_'
+}
+
+func isIdentifierChar(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c)
+}
+
+// next reads the next token.
+func (l *lexer) next() error {
+	l.doc = nil
+	l.text = ""
+	l.trivial = true
+	seenNewline := l.pos == 0
+	for {
+		l.tokenLine, l.tokenColumn = l.line, l.pos-l.lineStart+1
+		if l.pos >= len(l.src) {
+			l.token = tokenEOF
+			return nil
+		}
+		c := l.src[l.pos]
+		l.pos++
+		l.token = int(c)
+		switch c {
+		case ' ', '\r', '\t':
+		case '\n':
+			l.line++
+			l.lineStart = l.pos
+			seenNewline = true
+		case '{', '}', '(', ')', '[', ']', '<', '>', ',', ':', ';', '=':
+			return nil
+		case '"', '\'':
+			return l.str(c)
+		case '/':
+			if l.peek(0) == '/' {
+				l.pos++
+				start := l.pos
+				for l.pos < len(l.src) && l.src[l.pos] != '\n' && l.src[l.pos] != '\r' {
+					l.pos++
+				}
+				if start < l.pos && l.src[start] == '/' {
+					if !seenNewline {
+						return l.errorf("a documentation comment should be on a line on its own")
+					}
+					l.doc = append(l.doc, l.src[start+1:l.pos])
+				}
+				continue
+			}
+			if l.peek(0) == '*' {
+				l.pos++
+				for !strings.HasPrefix(l.src[l.pos:], "*/") {
+					if l.pos >= len(l.src) {
+						return l.errorf("end of file in comment")
+					}
+					if l.src[l.pos] == '\n' {
+						l.line++
+						l.lineStart = l.pos + 1
+					}
+					l.pos++
+				}
+				l.pos += 2
+				continue
+			}
+			return l.errorf("illegal character: /")
+		default:
+			return l.other(c)
+		}
+	}
+}
+
+// other reads an identifier or a number starting with c, or the sign or dot
+// of a constant such as -inf or .5.
+func (l *lexer) other(c byte) error {
+	start := l.pos - 1
+	if isIdentifierStart(c) {
+		for isIdentifierChar(l.peek(0)) {
+			l.pos++
+		}
+		l.text = l.src[start:l.pos]
+		l.token = tokenIdentifier
+		return nil
+	}
+	sign := c == '+' || c == '-'
+	if sign {
+		if strings.HasPrefix(l.src[l.pos:], "inf") && !isIdentifierChar(l.peek(3)) {
+			l.pos += 3
+			l.text = l.src[start:l.pos]
+			l.token = tokenFloat
+			return nil
+		}
+		// A sign before an identifier, such as -nan, is returned as is.
+		if isIdentifierStart(l.peek(0)) {
+			return nil
+		}
+	}
+	if c == '.' && !isDigit(l.peek(0)) {
+		return nil
+	}
+	if !isDigit(c) && !sign && c != '.' {
+		if c < ' ' || c > '~' {
+			return l.errorf("illegal character: code: %d", c)
+		}
+		return l.errorf("illegal character: %c", c)
+	}
+	return l.number(start)
+}
+
+// number reads an integer or a floating-point number, which may be
+// hexadecimal, starting at start.
+func (l *lexer) number(start int) error {
+	l.pos = start
+	if c := l.peek(0); c == '+' || c == '-' {
+		l.pos++
+	}
+	digits := func(hex bool) int {
+		n := 0
+		for c := l.peek(0); isDigit(c) || hex && isHexDigit(c); c = l.peek(0) {
+			l.pos++
+			n++
+		}
+		return n
+	}
+	invalid := func() error {
+		return l.errorf("invalid number: %s", l.src[start:l.pos])
+	}
+	hex := l.peek(0) == '0' && (l.peek(1)|0x20) == 'x'
+	if hex {
+		l.pos += 2
+	}
+	float := false
+	n := digits(hex)
+	if l.peek(0) == '.' {
+		l.pos++
+		n += digits(hex)
+		float = true
+	}
+	if n == 0 {
+		return invalid()
+	}
+	if e := l.peek(0) | 0x20; hex && e == 'p' || !hex && e == 'e' {
+		l.pos++
+		if c := l.peek(0); c == '+' || c == '-' {
+			l.pos++
+		}
+		if digits(false) == 0 {
+			return invalid()
+		}
+		float = true
+	} else if hex && float {
+		// The exponent of a hexadecimal floating-point number is mandatory.
+		return invalid()
+	}
+	if l.peek(0) == '.' {
+		l.pos++
+		return invalid()
+	}
+	l.text = l.src[start:l.pos]
+	l.token = tokenInteger
+	if float {
+		l.token = tokenFloat
+	}
+	return nil
+}
+
+// str reads a string constant quoted by q, decoding its escapes.
+func (l *lexer) str(q byte) error {
+	var b strings.Builder
+	highSurrogate := -1
+	for {
+		if l.pos >= len(l.src) {
+			return l.errorf("illegal character in string constant")
+		}
+		c := l.src[l.pos]
+		if c == q {
+			break
+		}
+		if c < ' ' {
+			return l.errorf("illegal character in string constant")
+		}
+		if c != '\\' {
+			if highSurrogate != -1 {
+				return l.errorf("illegal Unicode sequence (unpaired high surrogate)")
+			}
+			if c > '~' {
+				l.trivial = false
+			}
+			b.WriteByte(c)
+			l.pos++
+			continue
+		}
+		l.trivial = false
+		l.pos++
+		e := l.peek(0)
+		l.pos++
+		if highSurrogate != -1 && e != 'u' {
+			return l.errorf("illegal Unicode sequence (unpaired high surrogate)")
+		}
+		switch e {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case '"', '\'', '\\', '/':
+			b.WriteByte(e)
+		case 'x':
+			v, err := l.hex(2)
+			if err != nil {
+				return err
+			}
+			b.WriteByte(byte(v))
+		case 'u':
+			v, err := l.hex(4)
+			if err != nil {
+				return err
+			}
+			switch {
+			case v >= 0xd800 && v <= 0xdbff:
+				if highSurrogate != -1 {
+					return l.errorf("illegal Unicode sequence (multiple high surrogates)")
+				}
+				highSurrogate = v
+			case v >= 0xdc00 && v <= 0xdfff:
+				if highSurrogate == -1 {
+					return l.errorf("illegal Unicode sequence (unpaired low surrogate)")
+				}
+				b.WriteRune(rune(0x10000 + (highSurrogate&0x3ff)<<10 + v&0x3ff))
+				highSurrogate = -1
+			default:
+				if highSurrogate != -1 {
+					return l.errorf("illegal Unicode sequence (unpaired high surrogate)")
+				}
+				b.WriteRune(rune(v))
+			}
+		default:
+			return l.errorf("unknown escape code in string constant")
+		}
+	}
+	if highSurrogate != -1 {
+		return l.errorf("illegal Unicode sequence (unpaired high surrogate)")
+	}
+	l.pos++
+	l.text = b.String()
+	if !l.trivial && !utf8.ValidString(l.text) {
+		return l.errorf("illegal UTF-8 sequence")
+	}
+	l.token = tokenString
+	return nil
+}
+
+// hex reads the n hexadecimal digits of an escape.
+func (l *lexer) hex(n int) (int, error) {
+	if l.pos+n > len(l.src) {
+		return 0, l.errorf("escape code must be followed by %d hex digits", n)
+	}
+	v, err := strconv.ParseUint(l.src[l.pos:l.pos+n], 16, 32)
+	if err != nil {
+		return 0, l.errorf("escape code must be followed by %d hex digits", n)
+	}
+	l.pos += n
+	return int(v), nil
+}
+
+// tokenString describes the current token for errors.
+func (l *lexer) tokenString() string {
+	switch l.token {
+	case tokenEOF:
+		return "end of file"
+	case tokenString:
+		return "string constant"
+	case tokenInteger, tokenFloat, tokenIdentifier:
+		return l.text
+	}
+	return string(rune(l.token))
+}
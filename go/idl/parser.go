@@ -0,0 +1,986 @@
+package idl
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/flatbuffers/go/reflection"
+)
+
+// typ is the type of a field, enum or union value, like flatc's Type. obj is
+// set for tables and structs, and enum for enums and unions, including
+// their vectors and arrays.
+type typ struct {
+	base, element reflection.BaseType
+	obj           *object
+	enum          *enum
+	fixedLength   int
+}
+
+// elementType returns the type of the elements of a vector or array.
+func (t typ) elementType() typ {
+	return typ{base: t.element, obj: t.obj, enum: t.enum}
+}
+
+func (t typ) isStruct() bool {
+	return t.base == reflection.BaseTypeObj && t.obj.fixed
+}
+
+func (t typ) isTable() bool {
+	return t.base == reflection.BaseTypeObj && !t.obj.fixed
+}
+
+func isScalar(b reflection.BaseType) bool {
+	return b >= reflection.BaseTypeUType && b <= reflection.BaseTypeDouble
+}
+
+func isInteger(b reflection.BaseType) bool {
+	return b >= reflection.BaseTypeUType && b <= reflection.BaseTypeULong
+}
+
+func isFloat(b reflection.BaseType) bool {
+	return b == reflection.BaseTypeFloat || b == reflection.BaseTypeDouble
+}
+
+func isUnsigned(b reflection.BaseType) bool {
+	switch b {
+	case reflection.BaseTypeUType, reflection.BaseTypeUByte, reflection.BaseTypeUShort,
+		reflection.BaseTypeUInt, reflection.BaseTypeULong:
+		return true
+	}
+	return false
+}
+
+func isVector(b reflection.BaseType) bool {
+	return b == reflection.BaseTypeVector || b == reflection.BaseTypeVector64
+}
+
+// sizes are the sizes of the base types, inline in a table or struct.
+var sizes = [...]int{
+	reflection.BaseTypeNone:     1,
+	reflection.BaseTypeUType:    1,
+	reflection.BaseTypeBool:     1,
+	reflection.BaseTypeByte:     1,
+	reflection.BaseTypeUByte:    1,
+	reflection.BaseTypeShort:    2,
+	reflection.BaseTypeUShort:   2,
+	reflection.BaseTypeInt:      4,
+	reflection.BaseTypeUInt:     4,
+	reflection.BaseTypeLong:     8,
+	reflection.BaseTypeULong:    8,
+	reflection.BaseTypeFloat:    4,
+	reflection.BaseTypeDouble:   8,
+	reflection.BaseTypeString:   4,
+	reflection.BaseTypeVector:   4,
+	reflection.BaseTypeObj:      4,
+	reflection.BaseTypeUnion:    4,
+	reflection.BaseTypeArray:    4,
+	reflection.BaseTypeVector64: 8,
+}
+
+// inlineSize returns the size of t inline in a struct.
+func (t typ) inlineSize() int {
+	switch {
+	case t.isStruct():
+		return t.obj.bytesize
+	case t.base == reflection.BaseTypeArray:
+		return t.elementType().inlineSize() * t.fixedLength
+	}
+	return sizes[t.base]
+}
+
+// inlineAlignment returns the alignment of t inline in a struct.
+func (t typ) inlineAlignment() int {
+	switch {
+	case t.isStruct():
+		return t.obj.minalign
+	case t.base == reflection.BaseTypeArray:
+		return t.elementType().inlineAlignment()
+	}
+	return sizes[t.base]
+}
+
+// typeNames are the names of the scalar and string types in schemas.
+var typeNames = map[string]reflection.BaseType{
+	"bool":    reflection.BaseTypeBool,
+	"byte":    reflection.BaseTypeByte,
+	"int8":    reflection.BaseTypeByte,
+	"ubyte":   reflection.BaseTypeUByte,
+	"uint8":   reflection.BaseTypeUByte,
+	"short":   reflection.BaseTypeShort,
+	"int16":   reflection.BaseTypeShort,
+	"ushort":  reflection.BaseTypeUShort,
+	"uint16":  reflection.BaseTypeUShort,
+	"int":     reflection.BaseTypeInt,
+	"int32":   reflection.BaseTypeInt,
+	"uint":    reflection.BaseTypeUInt,
+	"uint32":  reflection.BaseTypeUInt,
+	"long":    reflection.BaseTypeLong,
+	"int64":   reflection.BaseTypeLong,
+	"ulong":   reflection.BaseTypeULong,
+	"uint64":  reflection.BaseTypeULong,
+	"float":   reflection.BaseTypeFloat,
+	"float32": reflection.BaseTypeFloat,
+	"double":  reflection.BaseTypeDouble,
+	"float64": reflection.BaseTypeDouble,
+	"string":  reflection.BaseTypeString,
+}
+
+// typeName returns the name of a scalar or string type in errors.
+func typeName(b reflection.BaseType) string {
+	switch b {
+	case reflection.BaseTypeBool:
+		return "bool"
+	case reflection.BaseTypeByte:
+		return "byte"
+	case reflection.BaseTypeUByte:
+		return "ubyte"
+	case reflection.BaseTypeShort:
+		return "short"
+	case reflection.BaseTypeUShort:
+		return "ushort"
+	case reflection.BaseTypeInt:
+		return "int"
+	case reflection.BaseTypeUInt:
+		return "uint"
+	case reflection.BaseTypeLong:
+		return "long"
+	case reflection.BaseTypeULong:
+		return "ulong"
+	case reflection.BaseTypeFloat:
+		return "float"
+	case reflection.BaseTypeDouble:
+		return "double"
+	case reflection.BaseTypeString:
+		return "string"
+	}
+	return ""
+}
+
+// value is a default value or the value of an attribute, as a constant in
+// flatc's canonical form: integers in decimal, and booleans as 0 or 1.
+type value struct {
+	typ      typ
+	constant string
+}
+
+// attributes are the attributes of a declaration, by name.
+type attributes map[string]*value
+
+// position locates a token for errors reported after it is parsed.
+type position struct {
+	file         string
+	line, column int
+}
+
+func (p *parser) position() position {
+	return position{p.lex.file, p.lex.tokenLine, p.lex.tokenColumn}
+}
+
+func (pos position) errorf(format string, args ...interface{}) error {
+	return &Error{File: pos.file, Line: pos.line, Column: pos.column, Msg: fmt.Sprintf(format, args...)}
+}
+
+// object is a table or a struct.
+type object struct {
+	name      string
+	namespace []string
+	// predecl reports whether the object was used but not declared yet.
+	predecl bool
+	fixed   bool
+	fields  []*field
+	byName  map[string]*field
+	attrs   attributes
+	doc     []string
+	// file is the absolute path of the file declaring the object.
+	file string
+	// use is where an object used before its declaration was first used.
+	use                position
+	minalign, bytesize int
+	hasKey             bool
+}
+
+// padLastField pads the struct to align, recording the padding in its last
+// field.
+func (o *object) padLastField(align int) {
+	padding := -o.bytesize & (align - 1)
+	o.bytesize += padding
+	if len(o.fields) > 0 {
+		o.fields[len(o.fields)-1].padding = padding
+	}
+}
+
+type field struct {
+	name  string
+	value value
+	// offset is the vtable offset of a table field, or the offset of a struct
+	// field in its struct.
+	offset                                        int
+	attrs                                         attributes
+	doc                                           []string
+	deprecated, key, required, optional, offset64 bool
+	padding                                       int
+}
+
+// enum is an enum or a union.
+type enum struct {
+	name       string
+	namespace  []string
+	union      bool
+	underlying typ
+	values     []*enumVal
+	byName     map[string]*enumVal
+	attrs      attributes
+	doc        []string
+	file       string
+}
+
+type enumVal struct {
+	name string
+	// value holds the bits of the value, which is unsigned for ulong enums.
+	value     int64
+	unionType typ
+	attrs     attributes
+	doc       []string
+}
+
+type service struct {
+	name      string
+	namespace []string
+	calls     []*rpcCall
+	attrs     attributes
+	doc       []string
+	file      string
+}
+
+type rpcCall struct {
+	name              string
+	request, response *object
+	attrs             attributes
+	doc               []string
+}
+
+// qualify returns the name qualified by namespace.
+func qualify(namespace []string, name string) string {
+	if len(namespace) == 0 {
+		return name
+	}
+	return strings.Join(namespace, ".") + "." + name
+}
+
+// lookupInNamespaces looks name up in namespace, leaving out its skip
+// innermost components, then in each parent namespace, like flatc's
+// LookupTableByName. It returns the qualified name found.
+func lookupInNamespaces(namespace []string, name string, skip int, exists func(string) bool) (string, bool) {
+	if len(namespace) < skip {
+		return "", false
+	}
+	for i := len(namespace) - skip; i > 0; i-- {
+		if q := qualify(namespace[:i], name); exists(q) {
+			return q, true
+		}
+	}
+	return name, exists(name)
+}
+
+func (p *parser) next() error {
+	return p.lex.next()
+}
+
+func (p *parser) is(token int) bool {
+	return p.lex.token == token
+}
+
+func (p *parser) isIdent(id string) bool {
+	return p.lex.token == tokenIdentifier && p.lex.text == id
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return p.lex.errorf(format, args...)
+}
+
+// expect skips the current token, which must be token.
+func (p *parser) expect(token int) error {
+	if p.lex.token != token {
+		return p.errorf("expecting: %s instead got: %s", tokenName(token), p.lex.tokenString())
+	}
+	return p.next()
+}
+
+func tokenName(token int) string {
+	switch token {
+	case tokenEOF:
+		return "end of file"
+	case tokenString:
+		return "string constant"
+	case tokenInteger:
+		return "integer constant"
+	case tokenFloat:
+		return "float constant"
+	case tokenIdentifier:
+		return "identifier"
+	}
+	return string(rune(token))
+}
+
+// identifier returns the current identifier token and skips it.
+func (p *parser) identifier() (string, error) {
+	id := p.lex.text
+	return id, p.expect(tokenIdentifier)
+}
+
+// parseNamespacing parses the rest of the dotted name starting with id.
+func (p *parser) parseNamespacing(id string) (string, error) {
+	for p.is('.') {
+		if err := p.next(); err != nil {
+			return "", err
+		}
+		part, err := p.identifier()
+		if err != nil {
+			return "", err
+		}
+		id += "." + part
+	}
+	return id, nil
+}
+
+func (p *parser) lookupEnum(id string) *enum {
+	q, ok := lookupInNamespaces(p.namespace, id, 0, func(q string) bool { return p.enums[q] != nil })
+	if !ok {
+		return nil
+	}
+	return p.enums[q]
+}
+
+// lookupObject looks up the table or struct id, like flatc's
+// LookupCreateStruct. If create is set, an object not found is created,
+// declared if definition is set and used at use before its declaration
+// otherwise.
+func (p *parser) lookupObject(id string, create, definition bool, use position) *object {
+	q := qualify(p.namespace, id)
+	// Objects used before their declaration are keyed by the name they were
+	// used by, or qualified by the namespace they were used in.
+	if o := p.objects[id]; o != nil && o.predecl {
+		if definition {
+			o.namespace = p.namespace
+			delete(p.objects, id)
+			p.objects[q] = o
+		}
+		return o
+	}
+	o := p.objects[q]
+	if o != nil && o.predecl {
+		if definition {
+			o.namespace = p.namespace
+		}
+		return o
+	}
+	if !definition && o == nil {
+		if found, ok := lookupInNamespaces(p.namespace, id, 1, func(q string) bool { return p.objects[q] != nil }); ok {
+			o = p.objects[found]
+		}
+	}
+	if o == nil && create {
+		o = &object{name: id, namespace: p.namespace, predecl: true, byName: map[string]*field{}, attrs: attributes{}, minalign: 1}
+		if definition {
+			p.objects[q] = o
+		} else {
+			o.use = use
+			p.objects[id] = o
+		}
+		p.objectList = append(p.objectList, o)
+	}
+	return o
+}
+
+// parseTypeIdent parses the name of an enum, union, table or struct.
+func (p *parser) parseTypeIdent() (typ, error) {
+	use := p.position()
+	id, err := p.identifier()
+	if err != nil {
+		return typ{}, err
+	}
+	if id, err = p.parseNamespacing(id); err != nil {
+		return typ{}, err
+	}
+	if e := p.lookupEnum(id); e != nil {
+		t := e.underlying
+		if e.union {
+			t.base = reflection.BaseTypeUnion
+		}
+		return t, nil
+	}
+	return typ{base: reflection.BaseTypeObj, obj: p.lookupObject(id, true, false, use)}, nil
+}
+
+// parseType parses the type of a field, enum or union value.
+func (p *parser) parseType() (typ, error) {
+	if p.is(tokenIdentifier) {
+		if b, ok := typeNames[p.lex.text]; ok {
+			return typ{base: b}, p.next()
+		}
+		return p.parseTypeIdent()
+	}
+	if !p.is('[') {
+		return typ{}, p.errorf("illegal type syntax")
+	}
+	if err := p.next(); err != nil {
+		return typ{}, err
+	}
+	element, err := p.parseType()
+	if err != nil {
+		return typ{}, err
+	}
+	if isVector(element.base) || element.base == reflection.BaseTypeArray {
+		return typ{}, p.errorf("nested vector types not supported (wrap in table first)")
+	}
+	t := typ{base: reflection.BaseTypeVector, element: element.base, obj: element.obj, enum: element.enum}
+	if p.is(':') {
+		if err := p.next(); err != nil {
+			return typ{}, err
+		}
+		if !p.is(tokenInteger) {
+			return typ{}, p.errorf("length of fixed-length array must be an integer value")
+		}
+		n, err := parseInteger(p.lex.text, reflection.BaseTypeUShort)
+		if err != nil || n < 1 {
+			return typ{}, p.errorf("length of fixed-length array must be positive and fit to uint16_t type")
+		}
+		t.base, t.fixedLength = reflection.BaseTypeArray, int(n)
+		if err := p.next(); err != nil {
+			return typ{}, err
+		}
+	}
+	return t, p.expect(']')
+}
+
+func (p *parser) parseNamespace() error {
+	if err := p.next(); err != nil {
+		return err
+	}
+	var namespace []string
+	if !p.is(';') {
+		for {
+			part, err := p.identifier()
+			if err != nil {
+				return err
+			}
+			namespace = append(namespace, part)
+			if !p.is('.') {
+				break
+			}
+			if err := p.next(); err != nil {
+				return err
+			}
+		}
+	}
+	p.namespace = namespace
+	return p.expect(';')
+}
+
+func (p *parser) parseRootType() error {
+	if err := p.next(); err != nil {
+		return err
+	}
+	id, err := p.identifier()
+	if err != nil {
+		return err
+	}
+	if id, err = p.parseNamespacing(id); err != nil {
+		return err
+	}
+	root := p.objects[id]
+	if root == nil {
+		root = p.objects[qualify(p.namespace, id)]
+	}
+	if root == nil {
+		return p.errorf("unknown root type: %s", id)
+	}
+	if root.fixed {
+		return p.errorf("root type must be a table")
+	}
+	p.root = root
+	return p.expect(';')
+}
+
+// parseDecl parses a table or struct declared in file.
+func (p *parser) parseDecl(file string) error {
+	doc := p.lex.doc
+	fixed := p.isIdent("struct")
+	if !fixed && !p.isIdent("table") {
+		return p.errorf("declaration expected")
+	}
+	if err := p.next(); err != nil {
+		return err
+	}
+	name, err := p.identifier()
+	if err != nil {
+		return err
+	}
+	o := p.lookupObject(name, true, true, position{})
+	q := qualify(p.namespace, name)
+	if !o.predecl {
+		return p.errorf("datatype already exists: %s", q)
+	}
+	o.predecl, o.name, o.fixed, o.doc, o.file = false, name, fixed, doc, file
+	if err := p.parseMetadata(o.attrs); err != nil {
+		return err
+	}
+	if err := p.expect('{'); err != nil {
+		return err
+	}
+	for !p.is('}') {
+		if err := p.parseField(o); err != nil {
+			return err
+		}
+	}
+	if fixed {
+		if a := o.attrs["force_align"]; a != nil {
+			align, err := parseInteger(a.constant, reflection.BaseTypeUByte)
+			if err != nil || align < int64(o.minalign) || align > 32 || align&(align-1) != 0 {
+				return p.errorf("unexpected force_align value '%s', alignment must be a power of two integer ranging from the type's natural alignment %d to 32", a.constant, o.minalign)
+			}
+			o.minalign = int(align)
+		}
+		if o.bytesize == 0 {
+			return p.errorf("size 0 structs not allowed")
+		}
+	}
+	o.padLastField(o.minalign)
+	if err := p.assignIDs(o); err != nil {
+		return err
+	}
+	for _, c := range []struct {
+		suffix string
+		base   reflection.BaseType
+	}{
+		{"_type", reflection.BaseTypeUnion},
+		{"Type", reflection.BaseTypeUnion},
+		{"_length", reflection.BaseTypeVector},
+		{"Length", reflection.BaseTypeVector},
+		{"_byte_vector", reflection.BaseTypeString},
+		{"ByteVector", reflection.BaseTypeString},
+	} {
+		for _, f := range o.fields {
+			if len(f.name) <= len(c.suffix) || !strings.HasSuffix(f.name, c.suffix) || f.value.typ.base == reflection.BaseTypeUType {
+				continue
+			}
+			if other := o.byName[strings.TrimSuffix(f.name, c.suffix)]; other != nil && other.value.typ.base == c.base {
+				return p.errorf("Field %s would clash with generated functions for field %s", f.name, other.name)
+			}
+		}
+	}
+	if err := p.expect('}'); err != nil {
+		return err
+	}
+	if p.types[q] {
+		return p.errorf("datatype already exists: %s", q)
+	}
+	p.types[q] = true
+	return nil
+}
+
+// assignIDs orders the fields of a table by their id attributes, if they
+// have them, and gives them the matching vtable offsets.
+func (p *parser) assignIDs(o *object) error {
+	if o.fixed || len(o.fields) == 0 {
+		return nil
+	}
+	n := 0
+	for _, f := range o.fields {
+		if f.attrs["id"] != nil {
+			n++
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	if n != len(o.fields) {
+		return p.errorf("either all fields or no fields must have an 'id' attribute")
+	}
+	atoi := func(f *field) int {
+		id, _ := strconv.Atoi(f.attrs["id"].constant)
+		return id
+	}
+	sort.SliceStable(o.fields, func(i, j int) bool { return atoi(o.fields[i]) < atoi(o.fields[j]) })
+	for i, f := range o.fields {
+		constant := f.attrs["id"].constant
+		id, err := parseInteger(constant, reflection.BaseTypeUShort)
+		if err != nil {
+			return p.errorf("field id's must be non-negative number, field: %s, id: %s", f.name, constant)
+		}
+		if int64(i) != id {
+			return p.errorf("field id's must be consecutive from 0, id %d missing or set twice, field: %s, id: %s", i, f.name, constant)
+		}
+		f.offset = fieldIndexToOffset(i)
+	}
+	return nil
+}
+
+// fieldIndexToOffset returns the vtable offset of the field at index i.
+func fieldIndexToOffset(i int) int {
+	return 4 + 2*i
+}
+
+// addField adds a field of type t to o, laying it out if o is a struct.
+func (p *parser) addField(o *object, name string, t typ) (*field, error) {
+	f := &field{name: name, value: value{typ: t, constant: "0"}, offset: fieldIndexToOffset(len(o.fields)), attrs: attributes{}}
+	if o.fixed {
+		align := t.inlineAlignment()
+		if align > o.minalign {
+			o.minalign = align
+		}
+		o.padLastField(align)
+		f.offset = o.bytesize
+		o.bytesize += t.inlineSize()
+	}
+	if o.byName[name] != nil {
+		return nil, p.errorf("field already exists: %s", name)
+	}
+	o.fields = append(o.fields, f)
+	o.byName[name] = f
+	return f, nil
+}
+
+// hashes are the hash functions of the hash attribute, by the size in bits
+// of the fields they apply to.
+var hashes = map[int][]string{
+	16: {"fnv1_16", "fnv1a_16"},
+	32: {"fnv1_32", "fnv1a_32"},
+	64: {"fnv1_64", "fnv1a_64"},
+}
+
+func (p *parser) parseField(o *object) error {
+	name, use := p.lex.text, p.position()
+	if p.lookupObject(name, false, false, position{}) != nil {
+		return p.errorf("field name can not be the same as table/struct name")
+	}
+	doc := p.lex.doc
+	if err := p.expect(tokenIdentifier); err != nil {
+		return err
+	}
+	if err := p.expect(':'); err != nil {
+		return err
+	}
+	t, err := p.parseType()
+	if err != nil {
+		return err
+	}
+	isArray := t.base == reflection.BaseTypeArray
+	if o.fixed {
+		inner := t
+		if isArray {
+			inner = t.elementType()
+		}
+		if inner.base == reflection.BaseTypeObj && inner.obj.predecl {
+			return p.errorf("Incomplete type in struct is not allowed, type name: %s", inner.obj.name)
+		}
+		if !isScalar(inner.base) && !inner.isStruct() {
+			return p.errorf("structs may contain only scalar or struct fields")
+		}
+	}
+	if !o.fixed && isArray {
+		return p.errorf("fixed-length array in table must be wrapped in struct")
+	}
+	if isArray {
+		p.features |= reflection.AdvancedFeaturesAdvancedArrayFeatures
+	}
+
+	// Unions and vectors of unions get a field holding their types, declared
+	// right before them.
+	var typeField *field
+	if t.base == reflection.BaseTypeUnion {
+		ut := t.enum.underlying
+		ut.base = reflection.BaseTypeUType
+		if typeField, err = p.addField(o, name+"_type", ut); err != nil {
+			return err
+		}
+	} else if isVector(t.base) && t.element == reflection.BaseTypeUnion {
+		p.features |= reflection.AdvancedFeaturesAdvancedUnionFeatures
+		ut := typ{base: reflection.BaseTypeVector, element: reflection.BaseTypeUType, enum: t.enum}
+		if typeField, err = p.addField(o, name+"_type", ut); err != nil {
+			return err
+		}
+	}
+	f, err := p.addField(o, name, t)
+	if err != nil {
+		return err
+	}
+
+	if p.is('=') {
+		if err := p.next(); err != nil {
+			return err
+		}
+		if err := p.parseSingleValue(name, &f.value, true); err != nil {
+			return err
+		}
+		if t.isStruct() || o.fixed && f.value.constant != "0" {
+			return p.errorf("default values are not supported for struct fields, table fields, or in structs.")
+		}
+		if t.base == reflection.BaseTypeString || isVector(t.base) {
+			p.features |= reflection.AdvancedFeaturesDefaultVectorsAndStrings
+		}
+		if isVector(t.base) && f.value.constant != "0" && f.value.constant != "[]" {
+			return p.errorf("The only supported default for vectors is `[]`.")
+		}
+	}
+
+	f.doc = doc
+	if err := p.parseMetadata(f.attrs); err != nil {
+		return err
+	}
+	f.deprecated = f.attrs["deprecated"] != nil
+	hash := f.attrs["hash"]
+	if hash != nil {
+		b := t.base
+		if isVector(b) {
+			b = t.element
+		}
+		bits := 0
+		switch b {
+		case reflection.BaseTypeShort, reflection.BaseTypeUShort:
+			bits = 16
+		case reflection.BaseTypeInt, reflection.BaseTypeUInt:
+			bits = 32
+		case reflection.BaseTypeLong, reflection.BaseTypeULong:
+			bits = 64
+		default:
+			return p.errorf("only short, ushort, int, uint, long and ulong data types support hashing.")
+		}
+		known := false
+		for _, h := range hashes[bits] {
+			known = known || h == hash.constant
+		}
+		if !known {
+			return p.errorf("Unknown hashing algorithm for %d bit types: %s", bits, hash.constant)
+		}
+	}
+
+	if f.attrs["vector64"] != nil {
+		if !isVector(t.base) {
+			return p.errorf("`vector64` attribute can only be applied on vectors.")
+		}
+		t.base = reflection.BaseTypeVector64
+		f.value.typ = t
+		f.offset64 = true
+	}
+	if f.attrs["offset64"] != nil {
+		f.offset64 = true
+	}
+	if f.offset64 {
+		if t.base != reflection.BaseTypeString && !isVector(t.base) {
+			return p.errorf("only string and vectors can have `offset64` attribute applied")
+		}
+		if isVector(t.base) && !(isScalar(t.element) && t.enum == nil || t.elementType().isStruct()) {
+			return p.errorf("only vectors of scalars are allowed to be 64-bit.")
+		}
+	}
+
+	// String keys are required, scalars are optional if their default is
+	// null, and other fields are optional unless required or defaulted.
+	f.key = f.attrs["key"] != nil
+	f.required = f.attrs["required"] != nil || t.base == reflection.BaseTypeString && f.key
+	if isScalar(t.base) {
+		f.optional = f.value.constant == "null"
+	} else {
+		defaulted := (t.base == reflection.BaseTypeString || isVector(t.base)) && f.value.constant != "0"
+		f.optional = !(f.required || defaulted)
+	}
+	if f.required && f.optional {
+		return p.errorf("Fields cannot be both optional and required.")
+	}
+	if f.required && (o.fixed || isScalar(t.base)) {
+		return p.errorf("only non-scalar fields in tables may be 'required'")
+	}
+	if f.key {
+		if o.hasKey {
+			return p.errorf("only one field may be set as 'key'")
+		}
+		o.hasKey = true
+		valid := isScalar(t.base) || t.base == reflection.BaseTypeString || t.isStruct()
+		if isArray {
+			valid = valid || isScalar(t.element) || t.elementType().isStruct()
+		}
+		if !valid {
+			return p.errorf("'key' field must be string, scalar type or fixed size array of scalars")
+		}
+	}
+	if isScalar(t.base) && f.optional {
+		p.features |= reflection.AdvancedFeaturesOptionalScalars
+		if t.enum != nil && t.enum.byName["null"] != nil {
+			return p.errorf("the default 'null' is reserved for declaring optional scalar fields, it conflicts with declaration of enum '%s'.", t.enum.name)
+		}
+		if f.key {
+			return p.errorf("only a non-optional scalar field can be used as a 'key' field")
+		}
+	}
+
+	if t.enum != nil {
+		constant := f.value.constant
+		switch {
+		case t.base == reflection.BaseTypeUnion:
+			if constant != "0" {
+				return p.errorf("Union defaults must be NONE")
+			}
+		case isVector(t.base):
+			if constant != "0" && constant != "[]" {
+				return p.errorf("Vector defaults may only be `[]`.")
+			}
+		case isArray:
+			if constant != "0" {
+				return p.errorf("Array defaults are not supported yet.")
+			}
+		default:
+			if !isInteger(t.base) {
+				return p.errorf("Enums must have integer base types")
+			}
+			// Optional and bit_flags enums may default to other values.
+			if !f.optional && t.enum.attrs["bit_flags"] == nil && t.enum.byValue(constant) == nil {
+				return p.errorf("default value of `%s` for field `%s` is not part of enum `%s`.", constant, name, t.enum.name)
+			}
+		}
+	}
+
+	if f.deprecated && o.fixed {
+		return p.errorf("can't deprecate fields in a struct")
+	}
+	if cppType := f.attrs["cpp_type"]; cppType != nil {
+		if hash == nil {
+			return p.errorf("cpp_type can only be used with a hashed field")
+		}
+		if f.attrs["cpp_ptr_type"] == nil {
+			f.attrs["cpp_ptr_type"] = &value{typ: cppType.typ, constant: "naked"}
+		}
+	}
+	if f.attrs["shared"] != nil && t.base != reflection.BaseTypeString {
+		return p.errorf("shared can only be defined on strings")
+	}
+	if f.attrs["native_custom_alloc"] != nil {
+		return p.errorf("native_custom_alloc can only be used with a table or struct definition")
+	}
+	if f.attrs["native_inline"] != nil && !t.isStruct() &&
+		!(isVector(t.base) && (t.elementType().isStruct() || t.elementType().isTable())) {
+		return p.errorf("'native_inline' can only be defined on structs, vector of structs or vector of tables")
+	}
+	if nested := f.attrs["nested_flatbuffer"]; nested != nil {
+		if nested.typ.base != reflection.BaseTypeString {
+			return p.errorf("nested_flatbuffer attribute must be a string (the root type)")
+		}
+		if !isVector(t.base) || t.element != reflection.BaseTypeUByte {
+			return p.errorf("nested_flatbuffer attribute may only apply to a vector of ubyte")
+		}
+		// The root type of the nested flatbuffer must be declared somewhere.
+		p.lookupObject(nested.constant, true, false, use)
+	}
+	if f.attrs["flexbuffer"] != nil && (t.base != reflection.BaseTypeVector || t.element != reflection.BaseTypeUByte) {
+		return p.errorf("flexbuffer attribute may only apply to a vector of ubyte")
+	}
+
+	if typeField != nil {
+		if !isScalar(typeField.value.typ.base) {
+			typeField.required, typeField.optional = f.required, f.optional
+		}
+		// The type field of a union with an id takes the id before it.
+		if id := f.attrs["id"]; id != nil {
+			n, err := parseInteger(id.constant, reflection.BaseTypeUShort)
+			if err != nil || n == 0 {
+				return p.errorf("a union type effectively adds two fields with non-negative ids, its id must be that of the second field (the first field is the type field and not explicitly declared in the schema);\nfield: %s, id: %s", name, id.constant)
+			}
+			typeField.attrs["id"] = &value{typ: id.typ, constant: strconv.FormatInt(n-1, 10)}
+		}
+		typeField.deprecated = f.deprecated
+	}
+	return p.expect(';')
+}
+
+// byValue returns the value of e whose value is the integer constant.
+func (e *enum) byValue(constant string) *enumVal {
+	var v int64
+	if e.underlying.base == reflection.BaseTypeULong {
+		u, err := strconv.ParseUint(constant, 10, 64)
+		if err != nil {
+			return nil
+		}
+		v = int64(u)
+	} else {
+		var err error
+		if v, err = strconv.ParseInt(constant, 10, 64); err != nil {
+			return nil
+		}
+	}
+	for _, ev := range e.values {
+		if ev.value == v {
+			return ev
+		}
+	}
+	return nil
+}
+
+// parseService parses an rpc_service declared in file.
+func (p *parser) parseService(file string) error {
+	doc := p.lex.doc
+	if err := p.next(); err != nil {
+		return err
+	}
+	name, err := p.identifier()
+	if err != nil {
+		return err
+	}
+	s := &service{name: name, namespace: p.namespace, attrs: attributes{}, doc: doc, file: file}
+	q := qualify(p.namespace, name)
+	if p.services[q] != nil {
+		return p.errorf("service already exists: %s", name)
+	}
+	p.services[q] = s
+	if err := p.parseMetadata(s.attrs); err != nil {
+		return err
+	}
+	if err := p.expect('{'); err != nil {
+		return err
+	}
+	byName := map[string]bool{}
+	for {
+		call := &rpcCall{attrs: attributes{}, doc: p.lex.doc}
+		if call.name, err = p.identifier(); err != nil {
+			return err
+		}
+		if err := p.expect('('); err != nil {
+			return err
+		}
+		request, err := p.parseTypeIdent()
+		if err != nil {
+			return err
+		}
+		if err := p.expect(')'); err != nil {
+			return err
+		}
+		if err := p.expect(':'); err != nil {
+			return err
+		}
+		response, err := p.parseTypeIdent()
+		if err != nil {
+			return err
+		}
+		if !request.isTable() || !response.isTable() {
+			return p.errorf("rpc request and response types must be tables")
+		}
+		call.request, call.response = request.obj, response.obj
+		if byName[call.name] {
+			return p.errorf("rpc already exists: %s", call.name)
+		}
+		byName[call.name] = true
+		s.calls = append(s.calls, call)
+		if err := p.parseMetadata(call.attrs); err != nil {
+			return err
+		}
+		if err := p.expect(';'); err != nil {
+			return err
+		}
+		if p.is('}') {
+			break
+		}
+	}
+	return p.next()
+}
@@ -0,0 +1,193 @@
+package idl
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/flatbuffers/go/reflection"
+)
+
+// schema returns the parsed definitions as a reflection schema. Objects,
+// enums and services are sorted by qualified name, and the types refer to
+// objects and enums by their index in that order.
+func (p *parser) schema() *reflection.SchemaT {
+	objects := append([]*object(nil), p.objectList...)
+	sort.Slice(objects, func(i, j int) bool { return objects[i].qualifiedName() < objects[j].qualifiedName() })
+	enums := append([]*enum(nil), p.enumList...)
+	sort.Slice(enums, func(i, j int) bool {
+		return qualify(enums[i].namespace, enums[i].name) < qualify(enums[j].namespace, enums[j].name)
+	})
+	s := &schemaBuilder{p: p, objectIndex: map[*object]int{}, enumIndex: map[*enum]int{}}
+	for i, o := range objects {
+		s.objectIndex[o] = i
+	}
+	for i, e := range enums {
+		s.enumIndex[e] = i
+	}
+
+	schema := &reflection.SchemaT{
+		FileIdent:        p.fileIdentifier,
+		FileExt:          p.fileExtension,
+		Objects:          []*reflection.ObjectT{},
+		Enums:            []*reflection.EnumT{},
+		Services:         []*reflection.ServiceT{},
+		AdvancedFeatures: p.features,
+	}
+	objectTs := map[*object]*reflection.ObjectT{}
+	for _, o := range objects {
+		t := s.object(o)
+		objectTs[o] = t
+		schema.Objects = append(schema.Objects, t)
+	}
+	for _, e := range enums {
+		schema.Enums = append(schema.Enums, s.enum(e))
+	}
+	if p.root != nil {
+		schema.RootTable = objectTs[p.root]
+	}
+	var services []*service
+	for _, sv := range p.services {
+		services = append(services, sv)
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return qualify(services[i].namespace, services[i].name) < qualify(services[j].namespace, services[j].name)
+	})
+	for _, sv := range services {
+		t := &reflection.ServiceT{
+			Name:            qualify(sv.namespace, sv.name),
+			Calls:           []*reflection.RPCCallT{},
+			Attributes:      s.attributes(sv.attrs),
+			Documentation:   s.doc(sv.doc),
+			DeclarationFile: p.declarationFile(sv.file),
+		}
+		for _, c := range sv.calls {
+			t.Calls = append(t.Calls, &reflection.RPCCallT{
+				Name:          c.name,
+				Request:       objectTs[c.request],
+				Response:      objectTs[c.response],
+				Attributes:    s.attributes(c.attrs),
+				Documentation: s.doc(c.doc),
+			})
+		}
+		schema.Services = append(schema.Services, t)
+	}
+	if p.opts.ProjectRoot != "" {
+		schema.FbsFiles = p.schemaFiles()
+	}
+	return schema
+}
+
+func (o *object) qualifiedName() string {
+	return qualify(o.namespace, o.name)
+}
+
+// schemaBuilder converts the parsed definitions to their reflection types.
+type schemaBuilder struct {
+	p           *parser
+	objectIndex map[*object]int
+	enumIndex   map[*enum]int
+}
+
+func (s *schemaBuilder) object(o *object) *reflection.ObjectT {
+	t := &reflection.ObjectT{
+		Name:            o.qualifiedName(),
+		IsStruct:        o.fixed,
+		Minalign:        int32(o.minalign),
+		Bytesize:        int32(o.bytesize),
+		Fields:          []*reflection.FieldT{},
+		Attributes:      s.attributes(o.attrs),
+		Documentation:   s.doc(o.doc),
+		DeclarationFile: s.p.declarationFile(o.file),
+	}
+	for id, f := range o.fields {
+		ft := &reflection.FieldT{
+			Name:          f.name,
+			Type:          s.typ(f.value.typ),
+			Id:            uint16(id),
+			Offset:        uint16(f.offset),
+			Deprecated:    f.deprecated,
+			Required:      f.required,
+			Key:           f.key,
+			Attributes:    s.attributes(f.attrs),
+			Documentation: s.doc(f.doc),
+			Optional:      f.optional,
+			Padding:       uint16(f.padding),
+			Offset64:      f.offset64,
+		}
+		// Defaults that don't fit an int64, such as large ulongs, are 0.
+		if b := f.value.typ.base; isInteger(b) {
+			if n, err := strconv.ParseInt(strings.TrimLeft(f.value.constant, " \t\n\v\f\r"), 10, 64); err == nil {
+				ft.DefaultInteger = n
+			}
+		} else if isFloat(b) {
+			ft.DefaultReal, _ = parseFloat(f.value.constant)
+		}
+		t.Fields = append(t.Fields, ft)
+	}
+	sort.Slice(t.Fields, func(i, j int) bool { return t.Fields[i].Name < t.Fields[j].Name })
+	return t
+}
+
+func (s *schemaBuilder) enum(e *enum) *reflection.EnumT {
+	t := &reflection.EnumT{
+		Name:            qualify(e.namespace, e.name),
+		IsUnion:         e.union,
+		Values:          []*reflection.EnumValT{},
+		UnderlyingType:  s.typ(e.underlying),
+		Attributes:      s.attributes(e.attrs),
+		Documentation:   s.doc(e.doc),
+		DeclarationFile: s.p.declarationFile(e.file),
+	}
+	for _, v := range e.values {
+		t.Values = append(t.Values, &reflection.EnumValT{
+			Name:          v.name,
+			Value:         v.value,
+			UnionType:     s.typ(v.unionType),
+			Documentation: s.doc(v.doc),
+			Attributes:    s.attributes(v.attrs),
+		})
+	}
+	return t
+}
+
+func (s *schemaBuilder) typ(t typ) *reflection.TypeT {
+	elementSize := sizes[t.element]
+	if t.base == reflection.BaseTypeVector && t.element == reflection.BaseTypeObj && t.obj.bytesize != 0 {
+		elementSize = t.obj.bytesize
+	}
+	index := -1
+	if t.obj != nil {
+		index = s.objectIndex[t.obj]
+	} else if t.enum != nil {
+		index = s.enumIndex[t.enum]
+	}
+	return &reflection.TypeT{
+		BaseType:    t.base,
+		Element:     t.element,
+		Index:       int32(index),
+		FixedLength: uint16(t.fixedLength),
+		BaseSize:    uint32(sizes[t.base]),
+		ElementSize: uint32(elementSize),
+	}
+}
+
+// attributes returns attrs sorted by name, leaving out the built in ones
+// unless Options.Builtins is set.
+func (s *schemaBuilder) attributes(attrs attributes) []*reflection.KeyValueT {
+	var kvs []*reflection.KeyValueT
+	for name, v := range attrs {
+		if s.p.opts.Builtins || !s.p.attributes[name] {
+			kvs = append(kvs, &reflection.KeyValueT{Key: name, Value: v.constant})
+		}
+	}
+	sort.Slice(kvs, func(i, j int) bool { return kvs[i].Key < kvs[j].Key })
+	return kvs
+}
+
+func (s *schemaBuilder) doc(doc []string) []string {
+	if !s.p.opts.Comments || len(doc) == 0 {
+		return nil
+	}
+	return doc
+}
@@ -0,0 +1,360 @@
+package idl
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/google/flatbuffers/go/reflection"
+)
+
+// parseMetadata parses the attributes in parentheses following a
+// declaration, if any, into attrs. The first of repeated attributes wins.
+func (p *parser) parseMetadata(attrs attributes) error {
+	if !p.is('(') {
+		return nil
+	}
+	if err := p.next(); err != nil {
+		return err
+	}
+	for {
+		name := p.lex.text
+		if !p.is(tokenIdentifier) && !p.is(tokenString) {
+			return p.errorf("attribute name must be either identifier or string: %s", name)
+		}
+		if _, ok := p.attributes[name]; !ok {
+			return p.errorf("user define attributes must be declared before use: %s", name)
+		}
+		if err := p.next(); err != nil {
+			return err
+		}
+		v := &value{constant: "0"}
+		if attrs[name] == nil {
+			attrs[name] = v
+		}
+		if p.is(':') {
+			if err := p.next(); err != nil {
+				return err
+			}
+			if err := p.parseSingleValue(name, v, true); err != nil {
+				return err
+			}
+		}
+		if p.is(')') {
+			return p.next()
+		}
+		if err := p.expect(','); err != nil {
+			return err
+		}
+	}
+}
+
+// parseSingleValue parses a scalar, string or empty vector constant into v,
+// whose type is that of the field it initializes, or none for attributes.
+// If check is set, scalar constants are checked against their type and
+// normalized.
+func (p *parser) parseSingleValue(name string, v *value, check bool) error {
+	if p.is('+') || p.is('-') {
+		// A sign before nan, inf or a function.
+		sign := string(rune(p.lex.token))
+		if err := p.next(); err != nil {
+			return err
+		}
+		if !p.is(tokenIdentifier) {
+			return p.errorf("constant name expected")
+		}
+		p.lex.text = sign + p.lex.text
+	}
+	in := v.typ.base
+	token := p.lex.token
+	at := p.position()
+	if token == tokenIdentifier && p.lex.peek(0) == '(' {
+		return p.parseFunction(name, v)
+	}
+
+	// typed takes the current token as the constant of v, giving v the type
+	// req if it has none yet and typed is not set.
+	typed := func(typed bool, req reflection.BaseType) error {
+		v.constant = p.lex.text
+		if !typed {
+			if v.typ.base != reflection.BaseTypeNone {
+				return p.errorf("type mismatch: expecting: %s, found: %s, name: %s, value: %s", typeName(v.typ.base), typeName(req), name, v.constant)
+			}
+			v.typ.base = req
+		}
+		// Hexadecimal integers can't initialize floats.
+		if token != tokenFloat && isFloat(v.typ.base) {
+			s := v.constant
+			if k := strings.IndexAny(s, "0123456789."); k >= 0 && len(s) > k+1 && s[k] == '0' && s[k+1]|0x20 == 'x' &&
+				!strings.ContainsAny(s[k+2:], "pP") {
+				return p.errorf("invalid number, the exponent suffix of hexadecimal floating-point literals is mandatory: \"%s\"", s)
+			}
+		}
+		return p.next()
+	}
+
+	var err error
+	matched := true
+	switch {
+	case token == tokenString && in == reflection.BaseTypeString:
+		err = typed(true, reflection.BaseTypeString)
+	case token == tokenIdentifier || token == tokenString:
+		if token == tokenString && isScalar(in) && !p.lex.trivial {
+			return p.errorf("type mismatch or invalid value, an initializer of non-string field must be trivial ASCII string: type: %s, name: %s, value: %s", typeName(in), name, p.lex.text)
+		}
+		text := p.lex.text
+		switch {
+		case in == reflection.BaseTypeBool && (text == "true" || text == "false"):
+			p.lex.text = "0"
+			if text == "true" {
+				p.lex.text = "1"
+			}
+			err = typed(true, reflection.BaseTypeBool)
+		case isScalar(in) && text == "null":
+			v.constant = "null"
+			err = p.next()
+		case isInteger(in) && in != reflection.BaseTypeBool && len(text) > 0 && isIdentifierStart(text[0]):
+			if v.constant, err = p.parseEnumFromString(v.typ); err == nil {
+				err = p.next()
+			}
+		default:
+			if token == tokenString && isScalar(in) {
+				p.lex.text = strings.TrimRight(text, " ")
+				if isFloat(in) && strings.Contains(p.lex.text, ")") {
+					return p.errorf("invalid number: %s", p.lex.text)
+				}
+			}
+			switch {
+			case isFloat(in):
+				err = typed(true, reflection.BaseTypeFloat)
+			case isInteger(in):
+				err = typed(true, reflection.BaseTypeInt)
+			case token == tokenString:
+				// Attributes take strings as is.
+				err = typed(false, reflection.BaseTypeString)
+			default:
+				matched = false
+			}
+		}
+	case token == tokenFloat && isFloat(in):
+		err = typed(true, reflection.BaseTypeFloat)
+	case token == tokenInteger:
+		err = typed(isScalar(in), reflection.BaseTypeInt)
+	case isVector(in) && token == '[':
+		if err := p.next(); err != nil {
+			return err
+		}
+		if !p.is(']') {
+			return p.errorf("Expected `]` in vector default")
+		}
+		v.constant = "[]"
+		err = p.next()
+	default:
+		matched = false
+	}
+	if err != nil {
+		return err
+	}
+	if !matched {
+		return p.errorf("Cannot assign token starting with '%s' to value of <%s> type.", p.lex.tokenString(), typeName(in))
+	}
+
+	b := v.typ.base
+	if !check || !isScalar(b) || v.constant == "null" {
+		return nil
+	}
+	if isFloat(b) {
+		if _, ok := parseFloat(v.constant); !ok {
+			return at.errorf("invalid number: \"%s\"", v.constant)
+		}
+		return nil
+	}
+	n, err := parseInteger(v.constant, b)
+	if err != nil {
+		return at.errorf("%v", err)
+	}
+	if isUnsigned(b) {
+		v.constant = strconv.FormatUint(uint64(n), 10)
+	} else {
+		v.constant = strconv.FormatInt(n, 10)
+	}
+	return nil
+}
+
+// parseFunction parses a conversion function, such as deg(pi), initializing
+// a float.
+func (p *parser) parseFunction(name string, v *value) error {
+	fn := p.lex.text
+	if !isFloat(v.typ.base) {
+		return p.errorf("%s: type of argument mismatch, expecting: double, found: %s, name: %s, value: %s", fn, typeName(v.typ.base), name, v.constant)
+	}
+	if err := p.next(); err != nil {
+		return err
+	}
+	if err := p.expect('('); err != nil {
+		return err
+	}
+	if err := p.parseSingleValue(name, v, false); err != nil {
+		return err
+	}
+	if err := p.expect(')'); err != nil {
+		return err
+	}
+	x, ok := parseFloat(v.constant)
+	if !ok {
+		return p.errorf("invalid number: \"%s\"", v.constant)
+	}
+	var y float64
+	switch fn {
+	case "deg":
+		y = x / math.Pi * 180
+	case "rad":
+		y = x * math.Pi / 180
+	case "sin":
+		y = math.Sin(x)
+	case "cos":
+		y = math.Cos(x)
+	case "tan":
+		y = math.Tan(x)
+	case "asin":
+		y = math.Asin(x)
+	case "acos":
+		y = math.Acos(x)
+	case "atan":
+		y = math.Atan(x)
+	default:
+		return p.errorf("Unknown conversion function: %s, field name: %s, value: %s", fn, name, v.constant)
+	}
+	v.constant = formatFloat(y)
+	return nil
+}
+
+// formatFloat formats f like flatc's NumToString, with 12 decimals less the
+// trailing zeros.
+func formatFloat(f float64) string {
+	s := strconv.FormatFloat(f, 'f', 12, 64)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s = strings.TrimRight(s, "0")
+		if s[len(s)-1] == '.' {
+			s += "0"
+		}
+	}
+	return s
+}
+
+// parseEnumFromString parses the current identifier or string, one or more
+// space separated enum values, into the integer constant of their bitwise or.
+// Values of fields typed by other than an enum are qualified by their enum.
+func (p *parser) parseEnumFromString(t typ) (string, error) {
+	base := t.base
+	if t.enum != nil {
+		base = t.enum.underlying.base
+	}
+	if !isInteger(base) {
+		return "", p.errorf("not a valid value for this field")
+	}
+	var u uint64
+	for _, word := range strings.Split(p.lex.text, " ") {
+		var v *enumVal
+		if t.enum != nil {
+			v = t.enum.byName[word]
+		} else {
+			dot := strings.IndexByte(word, '.')
+			if dot < 0 {
+				return "", p.errorf("enum values need to be qualified by an enum type")
+			}
+			e := p.lookupEnum(word[:dot])
+			if e == nil {
+				return "", p.errorf("unknown enum: %s", word[:dot])
+			}
+			v = e.byName[word[dot+1:]]
+		}
+		if v == nil {
+			return "", p.errorf("unknown enum value: %s", word)
+		}
+		u |= uint64(v.value)
+	}
+	if isUnsigned(base) {
+		return strconv.FormatUint(u, 10), nil
+	}
+	return strconv.FormatInt(int64(u), 10), nil
+}
+
+// integerRange returns the smallest and largest values of an integer type.
+func integerRange(b reflection.BaseType) (int64, uint64) {
+	switch b {
+	case reflection.BaseTypeByte:
+		return math.MinInt8, math.MaxInt8
+	case reflection.BaseTypeShort:
+		return math.MinInt16, math.MaxInt16
+	case reflection.BaseTypeUShort:
+		return 0, math.MaxUint16
+	case reflection.BaseTypeInt:
+		return math.MinInt32, math.MaxInt32
+	case reflection.BaseTypeUInt:
+		return 0, math.MaxUint32
+	case reflection.BaseTypeLong:
+		return math.MinInt64, math.MaxInt64
+	case reflection.BaseTypeULong:
+		return 0, math.MaxUint64
+	}
+	return 0, math.MaxUint8
+}
+
+// parseInteger parses s, a decimal or 0x prefixed hexadecimal integer with
+// an optional sign, as a value of the integer type b. It returns the bits of
+// the value, which is unsigned for ulong.
+func parseInteger(s string, b reflection.BaseType) (int64, error) {
+	digits := strings.TrimLeft(s, " \t\n\v\f\r")
+	neg := false
+	if digits != "" && (digits[0] == '+' || digits[0] == '-') {
+		neg = digits[0] == '-'
+		digits = digits[1:]
+	}
+	base := 10
+	if len(digits) > 1 && digits[0] == '0' && digits[1]|0x20 == 'x' {
+		base = 16
+		digits = digits[2:]
+	}
+	u, err := strconv.ParseUint(digits, base, 64)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("invalid number: \"%s\"", s)
+	}
+	lo, hi := integerRange(b)
+	doesNotFit := fmt.Errorf("invalid number: \"%s\", constant does not fit %s", s, intervalString(b))
+	switch {
+	case err != nil:
+		return 0, doesNotFit
+	case neg:
+		if u > uint64(-(lo+1))+1 {
+			return 0, doesNotFit
+		}
+		return int64(-u), nil
+	case u > hi:
+		return 0, doesNotFit
+	}
+	return int64(u), nil
+}
+
+// quietNaN is the NaN flatc writes for nan defaults, whatever their sign.
+var quietNaN = math.Float64frombits(0x7ff8000000000000)
+
+// parseFloat parses s, a decimal or hexadecimal floating-point number, nan,
+// inf or infinity, like strtod.
+func parseFloat(s string) (float64, bool) {
+	s = strings.TrimLeft(s, " \t\n\v\f\r")
+	// strconv doesn't take a sign before nan.
+	if len(s) == 4 && (s[0] == '+' || s[0] == '-') && strings.EqualFold(s[1:], "nan") {
+		return quietNaN, true
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return 0, false
+	}
+	if math.IsNaN(f) {
+		f = quietNaN
+	}
+	return f, true
+}
@@ -0,0 +1,37 @@
+package flatbuffers
+
+// Interner returns the same string for equal byte slices, so that the
+// strings that repeat from one buffer to the next, such as names and tags,
+// are allocated once by the object API UnPackToInterned methods. It keeps
+// every string it returned until Reset, so it suits small sets of values. It
+// is not safe for concurrent use.
+type Interner struct {
+	strings map[string]string
+}
+
+// Intern returns b as a string, the same string as for an equal b before. A
+// nil Interner returns a new string every time.
+func (in *Interner) Intern(b []byte) string {
+	if in == nil {
+		return string(b)
+	}
+	if s, ok := in.strings[string(b)]; ok {
+		return s
+	}
+	if in.strings == nil {
+		in.strings = make(map[string]string)
+	}
+	s := string(b)
+	in.strings[s] = s
+	return s
+}
+
+// Len returns the number of strings held by the Interner.
+func (in *Interner) Len() int {
+	return len(in.strings)
+}
+
+// Reset drops the strings held by the Interner.
+func (in *Interner) Reset() {
+	in.strings = nil
+}
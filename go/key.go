@@ -0,0 +1,125 @@
+package flatbuffers
+
+import (
+	"bytes"
+	"math"
+)
+
+// Key is the key field of a table, as extracted by the generated key
+// functions, such as MonsterKey, to sort a vector of tables. Keys of the same
+// table type compare in the order of their field values.
+type Key struct {
+	bytes []byte
+	num   uint64
+}
+
+// KeyFunc returns the key of the table at off, an offset returned by
+// EndObject, in buf, the Bytes of the Builder.
+type KeyFunc func(off UOffsetT, buf []byte) Key
+
+// StringKey returns the Key of a string field.
+func StringKey(s []byte) Key {
+	return Key{bytes: s}
+}
+
+// Uint64Key returns the Key of an unsigned integer field.
+func Uint64Key(v uint64) Key {
+	return Key{num: v}
+}
+
+// Int64Key returns the Key of a signed integer field.
+func Int64Key(v int64) Key {
+	return Key{num: uint64(v) ^ 1<<63}
+}
+
+// Float64Key returns the Key of a floating point field.
+func Float64Key(v float64) Key {
+	bits := math.Float64bits(v)
+	if bits&(1<<63) != 0 {
+		return Key{num: ^bits}
+	}
+	return Key{num: bits | 1<<63}
+}
+
+// BoolKey returns the Key of a bool field.
+func BoolKey(v bool) Key {
+	if v {
+		return Key{num: 1}
+	}
+	return Key{}
+}
+
+// Compare returns -1, 0 or 1 as k is less than, equal to or greater than
+// other.
+func (k Key) Compare(other Key) int {
+	if k.num != other.num {
+		if k.num < other.num {
+			return -1
+		}
+		return 1
+	}
+	return bytes.Compare(k.bytes, other.bytes)
+}
+
+// keyedTable is a table offset and its key, sorted by
+// CreateVectorOfSortedTablesByKey.
+type keyedTable struct {
+	off UOffsetT
+	key Key
+}
+
+// insertionSortRun is the length of the runs that sortKeyedTables sorts by
+// insertion before merging them.
+const insertionSortRun = 16
+
+// sortKeyedTables sorts tables stably by key with a bottom-up merge sort,
+// using tmp, which is as long as tables, for the merges.
+func sortKeyedTables(tables, tmp []keyedTable) {
+	n := len(tables)
+	if n < 2 {
+		return
+	}
+	for lo := 0; lo < n; lo += insertionSortRun {
+		hi := lo + insertionSortRun
+		if hi > n {
+			hi = n
+		}
+		for i := lo + 1; i < hi; i++ {
+			for j := i; j > lo && tables[j].key.Compare(tables[j-1].key) < 0; j-- {
+				tables[j], tables[j-1] = tables[j-1], tables[j]
+			}
+		}
+	}
+	src, dst := tables, tmp
+	for width := insertionSortRun; width < n; width *= 2 {
+		for lo := 0; lo < n; lo += 2 * width {
+			mid, hi := lo+width, lo+2*width
+			if mid > n {
+				mid = n
+			}
+			if hi > n {
+				hi = n
+			}
+			mergeKeyedTables(dst[lo:hi], src[lo:mid], src[mid:hi])
+		}
+		src, dst = dst, src
+	}
+	if &src[0] != &tables[0] {
+		copy(tables, src)
+	}
+}
+
+// mergeKeyedTables merges the sorted a and b into dst, taking from a first
+// when keys are equal.
+func mergeKeyedTables(dst, a, b []keyedTable) {
+	i, j := 0, 0
+	for k := range dst {
+		if j == len(b) || (i < len(a) && b[j].key.Compare(a[i].key) >= 0) {
+			dst[k] = a[i]
+			i++
+		} else {
+			dst[k] = b[j]
+			j++
+		}
+	}
+}
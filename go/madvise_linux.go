@@ -0,0 +1,29 @@
+package flatbuffers
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// Advise tells the kernel how the file will be accessed.
+func (m *MappedFile) Advise(advice Advice) error {
+	if !m.mapped {
+		return nil
+	}
+	var a int
+	switch advice {
+	case AdviseNormal:
+		a = syscall.MADV_NORMAL
+	case AdviseRandom:
+		a = syscall.MADV_RANDOM
+	case AdviseSequential:
+		a = syscall.MADV_SEQUENTIAL
+	case AdviseWillNeed:
+		a = syscall.MADV_WILLNEED
+	case AdviseDontNeed:
+		a = syscall.MADV_DONTNEED
+	default:
+		return fmt.Errorf("flatbuffers: unknown advice %d", advice)
+	}
+	return syscall.Madvise(m.data, a)
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+// +build !linux
+
+package flatbuffers
+
+// Advise does nothing on platforms other than Linux.
+func (m *MappedFile) Advise(advice Advice) error {
+	return nil
+}
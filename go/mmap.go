@@ -0,0 +1,82 @@
+package flatbuffers
+
+import (
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// MappedFile is a file mapped read-only into memory by MapFile. Its bytes can
+// be passed to the generated GetRootAs functions like any other buffer, but
+// are only valid until Close.
+type MappedFile struct {
+	data   []byte
+	mapped bool
+}
+
+// Advice is a hint about how a MappedFile will be accessed, given to the
+// kernel with madvise. Hints are ignored on platforms other than Linux.
+type Advice int
+
+const (
+	// AdviseNormal is the default read-ahead.
+	AdviseNormal Advice = iota
+	// AdviseRandom disables read-ahead, for lookups scattered over the file.
+	AdviseRandom
+	// AdviseSequential reads ahead aggressively, for scans of the file.
+	AdviseSequential
+	// AdviseWillNeed starts reading the whole file in.
+	AdviseWillNeed
+	// AdviseDontNeed lets the kernel drop the pages read so far.
+	AdviseDontNeed
+)
+
+// Bytes returns the contents of the file. The Mutate methods of tables in it
+// return false instead of writing, but writing to the slice directly faults.
+func (m *MappedFile) Bytes() []byte {
+	return m.data
+}
+
+// readOnly holds the address ranges of the files mapped by MapFile, so that
+// the Mutate methods can refuse to write to them. count lets them skip the
+// lookup while nothing is mapped.
+var readOnly struct {
+	count  int32
+	mu     sync.RWMutex
+	ranges map[uintptr]uintptr // start address to end address
+}
+
+func addReadOnly(b []byte) {
+	start := uintptr(unsafe.Pointer(&b[0]))
+	readOnly.mu.Lock()
+	defer readOnly.mu.Unlock()
+	if readOnly.ranges == nil {
+		readOnly.ranges = make(map[uintptr]uintptr)
+	}
+	readOnly.ranges[start] = start + uintptr(len(b))
+	atomic.AddInt32(&readOnly.count, 1)
+}
+
+func removeReadOnly(b []byte) {
+	start := uintptr(unsafe.Pointer(&b[0]))
+	readOnly.mu.Lock()
+	defer readOnly.mu.Unlock()
+	delete(readOnly.ranges, start)
+	atomic.AddInt32(&readOnly.count, -1)
+}
+
+// isReadOnly reports whether b is in a file mapped by MapFile.
+func isReadOnly(b []byte) bool {
+	if atomic.LoadInt32(&readOnly.count) == 0 || len(b) == 0 {
+		return false
+	}
+	p := uintptr(unsafe.Pointer(&b[0]))
+	readOnly.mu.RLock()
+	defer readOnly.mu.RUnlock()
+	for start, end := range readOnly.ranges {
+		if p >= start && p < end {
+			return true
+		}
+	}
+	return false
+}
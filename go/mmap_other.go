@@ -0,0 +1,23 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package flatbuffers
+
+import "os"
+
+// MapFile reads the file at path into memory. It is only mapped on platforms
+// with mmap, where its Mutate methods are refused; here the buffer is an
+// ordinary, writable slice.
+func MapFile(path string) (*MappedFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return &MappedFile{data: data}, nil
+}
+
+// Close releases the buffer.
+func (m *MappedFile) Close() error {
+	m.data = nil
+	return nil
+}
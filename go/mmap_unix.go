@@ -0,0 +1,49 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package flatbuffers
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// MapFile maps the file at path read-only into memory with mmap, so that
+// large buffers can be read without copying them to the heap. The pages are
+// read in by the kernel as they are accessed. An empty file is not mapped.
+func MapFile(path string) (*MappedFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := fi.Size()
+	if size == 0 {
+		return &MappedFile{}, nil
+	}
+	if int64(int(size)) != size {
+		return nil, fmt.Errorf("flatbuffers: %s is too large to map", path)
+	}
+	data, err := syscall.Mmap(int(f.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, &os.PathError{Op: "mmap", Path: path, Err: err}
+	}
+	addReadOnly(data)
+	return &MappedFile{data: data, mapped: true}, nil
+}
+
+// Close unmaps the file. The tables read from it must not be used afterwards.
+func (m *MappedFile) Close() error {
+	if !m.mapped {
+		return nil
+	}
+	removeReadOnly(m.data)
+	data := m.data
+	m.data, m.mapped = nil, false
+	return syscall.Munmap(data)
+}
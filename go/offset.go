@@ -0,0 +1,70 @@
+//go:build go1.18
+// +build go1.18
+
+package flatbuffers
+
+// Offset is a UOffsetT that refers to a T, as taken and returned by the
+// builder functions that flatc generates with --go-typed-offsets: Monster for
+// a table or Vec3 for a struct of the generated types, String for a string,
+// Vector[E] for a vector of E, and a union type, such as Any, for a member of
+// the union. Passing one where another type is expected, such as a Stat as the
+// enemy Monster, does not compile. Converting to and from UOffsetT, or to the
+// union type from a member of the union, uses the raw API:
+//
+//	test := flatbuffers.Offset[example.Any](monster)
+type Offset[T any] UOffsetT
+
+// String is the T of the Offset of a string.
+type String struct{}
+
+// Vector is the T of the Offset of a vector of E. The E of a vector of tables
+// or strings is their Offset, as in Vector[Offset[String]].
+type Vector[E any] struct{}
+
+// CreateString is Builder.CreateString returning an Offset.
+func CreateString(b *Builder, s string) Offset[String] {
+	return Offset[String](b.CreateString(s))
+}
+
+// CreateSharedString is Builder.CreateSharedString returning an Offset.
+func CreateSharedString(b *Builder, s string) Offset[String] {
+	return Offset[String](b.CreateSharedString(s))
+}
+
+// CreateByteString is Builder.CreateByteString returning an Offset.
+func CreateByteString(b *Builder, s []byte) Offset[String] {
+	return Offset[String](b.CreateByteString(s))
+}
+
+// CreateByteVector is Builder.CreateByteVector returning an Offset.
+func CreateByteVector(b *Builder, v []byte) Offset[Vector[byte]] {
+	return Offset[Vector[byte]](b.CreateByteVector(v))
+}
+
+// CreateNestedFlatBuffer is Builder.CreateNestedFlatBuffer returning an
+// Offset.
+func CreateNestedFlatBuffer(b *Builder, nested *Builder) Offset[Vector[byte]] {
+	return Offset[Vector[byte]](b.CreateNestedFlatBuffer(nested))
+}
+
+// EndVector is Builder.EndVector returning the Offset of a vector of E, the
+// elements prepended since the generated Start function of the vector.
+func EndVector[E any](b *Builder, vectorNumElems int) Offset[Vector[E]] {
+	return Offset[Vector[E]](b.EndVector(vectorNumElems))
+}
+
+// CreateVectorOfTables is Builder.CreateVectorOfTables taking and returning
+// Offsets.
+func CreateVectorOfTables[T any](b *Builder, offsets []Offset[T]) Offset[Vector[Offset[T]]] {
+	b.assertNotNested()
+	if b.offsets != nil {
+		for _, off := range offsets {
+			b.offsets.check(UOffsetT(off), b.Offset())
+		}
+	}
+	b.StartVector(4, len(offsets), 4)
+	for i := len(offsets) - 1; i >= 0; i-- {
+		b.PrependUOffsetT(UOffsetT(offsets[i]))
+	}
+	return EndVector[Offset[T]](b, len(offsets))
+}
@@ -0,0 +1,136 @@
+package flatbuffers
+
+import "math/rand"
+
+// Random is the source of the generated Random functions of the object API,
+// such as RandomMonsterT, which build random objects that pack to valid
+// buffers, for property tests and fuzzing. Required fields are always set and
+// other fields at random; optional child tables stop at MaxDepth nested
+// tables, and strings and vectors are at most MaxLength long.
+//
+// A Random implements testing/quick.Generator for a type with a single line:
+//
+//	func (randomMonster) Generate(r *rand.Rand, size int) reflect.Value {
+//		return reflect.ValueOf(randomMonster{example.RandomMonsterT(flatbuffers.NewRandom(r, size))})
+//	}
+type Random struct {
+	// Rand is the source of the values. It is not embedded, as Random's
+	// Float32 and Float64 do not return values in [0, 1) as its own do.
+	Rand *rand.Rand
+	// MaxDepth is the depth of nested tables below which no optional child
+	// tables are generated.
+	MaxDepth int
+	// MaxLength bounds the length of strings and vectors.
+	MaxLength int
+
+	depth int
+}
+
+// NewRandom returns a Random taking values from r, with strings and vectors
+// at most size long, like those of testing/quick, and at most 3 nested tables.
+func NewRandom(r *rand.Rand, size int) *Random {
+	return &Random{Rand: r, MaxDepth: 3, MaxLength: size}
+}
+
+// Enter and Leave bracket the generation of a table, to count its depth.
+func (r *Random) Enter() {
+	r.depth++
+}
+
+// Leave ends the table started by Enter.
+func (r *Random) Leave() {
+	r.depth--
+}
+
+// Optional reports whether to set a field that is not required.
+func (r *Random) Optional() bool {
+	return r.Intn(2) == 0
+}
+
+// Child reports whether to set a child table that is not required, which is
+// never below MaxDepth.
+func (r *Random) Child() bool {
+	return r.depth < r.MaxDepth && r.Optional()
+}
+
+// Intn returns a random int in [0, n), as rand.Rand's Intn does.
+func (r *Random) Intn(n int) int {
+	return r.Rand.Intn(n)
+}
+
+// Length returns the length of a string or vector.
+func (r *Random) Length() int {
+	if r.MaxLength <= 0 {
+		return 0
+	}
+	return r.Intn(r.MaxLength + 1)
+}
+
+// Bytes returns a vector of random bytes.
+func (r *Random) Bytes() []byte {
+	b := make([]byte, r.Length())
+	r.Rand.Read(b)
+	return b
+}
+
+// Text returns a string of random bytes, which may not be valid UTF-8.
+func (r *Random) Text() string {
+	return string(r.Bytes())
+}
+
+// Bool returns a random bool.
+func (r *Random) Bool() bool {
+	return r.Intn(2) == 0
+}
+
+// Uint32 returns a random uint32.
+func (r *Random) Uint32() uint32 {
+	return r.Rand.Uint32()
+}
+
+// Uint64 returns a random uint64.
+func (r *Random) Uint64() uint64 {
+	return r.Rand.Uint64()
+}
+
+// Int8 returns a random int8.
+func (r *Random) Int8() int8 {
+	return int8(r.Uint32())
+}
+
+// Byte returns a random byte.
+func (r *Random) Byte() byte {
+	return uint8(r.Uint32())
+}
+
+// Int16 returns a random int16.
+func (r *Random) Int16() int16 {
+	return int16(r.Uint32())
+}
+
+// Uint16 returns a random uint16.
+func (r *Random) Uint16() uint16 {
+	return uint16(r.Uint32())
+}
+
+// Int32 returns a random int32.
+func (r *Random) Int32() int32 {
+	return int32(r.Uint32())
+}
+
+// Int64 returns a random int64.
+func (r *Random) Int64() int64 {
+	return int64(r.Uint64())
+}
+
+// Float32 returns a random float32, which is finite but may be negative or
+// large.
+func (r *Random) Float32() float32 {
+	return float32(r.Float64())
+}
+
+// Float64 returns a random float64, which is finite but may be negative or
+// large.
+func (r *Random) Float64() float64 {
+	return r.Rand.NormFloat64() * float64(uint64(1)<<uint(r.Intn(32)))
+}
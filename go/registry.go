@@ -0,0 +1,126 @@
+package flatbuffers
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrUnknownIdentifier is returned by Registry.Open for a buffer whose file
+// identifier is not registered.
+var ErrUnknownIdentifier = errors.New("flatbuffers: unknown file identifier")
+
+// RootType describes a root table that a Registry can open. The generated
+// code declares one for each root type with a file identifier, such as
+// MonsterRootType.
+type RootType struct {
+	// Name is the fully qualified name of the table, such as
+	// "MyGame.Example.Monster".
+	Name string
+	// Identifier is the file identifier of its buffers, such as "MONS", or
+	// empty if it has none, in which case the type can only be looked up by
+	// name.
+	Identifier string
+	// New returns a new table of the type, to be initialized with Init.
+	New func() FlatBuffer
+}
+
+// Registry maps file identifiers and fully qualified names to root types, so
+// that buffers of several types can be told apart and opened. The zero value
+// is an empty Registry ready to use, and it is safe for concurrent use.
+type Registry struct {
+	mu           sync.RWMutex
+	byIdentifier map[string]RootType
+	byName       map[string]RootType
+}
+
+// Register adds t to the registry. It fails if t has no name or no New
+// function, if its identifier is not 4 bytes long, or if its name or
+// identifier is already registered.
+func (r *Registry) Register(t RootType) error {
+	if t.Name == "" || t.New == nil {
+		return errors.New("flatbuffers: a root type needs a name and a New function")
+	}
+	if t.Identifier != "" && len(t.Identifier) != fileIdentifierLength {
+		return fmt.Errorf("flatbuffers: file identifier %q is not %d bytes", t.Identifier, fileIdentifierLength)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.byName[t.Name]; ok {
+		return fmt.Errorf("flatbuffers: %s is already registered", t.Name)
+	}
+	if other, ok := r.byIdentifier[t.Identifier]; ok && t.Identifier != "" {
+		return fmt.Errorf("flatbuffers: file identifier %q of %s is already registered by %s", t.Identifier, t.Name, other.Name)
+	}
+	if r.byName == nil {
+		r.byName = make(map[string]RootType)
+		r.byIdentifier = make(map[string]RootType)
+	}
+	r.byName[t.Name] = t
+	if t.Identifier != "" {
+		r.byIdentifier[t.Identifier] = t
+	}
+	return nil
+}
+
+// Lookup returns the root type registered with the fully qualified name.
+func (r *Registry) Lookup(name string) (RootType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.byName[name]
+	return t, ok
+}
+
+// Identify returns the registered root type of buf, a finished buffer with or
+// without a size prefix, by its file identifier.
+func (r *Registry) Identify(buf []byte) (RootType, bool) {
+	t, _, ok := r.identify(buf)
+	return t, ok
+}
+
+// identify also reports whether buf is size prefixed. The identifier of a
+// buffer without a size prefix is looked up first, and that of a size
+// prefixed buffer only if the size prefix fits in buf.
+func (r *Registry) identify(buf []byte) (t RootType, sizePrefixed, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if len(buf) >= SizeUOffsetT+fileIdentifierLength {
+		if t, ok := r.byIdentifier[GetBufferIdentifier(buf)]; ok {
+			return t, false, true
+		}
+	}
+	if len(buf) >= sizePrefixLength+SizeUOffsetT+fileIdentifierLength &&
+		uint64(GetSizePrefix(buf, 0)) <= uint64(len(buf)-sizePrefixLength) {
+		if t, ok := r.byIdentifier[GetSizePrefixedBufferIdentifier(buf)]; ok {
+			return t, true, true
+		}
+	}
+	return RootType{}, false, false
+}
+
+// Open identifies buf like Identify, and returns its root table, such as a
+// *Monster, initialized on buf. It checks that the root offset is within
+// buf, but not the rest of the buffer.
+func (r *Registry) Open(buf []byte) (FlatBuffer, error) {
+	t, sizePrefixed, ok := r.identify(buf)
+	if !ok {
+		if len(buf) < SizeUOffsetT+fileIdentifierLength {
+			return nil, fmt.Errorf("%w: %d bytes", ErrBufferTooShort, len(buf))
+		}
+		return nil, fmt.Errorf("%w: %q", ErrUnknownIdentifier, GetBufferIdentifier(buf))
+	}
+	data := buf
+	if sizePrefixed {
+		data = buf[sizePrefixLength:]
+	}
+	if root := GetUOffsetT(data); uint64(root)+SizeSOffsetT > uint64(len(data)) {
+		return nil, fmt.Errorf("%w: %d in %d bytes", ErrInvalidRootOffset, root, len(data))
+	}
+	fb := t.New()
+	if sizePrefixed {
+		GetSizePrefixedRootAs(buf, 0, fb)
+	} else {
+		GetRootAs(buf, 0, fb)
+	}
+	return fb, nil
+}
@@ -294,92 +294,140 @@ func (t *Table) GetVOffsetTSlot(slot VOffsetT, d VOffsetT) VOffsetT {
 	return VOffsetT(off)
 }
 
+// The Mutate methods return false, rather than writing, if the table is in a
+// read-only buffer from MapFile.
+
 // MutateBool updates a bool at the given offset.
 func (t *Table) MutateBool(off UOffsetT, n bool) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteBool(t.Bytes[off:], n)
 	return true
 }
 
 // MutateByte updates a Byte at the given offset.
 func (t *Table) MutateByte(off UOffsetT, n byte) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteByte(t.Bytes[off:], n)
 	return true
 }
 
 // MutateUint8 updates a Uint8 at the given offset.
 func (t *Table) MutateUint8(off UOffsetT, n uint8) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteUint8(t.Bytes[off:], n)
 	return true
 }
 
 // MutateUint16 updates a Uint16 at the given offset.
 func (t *Table) MutateUint16(off UOffsetT, n uint16) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteUint16(t.Bytes[off:], n)
 	return true
 }
 
 // MutateUint32 updates a Uint32 at the given offset.
 func (t *Table) MutateUint32(off UOffsetT, n uint32) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteUint32(t.Bytes[off:], n)
 	return true
 }
 
 // MutateUint64 updates a Uint64 at the given offset.
 func (t *Table) MutateUint64(off UOffsetT, n uint64) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteUint64(t.Bytes[off:], n)
 	return true
 }
 
 // MutateInt8 updates a Int8 at the given offset.
 func (t *Table) MutateInt8(off UOffsetT, n int8) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteInt8(t.Bytes[off:], n)
 	return true
 }
 
 // MutateInt16 updates a Int16 at the given offset.
 func (t *Table) MutateInt16(off UOffsetT, n int16) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteInt16(t.Bytes[off:], n)
 	return true
 }
 
 // MutateInt32 updates a Int32 at the given offset.
 func (t *Table) MutateInt32(off UOffsetT, n int32) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteInt32(t.Bytes[off:], n)
 	return true
 }
 
 // MutateInt64 updates a Int64 at the given offset.
 func (t *Table) MutateInt64(off UOffsetT, n int64) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteInt64(t.Bytes[off:], n)
 	return true
 }
 
 // MutateFloat32 updates a Float32 at the given offset.
 func (t *Table) MutateFloat32(off UOffsetT, n float32) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteFloat32(t.Bytes[off:], n)
 	return true
 }
 
 // MutateFloat64 updates a Float64 at the given offset.
 func (t *Table) MutateFloat64(off UOffsetT, n float64) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteFloat64(t.Bytes[off:], n)
 	return true
 }
 
 // MutateUOffsetT updates a UOffsetT at the given offset.
 func (t *Table) MutateUOffsetT(off UOffsetT, n UOffsetT) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteUOffsetT(t.Bytes[off:], n)
 	return true
 }
 
 // MutateVOffsetT updates a VOffsetT at the given offset.
 func (t *Table) MutateVOffsetT(off UOffsetT, n VOffsetT) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteVOffsetT(t.Bytes[off:], n)
 	return true
 }
 
 // MutateSOffsetT updates a SOffsetT at the given offset.
 func (t *Table) MutateSOffsetT(off UOffsetT, n SOffsetT) bool {
+	if isReadOnly(t.Bytes) {
+		return false
+	}
 	WriteSOffsetT(t.Bytes[off:], n)
 	return true
 }
@@ -387,8 +435,7 @@ func (t *Table) MutateSOffsetT(off UOffsetT, n SOffsetT) bool {
 // MutateBoolSlot updates the bool at given vtable location
 func (t *Table) MutateBoolSlot(slot VOffsetT, n bool) bool {
 	if off := t.Offset(slot); off != 0 {
-		t.MutateBool(t.Pos+UOffsetT(off), n)
-		return true
+		return t.MutateBool(t.Pos+UOffsetT(off), n)
 	}
 
 	return false
@@ -397,8 +444,7 @@ func (t *Table) MutateBoolSlot(slot VOffsetT, n bool) bool {
 // MutateByteSlot updates the byte at given vtable location
 func (t *Table) MutateByteSlot(slot VOffsetT, n byte) bool {
 	if off := t.Offset(slot); off != 0 {
-		t.MutateByte(t.Pos+UOffsetT(off), n)
-		return true
+		return t.MutateByte(t.Pos+UOffsetT(off), n)
 	}
 
 	return false
@@ -407,8 +453,7 @@ func (t *Table) MutateByteSlot(slot VOffsetT, n byte) bool {
 // MutateInt8Slot updates the int8 at given vtable location
 func (t *Table) MutateInt8Slot(slot VOffsetT, n int8) bool {
 	if off := t.Offset(slot); off != 0 {
-		t.MutateInt8(t.Pos+UOffsetT(off), n)
-		return true
+		return t.MutateInt8(t.Pos+UOffsetT(off), n)
 	}
 
 	return false
@@ -417,8 +462,7 @@ func (t *Table) MutateInt8Slot(slot VOffsetT, n int8) bool {
 // MutateUint8Slot updates the uint8 at given vtable location
 func (t *Table) MutateUint8Slot(slot VOffsetT, n uint8) bool {
 	if off := t.Offset(slot); off != 0 {
-		t.MutateUint8(t.Pos+UOffsetT(off), n)
-		return true
+		return t.MutateUint8(t.Pos+UOffsetT(off), n)
 	}
 
 	return false
@@ -427,8 +471,7 @@ func (t *Table) MutateUint8Slot(slot VOffsetT, n uint8) bool {
 // MutateInt16Slot updates the int16 at given vtable location
 func (t *Table) MutateInt16Slot(slot VOffsetT, n int16) bool {
 	if off := t.Offset(slot); off != 0 {
-		t.MutateInt16(t.Pos+UOffsetT(off), n)
-		return true
+		return t.MutateInt16(t.Pos+UOffsetT(off), n)
 	}
 
 	return false
@@ -437,8 +480,7 @@ func (t *Table) MutateInt16Slot(slot VOffsetT, n int16) bool {
 // MutateUint16Slot updates the uint16 at given vtable location
 func (t *Table) MutateUint16Slot(slot VOffsetT, n uint16) bool {
 	if off := t.Offset(slot); off != 0 {
-		t.MutateUint16(t.Pos+UOffsetT(off), n)
-		return true
+		return t.MutateUint16(t.Pos+UOffsetT(off), n)
 	}
 
 	return false
@@ -447,8 +489,7 @@ func (t *Table) MutateUint16Slot(slot VOffsetT, n uint16) bool {
 // MutateInt32Slot updates the int32 at given vtable location
 func (t *Table) MutateInt32Slot(slot VOffsetT, n int32) bool {
 	if off := t.Offset(slot); off != 0 {
-		t.MutateInt32(t.Pos+UOffsetT(off), n)
-		return true
+		return t.MutateInt32(t.Pos+UOffsetT(off), n)
 	}
 
 	return false
@@ -457,8 +498,7 @@ func (t *Table) MutateInt32Slot(slot VOffsetT, n int32) bool {
 // MutateUint32Slot updates the uint32 at given vtable location
 func (t *Table) MutateUint32Slot(slot VOffsetT, n uint32) bool {
 	if off := t.Offset(slot); off != 0 {
-		t.MutateUint32(t.Pos+UOffsetT(off), n)
-		return true
+		return t.MutateUint32(t.Pos+UOffsetT(off), n)
 	}
 
 	return false
@@ -467,8 +507,7 @@ func (t *Table) MutateUint32Slot(slot VOffsetT, n uint32) bool {
 // MutateInt64Slot updates the int64 at given vtable location
 func (t *Table) MutateInt64Slot(slot VOffsetT, n int64) bool {
 	if off := t.Offset(slot); off != 0 {
-		t.MutateInt64(t.Pos+UOffsetT(off), n)
-		return true
+		return t.MutateInt64(t.Pos+UOffsetT(off), n)
 	}
 
 	return false
@@ -477,8 +516,7 @@ func (t *Table) MutateInt64Slot(slot VOffsetT, n int64) bool {
 // MutateUint64Slot updates the uint64 at given vtable location
 func (t *Table) MutateUint64Slot(slot VOffsetT, n uint64) bool {
 	if off := t.Offset(slot); off != 0 {
-		t.MutateUint64(t.Pos+UOffsetT(off), n)
-		return true
+		return t.MutateUint64(t.Pos+UOffsetT(off), n)
 	}
 
 	return false
@@ -487,8 +525,7 @@ func (t *Table) MutateUint64Slot(slot VOffsetT, n uint64) bool {
 // MutateFloat32Slot updates the float32 at given vtable location
 func (t *Table) MutateFloat32Slot(slot VOffsetT, n float32) bool {
 	if off := t.Offset(slot); off != 0 {
-		t.MutateFloat32(t.Pos+UOffsetT(off), n)
-		return true
+		return t.MutateFloat32(t.Pos+UOffsetT(off), n)
 	}
 
 	return false
@@ -497,8 +534,7 @@ func (t *Table) MutateFloat32Slot(slot VOffsetT, n float32) bool {
 // MutateFloat64Slot updates the float64 at given vtable location
 func (t *Table) MutateFloat64Slot(slot VOffsetT, n float64) bool {
 	if off := t.Offset(slot); off != 0 {
-		t.MutateFloat64(t.Pos+UOffsetT(off), n)
-		return true
+		return t.MutateFloat64(t.Pos+UOffsetT(off), n)
 	}
 
 	return false
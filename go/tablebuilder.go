@@ -0,0 +1,75 @@
+package flatbuffers
+
+import "fmt"
+
+// TableBuilder is the state of a table being built by a generated builder
+// type, such as MonsterBuilder: the Builder it is built in, and the fields
+// that were added to it. Generated code uses it; other code should not.
+type TableBuilder struct {
+	builder *Builder
+	table   string
+	set     []uint64
+	ended   bool
+	err     error
+}
+
+// Start starts the table, the fully qualified name of a table with numFields
+// fields, in builder.
+func (t *TableBuilder) Start(builder *Builder, table string, numFields int) {
+	builder.StartObject(numFields)
+	t.builder = builder
+	t.table = table
+	t.set = make([]uint64, (numFields+63)/64)
+}
+
+// Field records that the field in slot was added, and returns the Builder to
+// add it to. It panics if the table was not started, or was already ended.
+func (t *TableBuilder) Field(slot int) *Builder {
+	t.assertStarted()
+	t.set[slot/64] |= 1 << uint(slot%64)
+	return t.builder
+}
+
+// Has reports whether the field in slot was added.
+func (t *TableBuilder) Has(slot int) bool {
+	return t.set != nil && t.set[slot/64]&(1<<uint(slot%64)) != 0
+}
+
+// Required records an error naming field, the fully qualified name of the
+// field in slot, unless the field was added and written. Adding a zero offset
+// writes nothing, so it does not count.
+func (t *TableBuilder) Required(slot int, field string) {
+	t.assertStarted()
+	if (!t.Has(slot) || t.builder.vtable[slot] == 0) && t.err == nil {
+		t.err = fmt.Errorf("%w: %s", ErrRequiredField, field)
+	}
+}
+
+// End marks the table as ended, and returns the Builder to end it in. It
+// panics if the table was not started, or was already ended.
+func (t *TableBuilder) End() *Builder {
+	t.assertStarted()
+	t.ended = true
+	return t.builder
+}
+
+// Err returns the error recorded by Required, or else the error recorded by
+// the Builder, which may come from an earlier table, or nil.
+func (t *TableBuilder) Err() error {
+	if t.err == nil && t.builder != nil {
+		return t.builder.err
+	}
+	return t.err
+}
+
+func (t *TableBuilder) assertStarted() {
+	// If you get this assert, you used the zero value of a generated
+	// builder type instead of its New function, or added a field or called
+	// Finish after Finish.
+	if t.builder == nil {
+		panic("Incorrect creation order: table builder was not started.")
+	}
+	if t.ended {
+		panic("Incorrect creation order: " + t.table + " was already finished.")
+	}
+}
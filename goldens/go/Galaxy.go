@@ -62,3 +62,26 @@ func GalaxyAddNumStars(builder *flatbuffers.Builder, numStars int64) {
 func GalaxyEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
+
+// GalaxyBuilder builds a Galaxy with chainable Add methods. It panics
+// if a field is added after Finish, which checks the required fields.
+type GalaxyBuilder struct {
+	table flatbuffers.TableBuilder
+}
+
+// NewGalaxyBuilder starts a Galaxy in builder.
+func NewGalaxyBuilder(builder *flatbuffers.Builder) *GalaxyBuilder {
+	rcv := &GalaxyBuilder{}
+	rcv.table.Start(builder, "Galaxy", 1)
+	return rcv
+}
+func (rcv *GalaxyBuilder) AddNumStars(numStars int64) *GalaxyBuilder {
+	GalaxyAddNumStars(rcv.table.Field(0), numStars)
+	return rcv
+}
+
+// Finish ends the Galaxy and returns its offset, and an error
+// wrapping flatbuffers.ErrRequiredField if a required field was not added.
+func (rcv *GalaxyBuilder) Finish() (flatbuffers.UOffsetT, error) {
+	return GalaxyEnd(rcv.table.End()), rcv.table.Err()
+}
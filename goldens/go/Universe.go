@@ -88,3 +88,30 @@ func UniverseStartGalaxiesVector(builder *flatbuffers.Builder, numElems int) fla
 func UniverseEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
+
+// UniverseBuilder builds a Universe with chainable Add methods. It panics
+// if a field is added after Finish, which checks the required fields.
+type UniverseBuilder struct {
+	table flatbuffers.TableBuilder
+}
+
+// NewUniverseBuilder starts a Universe in builder.
+func NewUniverseBuilder(builder *flatbuffers.Builder) *UniverseBuilder {
+	rcv := &UniverseBuilder{}
+	rcv.table.Start(builder, "Universe", 2)
+	return rcv
+}
+func (rcv *UniverseBuilder) AddAge(age float64) *UniverseBuilder {
+	UniverseAddAge(rcv.table.Field(0), age)
+	return rcv
+}
+func (rcv *UniverseBuilder) AddGalaxies(galaxies flatbuffers.UOffsetT) *UniverseBuilder {
+	UniverseAddGalaxies(rcv.table.Field(1), galaxies)
+	return rcv
+}
+
+// Finish ends the Universe and returns its offset, and an error
+// wrapping flatbuffers.ErrRequiredField if a required field was not added.
+func (rcv *UniverseBuilder) Finish() (flatbuffers.UOffsetT, error) {
+	return UniverseEnd(rcv.table.End()), rcv.table.Err()
+}
@@ -8,3 +8,7 @@ def flatc(options, schema):
 
 def GenerateGo():
     flatc([], "basic.fbs")
+
+    # The binary schema lets the tests check that flatc-gen-go generates the
+    # same code as flatc.
+    flatc_golden(options=["--binary", "--schema"], schema="basic.fbs", prefix="go")
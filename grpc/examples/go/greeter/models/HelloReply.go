@@ -58,3 +58,26 @@ func HelloReplyAddMessage(builder *flatbuffers.Builder, message flatbuffers.UOff
 func HelloReplyEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
+
+// HelloReplyBuilder builds a HelloReply with chainable Add methods. It panics
+// if a field is added after Finish, which checks the required fields.
+type HelloReplyBuilder struct {
+	table flatbuffers.TableBuilder
+}
+
+// NewHelloReplyBuilder starts a HelloReply in builder.
+func NewHelloReplyBuilder(builder *flatbuffers.Builder) *HelloReplyBuilder {
+	rcv := &HelloReplyBuilder{}
+	rcv.table.Start(builder, "models.HelloReply", 1)
+	return rcv
+}
+func (rcv *HelloReplyBuilder) AddMessage(message flatbuffers.UOffsetT) *HelloReplyBuilder {
+	HelloReplyAddMessage(rcv.table.Field(0), message)
+	return rcv
+}
+
+// Finish ends the HelloReply and returns its offset, and an error
+// wrapping flatbuffers.ErrRequiredField if a required field was not added.
+func (rcv *HelloReplyBuilder) Finish() (flatbuffers.UOffsetT, error) {
+	return HelloReplyEnd(rcv.table.End()), rcv.table.Err()
+}
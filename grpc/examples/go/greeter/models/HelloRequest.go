@@ -58,3 +58,26 @@ func HelloRequestAddName(builder *flatbuffers.Builder, name flatbuffers.UOffsetT
 func HelloRequestEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
+
+// HelloRequestBuilder builds a HelloRequest with chainable Add methods. It panics
+// if a field is added after Finish, which checks the required fields.
+type HelloRequestBuilder struct {
+	table flatbuffers.TableBuilder
+}
+
+// NewHelloRequestBuilder starts a HelloRequest in builder.
+func NewHelloRequestBuilder(builder *flatbuffers.Builder) *HelloRequestBuilder {
+	rcv := &HelloRequestBuilder{}
+	rcv.table.Start(builder, "models.HelloRequest", 1)
+	return rcv
+}
+func (rcv *HelloRequestBuilder) AddName(name flatbuffers.UOffsetT) *HelloRequestBuilder {
+	HelloRequestAddName(rcv.table.Field(0), name)
+	return rcv
+}
+
+// Finish ends the HelloRequest and returns its offset, and an error
+// wrapping flatbuffers.ErrRequiredField if a required field was not added.
+func (rcv *HelloRequestBuilder) Finish() (flatbuffers.UOffsetT, error) {
+	return HelloRequestEnd(rcv.table.End()), rcv.table.Err()
+}
@@ -481,6 +481,905 @@ void GenerateService(const grpc_generator::Service *service,
   printer->Outdent();
   printer->Print("}\n");
 }
+
+// Generates the typed message types of a table: an interface satisfied by
+// the object API type and by a typed handle on a finished builder, and the
+// checks of the buffers that the typed stubs send and receive
+static void GenerateTypedMessage(
+    const grpc_go_generator::TypedMessage &message,
+    grpc_generator::Printer *printer,
+    std::map<grpc::string, grpc::string> vars) {
+  vars["Table"] = message.name;
+  vars["TableBuilder"] = unexportName(message.name) + "Builder";
+  printer->Print(vars,
+                 "// $Table$Message is a $Table$ sent by the typed stubs: "
+                 "a *$Table$T or a\n// $Table$Buffer.\n");
+  printer->Print(vars, "type $Table$Message interface {\n");
+  printer->Indent();
+  printer->Print(vars, "$TableBuilder$() *flatbuffers.Builder\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars,
+                 "// $Table$Buffer is a builder finished with a $Table$ "
+                 "root table.\n");
+  printer->Print(vars, "type $Table$Buffer struct {\n");
+  printer->Indent();
+  printer->Print("builder *flatbuffers.Builder\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars,
+                 "// New$Table$Buffer wraps a builder that has been finished "
+                 "with a $Table$ root\n// table. The typed stubs check the "
+                 "root before sending it, as they do the\n// $Table$ they "
+                 "receive in a response.\n");
+  printer->Print(vars,
+                 "func New$Table$Buffer(builder *flatbuffers.Builder) "
+                 "$Table$Buffer {\n");
+  printer->Indent();
+  printer->Print(vars, "return $Table$Buffer{builder}\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars,
+                 "func (b $Table$Buffer) $TableBuilder$() "
+                 "*flatbuffers.Builder {\n");
+  printer->Indent();
+  printer->Print("return b.builder\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  if (vars["ObjectAPI"] == "true") {
+    printer->Print(vars,
+                   "func (t *$Table$T) $TableBuilder$() "
+                   "*flatbuffers.Builder {\n");
+    printer->Indent();
+    printer->Print("if t == nil {\n");
+    printer->Indent();
+    printer->Print("return nil\n");
+    printer->Outdent();
+    printer->Print("}\n");
+    printer->Print("b := flatbuffers.NewBuilder(0)\n");
+    printer->Print(vars, "Finish$Table$Buffer(b, t.Pack(b))\n");
+    printer->Print("return b\n");
+    printer->Outdent();
+    printer->Print("}\n\n");
+  }
+
+  printer->Print(vars,
+                 "// check$Table$ checks that rcv, the root table of a buffer, "
+                 "has the file\n// identifier and the required fields of a "
+                 "$Table$.\n");
+  printer->Print(vars, "func check$Table$(rcv *$Table$) error {\n");
+  printer->Indent();
+  if (!message.identifier.empty() || !message.required_fields.empty()) {
+    printer->Print("tab := rcv.Table()\n");
+  }
+  if (!message.identifier.empty()) {
+    printer->Print(vars,
+                   "if len(tab.Bytes) < "
+                   "flatbuffers.SizeUOffsetT+len($Table$Identifier) ||\n"
+                   "\t!$Table$BufferHasIdentifier(tab.Bytes) {\n");
+    printer->Indent();
+    printer->Print(vars,
+                   "return status.Errorf(codes.Internal, \"%v: want %q\", "
+                   "flatbuffers.ErrIdentifierMismatch, $Table$Identifier)\n");
+    printer->Outdent();
+    printer->Print("}\n");
+  }
+  for (const auto &field : message.required_fields) {
+    vars["Offset"] = std::to_string(field.first);
+    vars["Field"] = field.second;
+    printer->Print(vars, "if tab.Offset($Offset$) == 0 {\n");
+    printer->Indent();
+    printer->Print(vars,
+                   "return status.Errorf(codes.Internal, \"%v: $Field$\", "
+                   "flatbuffers.ErrRequiredField)\n");
+    printer->Outdent();
+    printer->Print("}\n");
+  }
+  printer->Print("return nil\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars,
+                 "// checked$Table$Builder returns the builder of m, once "
+                 "checked to be finished\n// with a $Table$ root table.\n");
+  printer->Print(vars,
+                 "func checked$Table$Builder(m $Table$Message) "
+                 "(*flatbuffers.Builder, error) {\n");
+  printer->Indent();
+  printer->Print("var b *flatbuffers.Builder\n");
+  // m is not nil when it holds a nil *T, so the builder is checked too.
+  printer->Print("if m != nil {\n");
+  printer->Indent();
+  printer->Print(vars, "b = m.$TableBuilder$()\n");
+  printer->Outdent();
+  printer->Print("}\n");
+  printer->Print("if b == nil {\n");
+  printer->Indent();
+  printer->Print(vars,
+                 "return nil, status.Error(codes.Internal, \"no $Table$ to "
+                 "send\")\n");
+  printer->Outdent();
+  printer->Print("}\n");
+  printer->Print("buf, err := flatbuffers.FlatbuffersCodec{}.Marshal(b)\n");
+  printer->Print("if err != nil {\n");
+  printer->Indent();
+  printer->Print("return nil, status.Error(codes.Internal, err.Error())\n");
+  printer->Outdent();
+  printer->Print("}\n");
+  vars["Error_Check"] = "err := check" + vars["Table"] + "(GetRootAs" +
+                        vars["Table"] + "(buf, 0)); err != nil";
+  GenerateError(printer, vars);
+  printer->Print("return b, nil\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+}
+
+// Generates typed Client method signature source
+static void GenerateTypedClientMethodSignature(
+    const grpc_generator::Method *method, grpc_generator::Printer *printer,
+    std::map<grpc::string, grpc::string> vars) {
+  vars["Method"] = exportName(method->name());
+  vars["Request"] = ", in " + method->get_input_type_name() + "Message";
+  if (ClientOnlyStreaming(method) || method->BidiStreaming()) {
+    vars["Request"] = "";
+  }
+  vars["Response"] = "*" + method->get_output_type_name();
+  if (!method->NoStreaming()) {
+    vars["Response"] = vars["Service"] + "_" + vars["Method"] + "TypedClient";
+  }
+  printer->Print(vars,
+                 "$Method$(ctx $context$.Context$Request$,\n\topts "
+                 "...$grpc$.CallOption) ($Response$, error)$Ending$");
+}
+
+// Generates the Recv or CloseAndRecv method of a typed client stream, which
+// checks the response
+static void GenerateTypedClientRecv(grpc_generator::Printer *printer,
+                                    std::map<grpc::string, grpc::string> vars) {
+  printer->Print(vars,
+                 "func (x *$StreamType$) $Recv$() (*$Response$, error) {\n");
+  printer->Indent();
+  printer->Print(vars, "m, err := x.$Service$_$Method$Client.$Recv$()\n");
+  vars["Error_Check"] = "err != nil";
+  GenerateError(printer, vars);
+  vars["Error_Check"] = "err := check" + vars["Response"] + "(m); err != nil";
+  GenerateError(printer, vars);
+  printer->Print("return m, nil\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+}
+
+// Generates typed Client method source, forwarding to the untyped client and
+// checking what it sends and receives
+static void GenerateTypedClientMethod(const grpc_generator::Method *method,
+                                      grpc_generator::Printer *printer,
+                                      std::map<grpc::string, grpc::string> vars) {
+  printer->Print(vars, "func (c *$ServiceUnexported$TypedClient) ");
+  vars["Ending"] = " {\n";
+  GenerateTypedClientMethodSignature(method, printer, vars);
+  printer->Indent();
+  vars["Method"] = exportName(method->name());
+  vars["Request"] = method->get_input_type_name();
+  vars["Response"] = method->get_output_type_name();
+  vars["StreamType"] =
+      vars["ServiceUnexported"] + vars["Method"] + "TypedClient";
+  vars["Error_Check"] = "err != nil";
+  if (method->NoStreaming() || ServerOnlyStreaming(method)) {
+    printer->Print(vars, "b, err := checked$Request$Builder(in)\n");
+    GenerateError(printer, vars);
+  }
+  if (method->NoStreaming()) {
+    printer->Print(vars, "out, err := c.client.$Method$(ctx, b, opts...)\n");
+    GenerateError(printer, vars);
+    vars["Error_Check"] =
+        "err := check" + vars["Response"] + "(out); err != nil";
+    GenerateError(printer, vars);
+    printer->Print("return out, nil\n");
+    printer->Outdent();
+    printer->Print("}\n\n");
+    return;
+  }
+  if (ServerOnlyStreaming(method)) {
+    printer->Print(vars,
+                   "stream, err := c.client.$Method$(ctx, b, opts...)\n");
+  } else {
+    printer->Print(vars, "stream, err := c.client.$Method$(ctx, opts...)\n");
+  }
+  GenerateError(printer, vars);
+  printer->Print(vars, "return &$StreamType${stream}, nil\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  vars["Recv"] = ClientOnlyStreaming(method) ? "CloseAndRecv" : "Recv";
+  printer->Print(vars, "type $Service$_$Method$TypedClient interface {\n");
+  printer->Indent();
+  if (!ServerOnlyStreaming(method)) {
+    printer->Print(vars, "Send($Request$Message) error\n");
+  }
+  printer->Print(vars, "$Recv$() (*$Response$, error)\n");
+  printer->Print(vars, "$grpc$.ClientStream\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars, "type $StreamType$ struct {\n");
+  printer->Indent();
+  printer->Print(vars, "$Service$_$Method$Client\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  if (!ServerOnlyStreaming(method)) {
+    printer->Print(vars,
+                   "func (x *$StreamType$) Send(m $Request$Message) error {\n");
+    printer->Indent();
+    printer->Print(vars, "b, err := checked$Request$Builder(m)\n");
+    GenerateError(printer, vars, false);
+    printer->Print(vars, "return x.$Service$_$Method$Client.Send(b)\n");
+    printer->Outdent();
+    printer->Print("}\n\n");
+  }
+  GenerateTypedClientRecv(printer, vars);
+}
+
+// Generates typed Server method signature source
+static void GenerateTypedServerMethodSignature(
+    const grpc_generator::Method *method, grpc_generator::Printer *printer,
+    std::map<grpc::string, grpc::string> vars) {
+  vars["Method"] = exportName(method->name());
+  vars["Request"] = method->get_input_type_name();
+  vars["Response"] = method->get_output_type_name();
+  if (method->NoStreaming()) {
+    printer->Print(vars,
+                   "$Method$($context$.Context, *$Request$) "
+                   "($Response$Message, error)$Ending$");
+  } else if (ServerOnlyStreaming(method)) {
+    printer->Print(
+        vars,
+        "$Method$(*$Request$, $Service$_$Method$TypedServer) error$Ending$");
+  } else {
+    printer->Print(vars,
+                   "$Method$($Service$_$Method$TypedServer) error$Ending$");
+  }
+}
+
+// Generates typed Server method source, adapting the typed server to the
+// untyped server interface and checking the responses it sends
+static void GenerateTypedServerMethod(const grpc_generator::Method *method,
+                                      grpc_generator::Printer *printer,
+                                      std::map<grpc::string, grpc::string> vars) {
+  vars["Method"] = exportName(method->name());
+  vars["Request"] = method->get_input_type_name();
+  vars["Response"] = method->get_output_type_name();
+  vars["StreamType"] =
+      vars["ServiceUnexported"] + vars["Method"] + "TypedServer";
+  printer->Print(vars, "func (s *$ServiceUnexported$TypedServer) ");
+  if (method->NoStreaming()) {
+    printer->Print(vars,
+                   "$Method$(ctx $context$.Context, in *$Request$) "
+                   "(*$CustomMethodIO$, error) {\n");
+    printer->Indent();
+    printer->Print(vars, "out, err := s.srv.$Method$(ctx, in)\n");
+    vars["Error_Check"] = "err != nil";
+    GenerateError(printer, vars);
+    printer->Print(vars, "return checked$Response$Builder(out)\n");
+    printer->Outdent();
+    printer->Print("}\n\n");
+    return;
+  }
+  if (ServerOnlyStreaming(method)) {
+    printer->Print(vars,
+                   "$Method$(in *$Request$, stream $Service$_$Method$Server) "
+                   "error {\n");
+    printer->Indent();
+    printer->Print(vars,
+                   "return s.srv.$Method$(in, &$StreamType${stream})\n");
+  } else {
+    printer->Print(vars,
+                   "$Method$(stream $Service$_$Method$Server) error {\n");
+    printer->Indent();
+    printer->Print(vars, "return s.srv.$Method$(&$StreamType${stream})\n");
+  }
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  bool genSend = method->BidiStreaming() || ServerOnlyStreaming(method);
+  bool genRecv = method->BidiStreaming() || ClientOnlyStreaming(method);
+  bool genSendAndClose = ClientOnlyStreaming(method);
+
+  printer->Print(vars, "type $Service$_$Method$TypedServer interface {\n");
+  printer->Indent();
+  if (genSend) { printer->Print(vars, "Send($Response$Message) error\n"); }
+  if (genRecv) { printer->Print(vars, "Recv() (*$Request$, error)\n"); }
+  if (genSendAndClose) {
+    printer->Print(vars, "SendAndClose($Response$Message) error\n");
+  }
+  printer->Print(vars, "$grpc$.ServerStream\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars, "type $StreamType$ struct {\n");
+  printer->Indent();
+  printer->Print(vars, "$Service$_$Method$Server\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  vars["Send"] = genSendAndClose ? "SendAndClose" : "Send";
+  printer->Print(vars,
+                 "func (x *$StreamType$) $Send$(m $Response$Message) "
+                 "error {\n");
+  printer->Indent();
+  printer->Print(vars, "b, err := checked$Response$Builder(m)\n");
+  vars["Error_Check"] = "err != nil";
+  GenerateError(printer, vars, false);
+  printer->Print(vars, "return x.$Service$_$Method$Server.$Send$(b)\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+}
+
+// Generates the typed client and server API for the service
+static void GenerateTypedService(const grpc_generator::Service *service,
+                                 grpc_generator::Printer *printer,
+                                 std::map<grpc::string, grpc::string> vars) {
+  vars["Service"] = exportName(service->name());
+  vars["ServiceUnexported"] = unexportName(vars["Service"]);
+
+  // Typed Client Interface
+  printer->Print(vars, "// Typed client API for $Service$ service\n");
+  printer->Print(vars, "type $Service$TypedClient interface {\n");
+  printer->Indent();
+  vars["Ending"] = "\n";
+  for (int i = 0; i < service->method_count(); i++) {
+    GenerateTypedClientMethodSignature(service->method(i).get(), printer,
+                                       vars);
+  }
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars, "type $ServiceUnexported$TypedClient struct {\n");
+  printer->Indent();
+  printer->Print(vars, "client $Service$Client\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars,
+                 "func New$Service$TypedClient(cc $grpc$.ClientConnInterface) "
+                 "$Service$TypedClient {\n");
+  printer->Indent();
+  printer->Print(vars,
+                 "return &$ServiceUnexported$TypedClient{New$Service$Client(cc)}");
+  printer->Outdent();
+  printer->Print("\n}\n\n");
+
+  for (int i = 0; i < service->method_count(); i++) {
+    GenerateTypedClientMethod(service->method(i).get(), printer, vars);
+  }
+
+  // Typed Server Interface
+  printer->Print(vars, "// Typed server API for $Service$ service\n");
+  printer->Print(vars, "type $Service$TypedServer interface {\n");
+  printer->Indent();
+  vars["Ending"] = "\n";
+  for (int i = 0; i < service->method_count(); i++) {
+    GenerateTypedServerMethodSignature(service->method(i).get(), printer,
+                                       vars);
+  }
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars, "type $ServiceUnexported$TypedServer struct {\n");
+  printer->Indent();
+  printer->Print(vars, "Unimplemented$Service$Server\n");
+  printer->Print(vars, "srv $Service$TypedServer\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars,
+                 "func Register$Service$TypedServer(s $grpc$.ServiceRegistrar, "
+                 "srv $Service$TypedServer) {\n");
+  printer->Indent();
+  printer->Print(
+      vars,
+      "Register$Service$Server(s, &$ServiceUnexported$TypedServer{srv: srv})\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  for (int i = 0; i < service->method_count(); i++) {
+    GenerateTypedServerMethod(service->method(i).get(), printer, vars);
+  }
+}
+
+// Generates the in-memory stream shared by the fake client and server
+static void GenerateFakeStream(grpc_generator::Printer *printer,
+                               std::map<grpc::string, grpc::string> vars) {
+  printer->Print(vars,
+                 "// fake$Service$Stream carries a streaming call between "
+                 "the fake client\n// and a server, in memory.\n");
+  printer->Print(vars, "type fake$Service$Stream struct {\n");
+  printer->Indent();
+  printer->Print(vars, "ctx       $context$.Context\n");
+  printer->Print("requests  chan []byte\n");
+  printer->Print("responses chan []byte\n");
+  printer->Print("closeSend sync.Once\n");
+  printer->Print("// done is closed when the server method has returned err.\n");
+  printer->Print("done chan struct{}\n");
+  printer->Print("err  error\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars,
+                 "func newFake$Service$Stream(ctx $context$.Context) "
+                 "*fake$Service$Stream {\n");
+  printer->Indent();
+  printer->Print(vars, "return &fake$Service$Stream{\n");
+  printer->Indent();
+  printer->Print("ctx:       ctx,\n");
+  printer->Print("requests:  make(chan []byte),\n");
+  printer->Print("responses: make(chan []byte),\n");
+  printer->Print("done:      make(chan struct{}),\n");
+  printer->Outdent();
+  printer->Print("}\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars, "func (s *fake$Service$Stream) finish(err error) {\n");
+  printer->Indent();
+  printer->Print("s.err = err\n");
+  printer->Print("close(s.done)\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars,
+                 "// fake$Service$Copy passes in to out as a copy, like a "
+                 "transport would.\n");
+  printer->Print(vars,
+                 "func fake$Service$Copy(in, out interface{}) error {\n");
+  printer->Indent();
+  printer->Print("buf, err := flatbuffers.FlatbuffersCodec{}.Marshal(in)\n");
+  vars["Error_Check"] = "err != nil";
+  GenerateError(printer, vars, false);
+  printer->Print(
+      "return flatbuffers.FlatbuffersCodec{}.Unmarshal(append([]byte(nil), "
+      "buf...), out)\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars,
+                 "func fake$Service$Marshal(m interface{}) ([]byte, error) "
+                 "{\n");
+  printer->Indent();
+  printer->Print("buf, err := flatbuffers.FlatbuffersCodec{}.Marshal(m)\n");
+  GenerateError(printer, vars);
+  printer->Print("return append([]byte(nil), buf...), nil\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  // Client side.
+  printer->Print(vars,
+                 "type fake$Service$ClientStream struct {\n");
+  printer->Indent();
+  printer->Print(vars, "*fake$Service$Stream\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  vars["Client"] = "func (s fake" + vars["Service"] + "ClientStream) ";
+  printer->Print(vars,
+                 "$Client$Header() (metadata.MD, error) { return nil, nil }\n");
+  printer->Print(vars, "$Client$Trailer() metadata.MD         { return nil }\n");
+  printer->Print(vars,
+                 "$Client$Context() $context$.Context     { return s.ctx }\n\n");
+
+  printer->Print(vars, "$Client$CloseSend() error {\n");
+  printer->Indent();
+  printer->Print("s.closeSend.Do(func() { close(s.requests) })\n");
+  printer->Print("return nil\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars, "$Client$SendMsg(m interface{}) error {\n");
+  printer->Indent();
+  printer->Print(vars, "buf, err := fake$Service$Marshal(m)\n");
+  GenerateError(printer, vars, false);
+  printer->Print("select {\n");
+  printer->Print("case s.requests <- buf:\n");
+  printer->Indent();
+  printer->Print("return nil\n");
+  printer->Outdent();
+  printer->Print("case <-s.done:\n");
+  printer->Indent();
+  printer->Print("return io.EOF\n");
+  printer->Outdent();
+  printer->Print("case <-s.ctx.Done():\n");
+  printer->Indent();
+  printer->Print("return status.FromContextError(s.ctx.Err()).Err()\n");
+  printer->Outdent();
+  printer->Print("}\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars, "$Client$RecvMsg(m interface{}) error {\n");
+  printer->Indent();
+  printer->Print("select {\n");
+  printer->Print("case buf := <-s.responses:\n");
+  printer->Indent();
+  printer->Print("return flatbuffers.FlatbuffersCodec{}.Unmarshal(buf, m)\n");
+  printer->Outdent();
+  printer->Print("case <-s.done:\n");
+  printer->Indent();
+  printer->Print("if s.err != nil {\n");
+  printer->Indent();
+  printer->Print("return s.err\n");
+  printer->Outdent();
+  printer->Print("}\n");
+  printer->Print("return io.EOF\n");
+  printer->Outdent();
+  printer->Print("case <-s.ctx.Done():\n");
+  printer->Indent();
+  printer->Print("return status.FromContextError(s.ctx.Err()).Err()\n");
+  printer->Outdent();
+  printer->Print("}\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  // Server side.
+  printer->Print(vars, "type fake$Service$ServerStream struct {\n");
+  printer->Indent();
+  printer->Print(vars, "*fake$Service$Stream\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  vars["Server"] = "func (s fake" + vars["Service"] + "ServerStream) ";
+  printer->Print(vars, "$Server$SetHeader(metadata.MD) error  { return nil }\n");
+  printer->Print(vars, "$Server$SendHeader(metadata.MD) error { return nil }\n");
+  printer->Print(vars, "$Server$SetTrailer(metadata.MD)       {}\n");
+  printer->Print(vars,
+                 "$Server$Context() $context$.Context     { return s.ctx }\n\n");
+
+  printer->Print(vars, "$Server$SendMsg(m interface{}) error {\n");
+  printer->Indent();
+  printer->Print(vars, "buf, err := fake$Service$Marshal(m)\n");
+  GenerateError(printer, vars, false);
+  printer->Print("select {\n");
+  printer->Print("case s.responses <- buf:\n");
+  printer->Indent();
+  printer->Print("return nil\n");
+  printer->Outdent();
+  printer->Print("case <-s.ctx.Done():\n");
+  printer->Indent();
+  printer->Print("return status.FromContextError(s.ctx.Err()).Err()\n");
+  printer->Outdent();
+  printer->Print("}\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars, "$Server$RecvMsg(m interface{}) error {\n");
+  printer->Indent();
+  printer->Print("select {\n");
+  printer->Print("case buf, ok := <-s.requests:\n");
+  printer->Indent();
+  printer->Print("if !ok {\n");
+  printer->Indent();
+  printer->Print("return io.EOF\n");
+  printer->Outdent();
+  printer->Print("}\n");
+  printer->Print("return flatbuffers.FlatbuffersCodec{}.Unmarshal(buf, m)\n");
+  printer->Outdent();
+  printer->Print("case <-s.ctx.Done():\n");
+  printer->Indent();
+  printer->Print("return status.FromContextError(s.ctx.Err()).Err()\n");
+  printer->Outdent();
+  printer->Print("}\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+}
+
+// Generates the fake client, which calls a server in memory
+static void GenerateFakeClient(const grpc_generator::Service *service,
+                               grpc_generator::Printer *printer,
+                               std::map<grpc::string, grpc::string> vars) {
+  printer->Print(vars, "type fake$Service$Client struct {\n");
+  printer->Indent();
+  printer->Print(vars, "srv $Service$Server\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars,
+                 "// NewFake$Service$Client returns a client that calls srv "
+                 "in memory, without\n// gRPC. srv is typically a "
+                 "*Fake$Service$Server.\n");
+  printer->Print(vars,
+                 "func NewFake$Service$Client(srv $Service$Server) "
+                 "$Service$Client {\n");
+  printer->Indent();
+  printer->Print(vars, "return &fake$Service$Client{srv}\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  vars["Ending"] = " {\n";
+  for (int i = 0; i < service->method_count(); i++) {
+    auto method = service->method(i);
+    vars["Method"] = exportName(method->name());
+    vars["Request"] = method->get_input_type_name();
+    vars["Response"] = method->get_output_type_name();
+    printer->Print(vars, "func (c *fake$Service$Client) ");
+    GenerateClientMethodSignature(method.get(), printer, vars);
+    printer->Indent();
+    if (method->NoStreaming() || ServerOnlyStreaming(method.get())) {
+      printer->Print(vars, "m := new($Request$)\n");
+      vars["Error_Check"] = "err := fake" + vars["Service"] + "Copy(in, m); err != nil";
+      GenerateError(printer, vars);
+    }
+    if (method->NoStreaming()) {
+      printer->Print(vars, "out, err := c.srv.$Method$(ctx, m)\n");
+      vars["Error_Check"] = "err != nil";
+      GenerateError(printer, vars);
+      printer->Print(vars, "r := new($Response$)\n");
+      vars["Error_Check"] = "err := fake" + vars["Service"] + "Copy(out, r); err != nil";
+      GenerateError(printer, vars);
+      printer->Print("return r, nil\n");
+      printer->Outdent();
+      printer->Print("}\n\n");
+      continue;
+    }
+    vars["ServerStream"] =
+        vars["ServiceUnexported"] + vars["Method"] + "Server";
+    vars["ClientStream"] =
+        vars["ServiceUnexported"] + vars["Method"] + "Client";
+    vars["Args"] = ServerOnlyStreaming(method.get()) ? "m, " : "";
+    printer->Print(vars, "stream := newFake$Service$Stream(ctx)\n");
+    printer->Print("go func() {\n");
+    printer->Indent();
+    printer->Print(vars,
+                   "stream.finish(c.srv.$Method$($Args$&$ServerStream${"
+                   "fake$Service$ServerStream{stream}}))\n");
+    printer->Outdent();
+    printer->Print("}()\n");
+    printer->Print(vars,
+                   "return &$ClientStream${fake$Service$ClientStream{stream}}"
+                   ", nil\n");
+    printer->Outdent();
+    printer->Print("}\n\n");
+  }
+}
+
+// Generates the fake server, which records its calls and answers with
+// scripted responses
+static void GenerateFakeServer(const grpc_generator::Service *service,
+                               grpc_generator::Printer *printer,
+                               std::map<grpc::string, grpc::string> vars) {
+  printer->Print(vars,
+                 "// Fake$Service$Server is a $Service$Server for tests.\n"
+                 "// It records the requests of every call, and answers with "
+                 "the responses\n// scripted by its Returns methods: each "
+                 "call takes the next one, and the\n// last one is kept for "
+                 "the calls after it.\n");
+  printer->Print(vars, "type Fake$Service$Server struct {\n");
+  printer->Indent();
+  printer->Print(vars, "Unimplemented$Service$Server\n");
+  printer->Outdent();
+  printer->Print("\n");
+  printer->Indent();
+  printer->Print("mu sync.Mutex\n");
+  for (int i = 0; i < service->method_count(); i++) {
+    auto method = service->method(i);
+    vars["Method"] = unexportName(exportName(method->name()));
+    vars["Request"] = method->get_input_type_name();
+    vars["Calls"] = method->ClientStreaming() || method->BidiStreaming()
+                        ? "[][]*" + vars["Request"]
+                        : "[]*" + vars["Request"];
+    printer->Print(vars, "$Method$Calls     $Calls$\n");
+    printer->Print(vars, "$Method$Responses []fake$Service$Response\n");
+  }
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars, "type fake$Service$Response struct {\n");
+  printer->Indent();
+  printer->Print("out []*flatbuffers.Builder\n");
+  printer->Print("err error\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  printer->Print(vars,
+                 "// next returns the response of a call to method, or an "
+                 "error if none was\n// scripted.\n");
+  printer->Print(vars,
+                 "func (f *Fake$Service$Server) next(responses "
+                 "*[]fake$Service$Response, method string) "
+                 "(fake$Service$Response, error) {\n");
+  printer->Indent();
+  printer->Print("if len(*responses) == 0 {\n");
+  printer->Indent();
+  printer->Print(vars,
+                 "return fake$Service$Response{}, status.Errorf(codes."
+                 "Unimplemented, \"no response scripted for %s\", method)\n");
+  printer->Outdent();
+  printer->Print("}\n");
+  printer->Print("r := (*responses)[0]\n");
+  printer->Print("if len(*responses) > 1 {\n");
+  printer->Indent();
+  printer->Print("*responses = (*responses)[1:]\n");
+  printer->Outdent();
+  printer->Print("}\n");
+  printer->Print("return r, nil\n");
+  printer->Outdent();
+  printer->Print("}\n\n");
+
+  for (int i = 0; i < service->method_count(); i++) {
+    auto method = service->method(i);
+    const bool client_streams =
+        method->ClientStreaming() || method->BidiStreaming();
+    const bool server_streams =
+        ServerOnlyStreaming(method.get()) || method->BidiStreaming();
+    vars["Method"] = exportName(method->name());
+    vars["Field"] = unexportName(vars["Method"]);
+    vars["Request"] = method->get_input_type_name();
+    vars["Response"] = method->get_output_type_name();
+
+    // Scripting and recording.
+    if (server_streams) {
+      printer->Print(vars,
+                     "// $Method$Returns scripts the responses that a call "
+                     "streams, and the\n// error it ends with.\n");
+      printer->Print(vars,
+                     "func (f *Fake$Service$Server) $Method$Returns(out "
+                     "[]*flatbuffers.Builder, err error) {\n");
+      printer->Indent();
+      printer->Print("f.mu.Lock()\n");
+      printer->Print("defer f.mu.Unlock()\n");
+      printer->Print(vars,
+                     "f.$Field$Responses = append(f.$Field$Responses, "
+                     "fake$Service$Response{out, err})\n");
+    } else {
+      printer->Print(vars,
+                     "// $Method$Returns scripts the response or the error "
+                     "of a call.\n");
+      printer->Print(vars,
+                     "func (f *Fake$Service$Server) $Method$Returns(out "
+                     "*flatbuffers.Builder, err error) {\n");
+      printer->Indent();
+      printer->Print("f.mu.Lock()\n");
+      printer->Print("defer f.mu.Unlock()\n");
+      printer->Print(vars,
+                     "f.$Field$Responses = append(f.$Field$Responses, "
+                     "fake$Service$Response{[]*flatbuffers.Builder{out}, "
+                     "err})\n");
+    }
+    printer->Outdent();
+    printer->Print("}\n\n");
+
+    if (client_streams) {
+      printer->Print(vars,
+                     "// $Method$Calls returns the requests streamed by each "
+                     "call.\n");
+      printer->Print(vars,
+                     "func (f *Fake$Service$Server) $Method$Calls() "
+                     "[][]*$Request$ {\n");
+      printer->Indent();
+      printer->Print("f.mu.Lock()\n");
+      printer->Print("defer f.mu.Unlock()\n");
+      printer->Print(vars,
+                     "calls := make([][]*$Request$, len(f.$Field$Calls))\n");
+      printer->Print(vars, "for i, c := range f.$Field$Calls {\n");
+      printer->Indent();
+      printer->Print(vars, "calls[i] = append([]*$Request$(nil), c...)\n");
+      printer->Outdent();
+      printer->Print("}\n");
+      printer->Print("return calls\n");
+    } else {
+      printer->Print(vars,
+                     "// $Method$Calls returns the request of each call.\n");
+      printer->Print(vars,
+                     "func (f *Fake$Service$Server) $Method$Calls() "
+                     "[]*$Request$ {\n");
+      printer->Indent();
+      printer->Print("f.mu.Lock()\n");
+      printer->Print("defer f.mu.Unlock()\n");
+      printer->Print(vars,
+                     "return append([]*$Request$(nil), f.$Field$Calls...)\n");
+    }
+    printer->Outdent();
+    printer->Print("}\n\n");
+
+    // The server method.
+    printer->Print(vars, "func (f *Fake$Service$Server) ");
+    if (method->NoStreaming()) {
+      printer->Print(vars,
+                     "$Method$(ctx $context$.Context, in *$Request$) "
+                     "(*flatbuffers.Builder, error) {\n");
+    } else if (ServerOnlyStreaming(method.get())) {
+      printer->Print(vars,
+                     "$Method$(in *$Request$, stream "
+                     "$Service$_$Method$Server) error {\n");
+    } else {
+      printer->Print(vars,
+                     "$Method$(stream $Service$_$Method$Server) error {\n");
+    }
+    printer->Indent();
+    printer->Print("f.mu.Lock()\n");
+    if (client_streams) {
+      printer->Print(vars, "call := len(f.$Field$Calls)\n");
+      printer->Print(vars,
+                     "f.$Field$Calls = append(f.$Field$Calls, nil)\n");
+    } else {
+      printer->Print(vars, "f.$Field$Calls = append(f.$Field$Calls, in)\n");
+    }
+    printer->Print(vars,
+                   "r, err := f.next(&f.$Field$Responses, \"$Method$\")\n");
+    printer->Print("f.mu.Unlock()\n");
+    vars["Error_Check"] = "err != nil";
+    GenerateError(printer, vars, method->NoStreaming());
+    if (method->NoStreaming()) {
+      printer->Print("return r.out[0], r.err\n");
+      printer->Outdent();
+      printer->Print("}\n\n");
+      continue;
+    }
+    if (ServerOnlyStreaming(method.get())) {
+      printer->Print("for _, m := range r.out {\n");
+      printer->Indent();
+      vars["Error_Check"] = "err := stream.Send(m); err != nil";
+      GenerateError(printer, vars, false);
+      printer->Outdent();
+      printer->Print("}\n");
+      printer->Print("return r.err\n");
+      printer->Outdent();
+      printer->Print("}\n\n");
+      continue;
+    }
+    if (method->BidiStreaming()) {
+      printer->Print(
+          "// Each request is answered with the next response, and the rest "
+          "are sent\n// once the client is done.\n");
+      printer->Print("sent := 0\n");
+    }
+    printer->Print("for {\n");
+    printer->Indent();
+    printer->Print("m, err := stream.Recv()\n");
+    printer->Print("if err == io.EOF {\n");
+    printer->Indent();
+    printer->Print("break\n");
+    printer->Outdent();
+    printer->Print("}\n");
+    vars["Error_Check"] = "err != nil";
+    GenerateError(printer, vars, false);
+    printer->Print("f.mu.Lock()\n");
+    printer->Print(vars,
+                   "f.$Field$Calls[call] = append(f.$Field$Calls[call], m)\n");
+    printer->Print("f.mu.Unlock()\n");
+    if (method->BidiStreaming()) {
+      printer->Print("if sent < len(r.out) {\n");
+      printer->Indent();
+      vars["Error_Check"] = "err := stream.Send(r.out[sent]); err != nil";
+      GenerateError(printer, vars, false);
+      printer->Print("sent++\n");
+      printer->Outdent();
+      printer->Print("}\n");
+    }
+    printer->Outdent();
+    printer->Print("}\n");
+    if (method->BidiStreaming()) {
+      printer->Print("for ; sent < len(r.out); sent++ {\n");
+      printer->Indent();
+      vars["Error_Check"] = "err := stream.Send(r.out[sent]); err != nil";
+      GenerateError(printer, vars, false);
+      printer->Outdent();
+      printer->Print("}\n");
+      printer->Print("return r.err\n");
+    } else {
+      printer->Print("if r.err != nil {\n");
+      printer->Indent();
+      printer->Print("return r.err\n");
+      printer->Outdent();
+      printer->Print("}\n");
+      printer->Print("return stream.SendAndClose(r.out[0])\n");
+    }
+    printer->Outdent();
+    printer->Print("}\n\n");
+  }
+}
 }  // namespace
 
 // Returns source for the service
@@ -502,6 +1401,57 @@ grpc::string GenerateServiceSource(grpc_generator::File *file,
     vars["CustomMethodIO"] = parameters->custom_method_io_type;
   }
   GenerateService(service, printer, vars);
+  if (parameters->typed_stubs) {
+    vars["ObjectAPI"] = parameters->object_api ? "true" : "false";
+    printer->Print("\n");
+    for (const auto &table : parameters->typed_messages) {
+      GenerateTypedMessage(table, printer, vars);
+    }
+    GenerateTypedService(service, printer, vars);
+    out.pop_back();
+  }
+  return out;
+}
+
+// Returns source for the fakes of the service
+grpc::string GenerateFakeSource(grpc_generator::File *file,
+                                const grpc_generator::Service *service,
+                                grpc_go_generator::Parameters *parameters) {
+  grpc::string out;
+  auto p = file->CreatePrinter(&out, '\t');
+  p->SetIndentationSize(1);
+  auto printer = p.get();
+  std::map<grpc::string, grpc::string> vars;
+  vars["Package"] = parameters->package_name;
+  vars["grpc"] = "grpc";
+  vars["context"] = "context";
+  vars["filename"] = file->filename();
+  vars["Service"] = exportName(service->name());
+  vars["ServiceUnexported"] = unexportName(vars["Service"]);
+  vars["CustomMethodIO"] = parameters->custom_method_io_type;
+  printer->Print("//Generated by gRPC Go plugin\n");
+  printer->Print("//If you make any local changes, they will be lost\n");
+  printer->Print(vars, "//source: $filename$\n\n");
+  printer->Print(vars, "package $Package$\n\n");
+  printer->Print("import (\n");
+  printer->Indent();
+  printer->Print(vars, "$context$ \"context\"\n");
+  printer->Print("\"io\"\n");
+  printer->Print("\"sync\"\n");
+  printer->Outdent();
+  printer->Print("\n");
+  printer->Indent();
+  printer->Print("flatbuffers \"github.com/google/flatbuffers/go\"\n");
+  printer->Print(vars, "$grpc$ \"google.golang.org/grpc\"\n");
+  printer->Print("\"google.golang.org/grpc/codes\"\n");
+  printer->Print("\"google.golang.org/grpc/metadata\"\n");
+  printer->Print("\"google.golang.org/grpc/status\"\n");
+  printer->Outdent();
+  printer->Print(")\n\n");
+  GenerateFakeServer(service, printer, vars);
+  GenerateFakeClient(service, printer, vars);
+  GenerateFakeStream(printer, vars);
+  out.pop_back();
   return out;
 }
 }  // Namespace grpc_go_generator
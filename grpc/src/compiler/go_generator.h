@@ -4,12 +4,24 @@
 // go generator is used to generate GRPC code for serialization system, such as
 // flatbuffers
 #include <memory>
+#include <utility>
 #include <vector>
 
 #include "src/compiler/schema_interface.h"
 
 namespace grpc_go_generator {
 
+// A table sent by the typed stubs, with what they check of its buffers
+struct TypedMessage {
+  grpc::string name;
+
+  // The file identifier of its buffers, if the table is the root type
+  grpc::string identifier;
+
+  // The vtable offsets and qualified names of its required fields
+  std::vector<std::pair<int, grpc::string>> required_fields;
+};
+
 struct Parameters {
   // Defines the custom parameter types for methods
   // eg: flatbuffers uses flatbuffers.Builder as input for the client and output
@@ -21,6 +33,17 @@ struct Parameters {
 
   // Prefix for RPC Calls
   grpc::string service_prefix;
+
+  // Generates typed stubs that send object API values or typed finished
+  // buffers, alongside the custom_method_io_type based ones
+  bool typed_stubs = false;
+
+  // Whether the object API types (eg: MonsterT) were generated
+  bool object_api = false;
+
+  // Tables whose typed message types are declared in this file, as the same
+  // table may be used by several services of a package
+  std::vector<TypedMessage> typed_messages;
 };
 
 // Return the source of the generated service file.
@@ -28,6 +51,11 @@ grpc::string GenerateServiceSource(grpc_generator::File *file,
                                    const grpc_generator::Service *service,
                                    grpc_go_generator::Parameters *parameters);
 
+// Return the source of the in-memory fakes of the service, for tests.
+grpc::string GenerateFakeSource(grpc_generator::File *file,
+                                const grpc_generator::Service *service,
+                                grpc_go_generator::Parameters *parameters);
+
 }  // namespace grpc_go_generator
 
 #endif  // GRPC_INTERNAL_COMPILER_GO_GENERATOR_H
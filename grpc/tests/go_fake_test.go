@@ -0,0 +1,136 @@
+package testing
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+	"github.com/google/flatbuffers/tests/MyGame/Example"
+
+	"context"
+	"io"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func finished(t interface {
+	Pack(*flatbuffers.Builder) flatbuffers.UOffsetT
+}) *flatbuffers.Builder {
+	b := flatbuffers.NewBuilder(0)
+	b.Finish(t.Pack(b))
+	return b
+}
+
+func TestFakeUnary(t *testing.T) {
+	f := &Example.FakeMonsterStorageServer{}
+	c := Example.NewFakeMonsterStorageClient(f)
+	ctx := context.Background()
+
+	f.StoreReturns(finished(&Example.StatT{Id: "stored", Val: 1}), nil)
+	f.StoreReturns(nil, status.Error(codes.ResourceExhausted, "full"))
+	out, err := c.Store(ctx, finished(&Example.MonsterT{Name: "Orc"}))
+	if err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+	if string(out.Id()) != "stored" || out.Val() != 1 {
+		t.Errorf("Store returned %s with %d, want stored with 1", out.Id(), out.Val())
+	}
+	// The last response is kept for the calls after it.
+	for _, name := range []string{"Troll", "Goblin"} {
+		if _, err := c.Store(ctx, finished(&Example.MonsterT{Name: name})); status.Code(err) != codes.ResourceExhausted {
+			t.Errorf("Store of %s: got %v, want ResourceExhausted", name, err)
+		}
+	}
+
+	var names []string
+	for _, m := range f.StoreCalls() {
+		names = append(names, string(m.Name()))
+	}
+	if want := []string{"Orc", "Troll", "Goblin"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("recorded Store calls %v, want %v", names, want)
+	}
+
+	// A method with nothing scripted fails.
+	maxStream, err := c.GetMaxHitPoint(ctx)
+	if err != nil {
+		t.Fatalf("GetMaxHitPoint failed: %v", err)
+	}
+	if _, err := maxStream.CloseAndRecv(); status.Code(err) != codes.Unimplemented {
+		t.Errorf("unscripted GetMaxHitPoint: got %v, want Unimplemented", err)
+	}
+}
+
+func TestFakeStreaming(t *testing.T) {
+	f := &Example.FakeMonsterStorageServer{}
+	c := Example.NewFakeMonsterStorageClient(f)
+	ctx := context.Background()
+
+	// Retrieve streams the scripted monsters, then ends with the error.
+	f.RetrieveReturns([]*flatbuffers.Builder{
+		finished(&Example.MonsterT{Name: "Orc"}),
+		finished(&Example.MonsterT{Name: "Troll"}),
+	}, status.Error(codes.Aborted, "interrupted"))
+	retrieved, err := c.Retrieve(ctx, finished(&Example.StatT{Id: "cave", Count: 3}))
+	if err != nil {
+		t.Fatalf("Retrieve failed: %v", err)
+	}
+	for _, want := range []string{"Orc", "Troll"} {
+		m, err := retrieved.Recv()
+		if err != nil {
+			t.Fatalf("Recv failed: %v", err)
+		}
+		if string(m.Name()) != want {
+			t.Errorf("Retrieve streamed %s, want %s", m.Name(), want)
+		}
+	}
+	if _, err := retrieved.Recv(); status.Code(err) != codes.Aborted {
+		t.Errorf("got %v after the scripted monsters, want Aborted", err)
+	}
+	if calls := f.RetrieveCalls(); len(calls) != 1 || string(calls[0].Id()) != "cave" || calls[0].Count() != 3 {
+		t.Errorf("recorded %d Retrieve calls, want the one for cave", len(calls))
+	}
+
+	// GetMinMaxHitPoints answers each request with the next response, and
+	// sends the rest once the client is done.
+	f.GetMinMaxHitPointsReturns([]*flatbuffers.Builder{
+		finished(&Example.StatT{Id: "min", Val: 10}),
+		finished(&Example.StatT{Id: "max", Val: 90}),
+		finished(&Example.StatT{Id: "total", Val: 100}),
+	}, nil)
+	bidi, err := c.GetMinMaxHitPoints(ctx)
+	if err != nil {
+		t.Fatalf("GetMinMaxHitPoints failed: %v", err)
+	}
+	var got []string
+	for _, hp := range []int16{10, 90} {
+		if err := bidi.Send(finished(&Example.MonsterT{Name: "Orc", Hp: hp})); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+		stat, err := bidi.Recv()
+		if err != nil {
+			t.Fatalf("Recv failed: %v", err)
+		}
+		got = append(got, string(stat.Id()))
+	}
+	if err := bidi.CloseSend(); err != nil {
+		t.Fatalf("CloseSend failed: %v", err)
+	}
+	for {
+		stat, err := bidi.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv failed: %v", err)
+		}
+		got = append(got, string(stat.Id()))
+	}
+	if want := []string{"min", "max", "total"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetMinMaxHitPoints streamed %v, want %v", got, want)
+	}
+
+	calls := f.GetMinMaxHitPointsCalls()
+	if len(calls) != 1 || len(calls[0]) != 2 || calls[0][0].Hp() != 10 || calls[0][1].Hp() != 90 {
+		t.Errorf("recorded GetMinMaxHitPoints calls %v, want one with both monsters", calls)
+	}
+}
@@ -32,13 +32,13 @@ func (s *server) Store(context context.Context, in *Example.Monster) (*flatbuffe
 }
 
 // gRPC server retrieve method
-func (s *server) Retrieve(context context.Context, in *Example.Stat) (*flatbuffers.Builder, error) {
+func (s *server) Retrieve(in *Example.Stat, stream Example.MonsterStorage_RetrieveServer) error {
 	b := flatbuffers.NewBuilder(0)
 	i := b.CreateString(test)
 	Example.MonsterStart(b)
 	Example.MonsterAddName(b, i)
 	b.Finish(Example.MonsterEnd(b))
-	return b, nil
+	return stream.Send(b)
 }
 
 func StoreClient(c Example.MonsterStorageClient, t *testing.T) {
@@ -87,8 +87,7 @@ func TestGRPC(t *testing.T) {
 	Example.RegisterMonsterStorageServer(ser, &server{})
 	go func() {
 		if err := ser.Serve(lis); err != nil {
-			t.Fatalf("Failed to serve: %v", err)
-			t.FailNow()
+			t.Errorf("Failed to serve: %v", err)
 		}
 	}()
 	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithCodec(flatbuffers.FlatbuffersCodec{}))
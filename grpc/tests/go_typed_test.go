@@ -0,0 +1,283 @@
+package testing
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+	"github.com/google/flatbuffers/tests/MyGame/Example"
+
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// typedServer answers with the object API types and with buffers. It returns
+// a typed nil *StatT from Store for a monster named "nil", and a monster
+// buffer without its file identifier from Retrieve for a stat named "bare".
+type typedServer struct{}
+
+func (typedServer) Store(ctx context.Context, in *Example.Monster) (Example.StatMessage, error) {
+	if string(in.Name()) == "nil" {
+		var out *Example.StatT
+		return out, nil
+	}
+	return &Example.StatT{Id: string(in.Name()), Val: int64(in.Hp())}, nil
+}
+
+func (typedServer) Retrieve(in *Example.Stat, stream Example.MonsterStorage_RetrieveTypedServer) error {
+	if string(in.Id()) == "bare" {
+		return stream.Send(Example.NewMonsterBuffer(bareMonster("Orc")))
+	}
+	for i := 0; i < int(in.Count()); i++ {
+		if err := stream.Send(&Example.MonsterT{Name: string(in.Id()), Hp: int16(i)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (typedServer) GetMaxHitPoint(stream Example.MonsterStorage_GetMaxHitPointTypedServer) error {
+	max := &Example.StatT{}
+	for {
+		m, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(max)
+		}
+		if err != nil {
+			return err
+		}
+		if int64(m.Hp()) > max.Val {
+			max.Id, max.Val = string(m.Name()), int64(m.Hp())
+		}
+	}
+}
+
+func (typedServer) GetMinMaxHitPoints(stream Example.MonsterStorage_GetMinMaxHitPointsTypedServer) error {
+	for {
+		m, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		b := flatbuffers.NewBuilder(0)
+		b.Finish((&Example.StatT{Id: string(m.Name()), Val: int64(m.Hp())}).Pack(b))
+		if err := stream.Send(Example.NewStatBuffer(b)); err != nil {
+			return err
+		}
+	}
+}
+
+// bareMonster returns a builder finished with a monster named name, but
+// without the file identifier of Monster.
+func bareMonster(name string) *flatbuffers.Builder {
+	b := flatbuffers.NewBuilder(0)
+	n := b.CreateString(name)
+	Example.MonsterStart(b)
+	Example.MonsterAddName(b, n)
+	b.Finish(Example.MonsterEnd(b))
+	return b
+}
+
+// dial serves the services that register adds over an in-memory connection,
+// with codec on the server side, and returns a connection to them.
+func dial(t *testing.T, codec flatbuffers.FlatbuffersCodec, register func(*grpc.Server)) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	ser := grpc.NewServer(grpc.ForceServerCodec(codec))
+	register(ser)
+	go ser.Serve(lis)
+	t.Cleanup(ser.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(flatbuffers.FlatbuffersCodec{})))
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// dialTyped serves typedServer with codec and returns a typed client for it.
+func dialTyped(t *testing.T, codec flatbuffers.FlatbuffersCodec) Example.MonsterStorageTypedClient {
+	return Example.NewMonsterStorageTypedClient(dial(t, codec, func(s *grpc.Server) {
+		Example.RegisterMonsterStorageTypedServer(s, typedServer{})
+	}))
+}
+
+func TestTypedUnary(t *testing.T) {
+	c := dialTyped(t, flatbuffers.FlatbuffersCodec{})
+	ctx := context.Background()
+
+	out, err := c.Store(ctx, &Example.MonsterT{Name: test, Hp: 80})
+	if err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+	if string(out.Id()) != test || out.Val() != 80 {
+		t.Errorf("Store returned %s with %d, want %s with 80", out.Id(), out.Val(), test)
+	}
+
+	b := flatbuffers.NewBuilder(0)
+	name := b.CreateString("Orc")
+	Example.MonsterStart(b)
+	Example.MonsterAddName(b, name)
+	Example.MonsterAddHp(b, 300)
+	Example.FinishMonsterBuffer(b, Example.MonsterEnd(b))
+	out, err = c.Store(ctx, Example.NewMonsterBuffer(b))
+	if err != nil {
+		t.Fatalf("Store of a buffer failed: %v", err)
+	}
+	if string(out.Id()) != "Orc" || out.Val() != 300 {
+		t.Errorf("Store returned %s with %d, want Orc with 300", out.Id(), out.Val())
+	}
+
+	// A typed nil response is reported, rather than dereferenced.
+	if _, err := c.Store(ctx, &Example.MonsterT{Name: "nil"}); status.Code(err) != codes.Internal {
+		t.Errorf("Store returning a nil *StatT: got %v, want an Internal error", err)
+	}
+	// So is a typed nil request, before it is sent.
+	if _, err := c.Store(ctx, (*Example.MonsterT)(nil)); status.Code(err) != codes.Internal {
+		t.Errorf("Store of a nil *MonsterT: got %v, want an Internal error", err)
+	}
+}
+
+func TestTypedChecks(t *testing.T) {
+	c := dialTyped(t, flatbuffers.FlatbuffersCodec{})
+	ctx := context.Background()
+
+	// A request must have the file identifier of Monster.
+	if _, err := c.Store(ctx, Example.NewMonsterBuffer(bareMonster("Orc"))); status.Code(err) != codes.Internal {
+		t.Errorf("Store of a monster without its identifier: got %v, want an Internal error", err)
+	}
+	// It must also have the required fields of a Monster, which a Stat lacks.
+	b := flatbuffers.NewBuilder(0)
+	id := b.CreateString("Orc")
+	Example.StatStart(b)
+	Example.StatAddId(b, id)
+	Example.FinishMonsterBuffer(b, Example.StatEnd(b))
+	if _, err := c.Store(ctx, Example.NewMonsterBuffer(b)); status.Code(err) != codes.Internal {
+		t.Errorf("Store of a stat as a monster: got %v, want an Internal error", err)
+	}
+
+	// The typed server checks the responses it sends.
+	retrieved, err := c.Retrieve(ctx, &Example.StatT{Id: "bare"})
+	if err != nil {
+		t.Fatalf("Retrieve failed: %v", err)
+	}
+	if _, err := retrieved.Recv(); status.Code(err) != codes.Internal {
+		t.Errorf("Retrieve of a monster without its identifier: got %v, want an Internal error", err)
+	}
+
+	// The typed client checks the responses it receives, even from a server
+	// that is not typed.
+	untyped := Example.NewMonsterStorageTypedClient(dial(t, flatbuffers.FlatbuffersCodec{}, func(s *grpc.Server) {
+		Example.RegisterMonsterStorageServer(s, &server{})
+	}))
+	retrieved, err = untyped.Retrieve(ctx, &Example.StatT{Id: test})
+	if err != nil {
+		t.Fatalf("Retrieve failed: %v", err)
+	}
+	if _, err := retrieved.Recv(); status.Code(err) != codes.Internal {
+		t.Errorf("Recv of a monster without its identifier: got %v, want an Internal error", err)
+	}
+}
+
+func TestTypedIdentifier(t *testing.T) {
+	// The typed client finishes monsters with their identifier, so a server
+	// that requires it accepts them.
+	c := dialTyped(t, flatbuffers.FlatbuffersCodec{Identifier: Example.MonsterIdentifier})
+	ctx := context.Background()
+
+	out, err := c.Store(ctx, &Example.MonsterT{Name: test, Hp: 80})
+	if err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+	if string(out.Id()) != test || out.Val() != 80 {
+		t.Errorf("Store returned %s with %d, want %s with 80", out.Id(), out.Val(), test)
+	}
+
+	maxStream, err := c.GetMaxHitPoint(ctx)
+	if err != nil {
+		t.Fatalf("GetMaxHitPoint failed: %v", err)
+	}
+	if err := maxStream.Send(&Example.MonsterT{Name: test, Hp: 30}); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if max, err := maxStream.CloseAndRecv(); err != nil || max.Val() != 30 {
+		t.Errorf("GetMaxHitPoint returned %v, %v, want 30", max, err)
+	}
+}
+
+func TestTypedStreaming(t *testing.T) {
+	c := dialTyped(t, flatbuffers.FlatbuffersCodec{})
+	ctx := context.Background()
+
+	retrieved, err := c.Retrieve(ctx, &Example.StatT{Id: test, Count: 3})
+	if err != nil {
+		t.Fatalf("Retrieve failed: %v", err)
+	}
+	for i := 0; ; i++ {
+		m, err := retrieved.Recv()
+		if err == io.EOF {
+			if i != 3 {
+				t.Errorf("Retrieve streamed %d monsters, want 3", i)
+			}
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv failed: %v", err)
+		}
+		if string(m.Name()) != test || m.Hp() != int16(i) {
+			t.Errorf("monster %d is %s with %d hp", i, m.Name(), m.Hp())
+		}
+	}
+
+	maxStream, err := c.GetMaxHitPoint(ctx)
+	if err != nil {
+		t.Fatalf("GetMaxHitPoint failed: %v", err)
+	}
+	for i, hp := range []int16{30, 90, 60} {
+		if err := maxStream.Send(&Example.MonsterT{Name: string(rune('A' + i)), Hp: hp}); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+	}
+	max, err := maxStream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CloseAndRecv failed: %v", err)
+	}
+	if string(max.Id()) != "B" || max.Val() != 90 {
+		t.Errorf("GetMaxHitPoint returned %s with %d, want B with 90", max.Id(), max.Val())
+	}
+
+	bidi, err := c.GetMinMaxHitPoints(ctx)
+	if err != nil {
+		t.Fatalf("GetMinMaxHitPoints failed: %v", err)
+	}
+	for _, hp := range []int16{10, 20} {
+		if err := bidi.Send(&Example.MonsterT{Name: test, Hp: hp}); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+		stat, err := bidi.Recv()
+		if err != nil {
+			t.Fatalf("Recv failed: %v", err)
+		}
+		if string(stat.Id()) != test || stat.Val() != int64(hp) {
+			t.Errorf("GetMinMaxHitPoints returned %s with %d, want %s with %d", stat.Id(), stat.Val(), test, hp)
+		}
+	}
+	if err := bidi.CloseSend(); err != nil {
+		t.Fatalf("CloseSend failed: %v", err)
+	}
+	if _, err := bidi.Recv(); err != io.EOF {
+		t.Errorf("got %v after CloseSend, want io.EOF", err)
+	}
+}
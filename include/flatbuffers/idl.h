@@ -676,6 +676,10 @@ struct IDLOptions {
   std::string go_import;
   std::string go_namespace;
   std::string go_module_name;
+  bool go_grpc_typed;
+  bool go_grpc_fakes;
+  bool go_http;
+  bool go_typed_offsets;
   bool protobuf_ascii_alike;
   bool size_prefixed;
   std::string root_type;
@@ -791,6 +795,10 @@ struct IDLOptions {
         binary_schema_comments(false),
         binary_schema_builtins(false),
         binary_schema_gen_embed(false),
+        go_grpc_typed(false),
+        go_grpc_fakes(false),
+        go_http(false),
+        go_typed_offsets(false),
         protobuf_ascii_alike(false),
         size_prefixed(false),
         force_defaults(false),
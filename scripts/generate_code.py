@@ -120,6 +120,7 @@ flatc(
         "--kotlin",
         "--dart",
         "--go",
+        "--go-http",
         "--lobster",
         "--php",
     ],
@@ -154,6 +155,13 @@ flatc(
     include="include_test",
 )
 
+# The Go gRPC stubs, with their typed variants and in-memory fakes.
+flatc(
+    NO_INCL_OPTS + ["--go", "--grpc", "--go-grpc-typed", "--go-grpc-fakes"],
+    schema="monster_test.fbs",
+    include="include_test",
+)
+
 flatc(
     NO_INCL_OPTS + CPP_OPTS + ["--grpc"],
     schema="monster_test.fbs",
@@ -532,6 +540,9 @@ if not args.skip_gen_reflection:
 # Python Reflection
 flatc_reflection(["-p"], "python/flatbuffers", "reflection")
 
+# Go Reflection
+flatc_reflection(["--go", "--gen-object-api"], "go", "reflection")
+
 # Java Reflection
 flatc_reflection(
     ["-j", "--java-package-prefix", "com.google.flatbuffers"],
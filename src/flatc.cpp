@@ -164,6 +164,18 @@ const static FlatCOption flatc_options[] = {
     "\"github.com/google/flatbuffers/go\")." },
   { "", "go-module-name", "",
     "Prefix local import paths of generated go code with the module name" },
+  { "", "go-grpc-typed", "",
+    "Also generate typed Go gRPC stubs that take object API values or typed "
+    "finished buffers instead of a flatbuffers.Builder." },
+  { "", "go-grpc-fakes", "",
+    "Also generate in-memory fakes of the Go gRPC client and server, for "
+    "tests." },
+  { "", "go-http", "",
+    "Generate net/http clients and handlers for rpc_service declarations in "
+    "Golang." },
+  { "", "go-typed-offsets", "",
+    "Generate Go builder functions that take and return flatbuffers.Offset[T] "
+    "instead of flatbuffers.UOffsetT (requires Go 1.18)." },
   { "", "raw-binary", "",
     "Allow binaries without file_identifier to be read. This may crash flatc "
     "given a mismatched schema." },
@@ -476,6 +488,14 @@ FlatCOptions FlatCompiler::ParseFromCommandLineArguments(int argc,
       } else if (arg == "--go-module-name") {
         if (++argi >= argc) Error("missing golang module name" + arg, true);
         opts.go_module_name = argv[argi];
+      } else if (arg == "--go-grpc-typed") {
+        opts.go_grpc_typed = true;
+      } else if (arg == "--go-grpc-fakes") {
+        opts.go_grpc_fakes = true;
+      } else if (arg == "--go-http") {
+        opts.go_http = true;
+      } else if (arg == "--go-typed-offsets") {
+        opts.go_typed_offsets = true;
       } else if (arg == "--defaults-json") {
         opts.output_default_scalars_in_json = true;
       } else if (arg == "--unknown-json") {
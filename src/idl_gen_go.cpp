@@ -115,6 +115,12 @@ class GoGenerator : public BaseGenerator {
         GenNativeUnion(**it, &enumcode);
         GenNativeUnionPack(**it, &enumcode);
         GenNativeUnionUnPack(**it, &enumcode);
+        GenUnionSplice(**it, &enumcode);
+        GenUnionRandom(**it, &enumcode);
+        needs_imports = true;
+      } else if (parser_.opts.generate_object_based_api &&
+                 !(*it)->generated) {
+        GenEnumRandom(**it, &enumcode);
         needs_imports = true;
       }
       if (parser_.opts.one_file) {
@@ -136,6 +142,17 @@ class GoGenerator : public BaseGenerator {
       }
     }
 
+    if (parser_.opts.go_http) {
+      for (auto it = parser_.services_.vec.begin();
+           it != parser_.services_.vec.end(); ++it) {
+        if ((*it)->generated) continue;
+        ResetImports();
+        std::string servicecode;
+        GenHTTPService(**it, &servicecode);
+        if (!SaveHTTPService(**it, servicecode)) return false;
+      }
+    }
+
     if (parser_.opts.one_file) {
       std::string code = "";
       const bool is_enum = !parser_.enums_.vec.empty();
@@ -162,6 +179,8 @@ class GoGenerator : public BaseGenerator {
   std::set<const Definition *, NamespacePtrLess> tracked_imported_namespaces_;
   bool needs_math_import_ = false;
   bool needs_bytes_import_ = false;
+  bool needs_flathash_import_ = false;
+  bool needs_strings_import_ = false;
 
   // Most field accessors need to retrieve and test the field offset first,
   // this is the prefix code for that.
@@ -254,11 +273,171 @@ class GoGenerator : public BaseGenerator {
     code += "\tif s, ok := EnumNames" + enum_type + "[v]; ok {\n";
     code += "\t\treturn s\n";
     code += "\t}\n";
+    if (enum_def.attributes.Lookup("bit_flags")) {
+      code += "\tif s := v.format(\"|\"); s != \"\" {\n";
+      code += "\t\treturn s\n";
+      code += "\t}\n";
+    }
     code += "\treturn \"" + enum_def.name;
     code += "(\" + strconv.FormatInt(int64(v), 10) + \")\"\n";
     code += "}\n\n";
   }
 
+  // Generate the list of values and the IsValid() method of an enum type.
+  void EnumValidation(const EnumDef &enum_def, std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    const std::string enum_type = namer_.Type(enum_def);
+    code += "// " + enum_type + "Values returns the values of " + enum_type +
+            ", in declaration order.\n";
+    code += "func " + enum_type + "Values() []" + enum_type + " {\n";
+    code += "\treturn []" + enum_type + "{\n";
+    for (auto it = enum_def.Vals().begin(); it != enum_def.Vals().end(); ++it) {
+      code += "\t\t" + namer_.EnumVariant(enum_def, **it) + ",\n";
+    }
+    code += "\t}\n";
+    code += "}\n\n";
+
+    if (enum_def.attributes.Lookup("bit_flags")) {
+      code += "// IsValid reports whether v is a combination of the flags of " +
+              enum_type + ".\n";
+      code += "func (v " + enum_type + ") IsValid() bool {\n";
+      code += "\treturn v&^(";
+      for (auto it = enum_def.Vals().begin(); it != enum_def.Vals().end();
+           ++it) {
+        if (it != enum_def.Vals().begin()) code += "|";
+        code += namer_.EnumVariant(enum_def, **it);
+      }
+      code += ") == 0\n";
+      code += "}\n\n";
+    } else {
+      code += "// IsValid reports whether v is a value of " + enum_type +
+              ".\n";
+      code += "func (v " + enum_type + ") IsValid() bool {\n";
+      code += "\t_, ok := EnumNames" + enum_type + "[v]\n";
+      code += "\treturn ok\n";
+      code += "}\n\n";
+    }
+  }
+
+  // Generate the set operations of a bit_flags enum type.
+  void EnumFlags(const EnumDef &enum_def, std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    const std::string enum_type = namer_.Type(enum_def);
+    code += "// Has reports whether all of flags are set in v.\n";
+    code += "func (v " + enum_type + ") Has(flags " + enum_type + ") bool {\n";
+    code += "\treturn v&flags == flags\n";
+    code += "}\n\n";
+    code += "// Set sets flags in v.\n";
+    code += "func (v *" + enum_type + ") Set(flags " + enum_type + ") {\n";
+    code += "\t*v |= flags\n";
+    code += "}\n\n";
+    code += "// Clear clears flags in v.\n";
+    code += "func (v *" + enum_type + ") Clear(flags " + enum_type + ") {\n";
+    code += "\t*v &^= flags\n";
+    code += "}\n\n";
+
+    code += "// format joins the names of the flags set in v with sep, or "
+            "returns \"\" if v\n// is not a combination of flags.\n";
+    code += "func (v " + enum_type + ") format(sep string) string {\n";
+    code += "\tif v == 0 || !v.IsValid() {\n";
+    code += "\t\treturn \"\"\n";
+    code += "\t}\n";
+    code += "\tvar names []string\n";
+    code += "\tfor _, f := range " + enum_type + "Values() {\n";
+    code += "\t\tif v.Has(f) {\n";
+    code += "\t\t\tnames = append(names, EnumNames" + enum_type + "[f])\n";
+    code += "\t\t}\n";
+    code += "\t}\n";
+    code += "\treturn strings.Join(names, sep)\n";
+    code += "}\n\n";
+  }
+
+  // Generate the text marshaling methods and the parser of an enum type.
+  void EnumText(const EnumDef &enum_def, std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    const std::string enum_type = namer_.Type(enum_def);
+    const bool bit_flags = enum_def.attributes.Lookup("bit_flags") != nullptr;
+    const BaseType base_type = enum_def.underlying_type.base_type;
+    const std::string bits = NumToString(SizeOf(base_type) * 8);
+    const std::string format =
+        IsUnsigned(base_type) ? "strconv.FormatUint(uint64(v), 10)"
+                              : "strconv.FormatInt(int64(v), 10)";
+    const std::string parse = IsUnsigned(base_type) ? "strconv.ParseUint("
+                                                    : "strconv.ParseInt(";
+
+    if (bit_flags) {
+      code += "// MarshalText formats v like flatc's JSON output: as the name "
+              "of a value, as\n// the names of its flags separated by "
+              "spaces, or as a number.\n";
+    } else {
+      code += "// MarshalText formats v like flatc's JSON output: as the name "
+              "of a value, or\n// as a number.\n";
+    }
+    code += "func (v " + enum_type + ") MarshalText() ([]byte, error) {\n";
+    code += "\tif s, ok := EnumNames" + enum_type + "[v]; ok {\n";
+    code += "\t\treturn []byte(s), nil\n";
+    code += "\t}\n";
+    if (bit_flags) {
+      code += "\tif s := v.format(\" \"); s != \"\" {\n";
+      code += "\t\treturn []byte(s), nil\n";
+      code += "\t}\n";
+    }
+    code += "\treturn []byte(" + format + "), nil\n";
+    code += "}\n\n";
+
+    code += "// UnmarshalText parses text like Parse" + enum_type + ".\n";
+    code += "func (v *" + enum_type + ") UnmarshalText(text []byte) error {\n";
+    code += "\tx, err := Parse" + enum_type + "(string(text))\n";
+    code += "\tif err != nil {\n";
+    code += "\t\treturn err\n";
+    code += "\t}\n";
+    code += "\t*v = x\n";
+    code += "\treturn nil\n";
+    code += "}\n\n";
+
+    const std::string invalid = "errors.New(\"invalid " + enum_type +
+                                ": \" + strconv.Quote(s))";
+    if (bit_flags) {
+      code += "// Parse" + enum_type + " parses the names or numbers of " +
+              enum_type + " flags, separated by\n// spaces as in flatc's "
+              "JSON or by \"|\" as in String.\n";
+      code += "func Parse" + enum_type + "(s string) (" + enum_type +
+              ", error) {\n";
+      code += "\tfields := strings.FieldsFunc(s, func(r rune) bool { return "
+              "r == ' ' || r == '|' })\n";
+      code += "\tif len(fields) == 0 {\n";
+      code += "\t\treturn 0, " + invalid + "\n";
+      code += "\t}\n";
+      code += "\tvar v " + enum_type + "\n";
+      code += "\tfor _, f := range fields {\n";
+      code += "\t\tif x, ok := EnumValues" + enum_type + "[f]; ok {\n";
+      code += "\t\t\tv |= x\n";
+      code += "\t\t\tcontinue\n";
+      code += "\t\t}\n";
+      code += "\t\tn, err := " + parse + "f, 10, " + bits + ")\n";
+      code += "\t\tif err != nil {\n";
+      code += "\t\t\treturn 0, " + invalid + "\n";
+      code += "\t\t}\n";
+      code += "\t\tv |= " + enum_type + "(n)\n";
+      code += "\t}\n";
+      code += "\treturn v, nil\n";
+    } else {
+      code += "// Parse" + enum_type + " parses the name or the number of a " +
+              enum_type + " value.\n";
+      code += "func Parse" + enum_type + "(s string) (" + enum_type +
+              ", error) {\n";
+      code += "\tif v, ok := EnumValues" + enum_type + "[s]; ok {\n";
+      code += "\t\treturn v, nil\n";
+      code += "\t}\n";
+      code += "\tn, err := " + parse + "s, 10, " + bits + ")\n";
+      code += "\tif err != nil {\n";
+      code += "\t\treturn 0, " + invalid + "\n";
+      code += "\t}\n";
+      code += "\treturn " + enum_type + "(n), nil\n";
+    }
+    code += "}\n\n";
+  }
+
   // Begin enum value map.
   void BeginEnumValues(const EnumDef &enum_def, std::string *code_ptr) {
     std::string &code = *code_ptr;
@@ -298,6 +477,17 @@ class GoGenerator : public BaseGenerator {
     if (has_file_identifier) {
       code += "const " + struct_type + "Identifier = \"" +
               parser_.file_identifier_ + "\"\n\n";
+      code += "// " + struct_type + "RootType describes " + struct_type +
+              " buffers to a flatbuffers.Registry.\n";
+      code += "var " + struct_type + "RootType = flatbuffers.RootType{\n";
+      code += "\tName:       \"" +
+              struct_def.defined_namespace->GetFullyQualifiedName(
+                  struct_def.name) +
+              "\",\n";
+      code += "\tIdentifier: " + struct_type + "Identifier,\n";
+      code += "\tNew:        func() flatbuffers.FlatBuffer { return &" +
+              struct_type + "{} },\n";
+      code += "}\n\n";
     }
 
     for (int i = 0; i < 2; i++) {
@@ -322,14 +512,15 @@ class GoGenerator : public BaseGenerator {
       code += "}\n\n";
 
       code += "func Finish" + size_prefix[i] + struct_type +
-              "Buffer(builder *flatbuffers.Builder, offset "
-              "flatbuffers.UOffsetT) {\n";
+              "Buffer(builder *flatbuffers.Builder, offset " +
+              OffsetType(struct_def) + ") {\n";
       if (has_file_identifier) {
         code += "\tidentifierBytes := []byte(" + struct_type + "Identifier)\n";
-        code += "\tbuilder.Finish" + size_prefix[i] +
-                "WithFileIdentifier(offset, identifierBytes)\n";
+        code += "\tbuilder.Finish" + size_prefix[i] + "WithFileIdentifier(" +
+                FromOffsetType("offset") + ", identifierBytes)\n";
       } else {
-        code += "\tbuilder.Finish" + size_prefix[i] + "(offset)\n";
+        code += "\tbuilder.Finish" + size_prefix[i] + "(" +
+                FromOffsetType("offset") + ")\n";
       }
       code += "}\n\n";
 
@@ -395,6 +586,23 @@ class GoGenerator : public BaseGenerator {
     code += "\treturn nil\n}\n\n";
   }
 
+  // Get a nested_flatbuffer field as its root table.
+  void GetNestedFlatBufferRoot(const StructDef &struct_def,
+                               const FieldDef &field, std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    const StructDef &nested = *field.nested_flatbuffer;
+    const std::string nested_type =
+        WrapInNameSpaceAndTrack(&nested, namer_.Type(nested));
+
+    GenReceiver(struct_def, code_ptr);
+    code += " " + namer_.Function(field) + "NestedRoot(";
+    code += ") *" + nested_type + " " + OffsetPrefix(field);
+    code += "\t\treturn " +
+            WrapInNameSpaceAndTrack(&nested, "GetRootAs" + namer_.Type(nested));
+    code += "(rcv._tab.Bytes, rcv._tab.Vector(o))\n\t}\n";
+    code += "\treturn nil\n}\n\n";
+  }
+
   // Get the value of a struct's scalar.
   void GetScalarFieldOfStruct(const StructDef &struct_def,
                               const FieldDef &field, std::string *code_ptr) {
@@ -614,9 +822,9 @@ class GoGenerator : public BaseGenerator {
   }
 
   // End the creator function signature.
-  void EndBuilderArgs(std::string *code_ptr) {
+  void EndBuilderArgs(const StructDef &struct_def, std::string *code_ptr) {
     std::string &code = *code_ptr;
-    code += ") flatbuffers.UOffsetT {\n";
+    code += ") " + OffsetType(struct_def) + " {\n";
   }
 
   // Recursively generate struct construction statements and instert manual
@@ -643,9 +851,9 @@ class GoGenerator : public BaseGenerator {
     }
   }
 
-  void EndBuilderBody(std::string *code_ptr) {
+  void EndBuilderBody(const StructDef &struct_def, std::string *code_ptr) {
     std::string &code = *code_ptr;
-    code += "\treturn builder.Offset()\n";
+    code += "\treturn " + ToOffsetType(struct_def, "builder.Offset()") + "\n";
     code += "}\n";
   }
 
@@ -668,7 +876,7 @@ class GoGenerator : public BaseGenerator {
     code += "(builder *flatbuffers.Builder, ";
     code += field_var + " ";
     if (!IsScalar(field.value.type.base_type) && (!struct_def.fixed)) {
-      code += "flatbuffers.UOffsetT";
+      code += OffsetType(field.value.type);
     } else {
       code += GenTypeGet(field.value.type);
     }
@@ -696,6 +904,20 @@ class GoGenerator : public BaseGenerator {
     code += "}\n";
   }
 
+  // Set the value of a hashed field from the string it is a hash of.
+  void BuildHashedFieldOfTable(const StructDef &struct_def,
+                               const FieldDef &field, std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    const std::string field_var = namer_.Variable(field);
+    const std::string add = namer_.Type(struct_def) + "Add" +
+                            namer_.Function(field);
+    code += "func " + add + "FromString";
+    code += "(builder *flatbuffers.Builder, " + field_var + " string) {\n";
+    code += "\t" + add + "(builder, " +
+            HashedValue(field, field.value.type, field_var) + ")\n";
+    code += "}\n";
+  }
+
   // Set the value of one of the members of a table's vector.
   void BuildVectorOfTable(const StructDef &struct_def, const FieldDef &field,
                           std::string *code_ptr) {
@@ -712,12 +934,39 @@ class GoGenerator : public BaseGenerator {
     code += ")\n}\n";
   }
 
+  // Embed a finished nested_flatbuffer builder as the field's vector.
+  void BuildNestedFlatBufferOfTable(const StructDef &struct_def,
+                                    const FieldDef &field,
+                                    std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    code += "func " + namer_.Type(struct_def) + "Make";
+    code += namer_.Function(field);
+    code += "Vector(builder *flatbuffers.Builder, nested *flatbuffers.Builder) ";
+    code += OffsetType(field.value.type) + " {\n";
+    code += "\treturn " +
+            ToOffsetType(field.value.type,
+                         "builder.CreateNestedFlatBuffer(nested)") +
+            "\n";
+    code += "}\n";
+  }
+
   // Get the offset of the end of a table.
   void GetEndOffsetOnTable(const StructDef &struct_def, std::string *code_ptr) {
     std::string &code = *code_ptr;
     code += "func " + namer_.Type(struct_def) + "End";
-    code += "(builder *flatbuffers.Builder) flatbuffers.UOffsetT ";
-    code += "{\n\treturn builder.EndObject()\n}\n";
+    code += "(builder *flatbuffers.Builder) " + OffsetType(struct_def) + " {\n";
+    for (auto it = struct_def.fields.vec.begin();
+         it != struct_def.fields.vec.end(); ++it) {
+      const FieldDef &field = **it;
+      if (field.deprecated || !field.IsRequired()) continue;
+      code += "\tbuilder.Required(" +
+              NumToString(it - struct_def.fields.vec.begin()) + ", \"" +
+              struct_def.defined_namespace->GetFullyQualifiedName(
+                  struct_def.name) +
+              "." + field.name + "\")\n";
+    }
+    code += "\treturn " + ToOffsetType(struct_def, "builder.EndObject()") +
+            "\n}\n";
   }
 
   // Generate the receiver for function signatures.
@@ -772,6 +1021,9 @@ class GoGenerator : public BaseGenerator {
       if (field.value.type.element == BASE_TYPE_UCHAR) {
         GetUByteSlice(struct_def, field, code_ptr);
       }
+      if (field.nested_flatbuffer) {
+        GetNestedFlatBufferRoot(struct_def, field, code_ptr);
+      }
     }
   }
 
@@ -854,12 +1106,88 @@ class GoGenerator : public BaseGenerator {
 
       auto offset = it - struct_def.fields.vec.begin();
       BuildFieldOfTable(struct_def, field, offset, code_ptr);
+      if (IsHashed(field) && IsScalar(field.value.type.base_type)) {
+        BuildHashedFieldOfTable(struct_def, field, code_ptr);
+      }
       if (IsVector(field.value.type)) {
         BuildVectorOfTable(struct_def, field, code_ptr);
       }
+      if (field.nested_flatbuffer) {
+        BuildNestedFlatBufferOfTable(struct_def, field, code_ptr);
+      }
     }
 
     GetEndOffsetOnTable(struct_def, code_ptr);
+    GenTableBuilderType(struct_def, code_ptr);
+  }
+
+  // Generate a builder type with chainable Add methods, like MonsterBuilder in
+  // C++, which starts the table when it is created, and checks the required
+  // fields when it is finished.
+  void GenTableBuilderType(const StructDef &struct_def,
+                           std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    const std::string struct_type = namer_.Type(struct_def);
+    const std::string builder_type = struct_type + "Builder";
+    const std::string full_name =
+        struct_def.defined_namespace->GetFullyQualifiedName(struct_def.name);
+
+    code += "\n// " + builder_type + " builds a " + struct_type +
+            " with chainable Add methods. It panics\n";
+    code += "// if a field is added after Finish, which checks the required "
+            "fields.\n";
+    code += "type " + builder_type + " struct {\n";
+    code += "\ttable flatbuffers.TableBuilder\n";
+    code += "}\n\n";
+
+    code += "// New" + builder_type + " starts a " + struct_type +
+            " in builder.\n";
+    code += "func New" + builder_type +
+            "(builder *flatbuffers.Builder) *" + builder_type + " {\n";
+    code += "\trcv := &" + builder_type + "{}\n";
+    code += "\trcv.table.Start(builder, \"" + full_name + "\", " +
+            NumToString(struct_def.fields.vec.size()) + ")\n";
+    code += "\treturn rcv\n";
+    code += "}\n";
+
+    for (auto it = struct_def.fields.vec.begin();
+         it != struct_def.fields.vec.end(); ++it) {
+      const FieldDef &field = **it;
+      if (field.deprecated) continue;
+      const std::string field_fn = namer_.Function(field);
+      const std::string field_var = namer_.Variable(field);
+      code += "func (rcv *" + builder_type + ") Add" + field_fn + "(" +
+              field_var + " ";
+      if (!IsScalar(field.value.type.base_type)) {
+        code += OffsetType(field.value.type);
+      } else {
+        code += GenTypeGet(field.value.type);
+      }
+      code += ") *" + builder_type + " {\n";
+      code += "\t" + struct_type + "Add" + field_fn + "(rcv.table.Field(" +
+              NumToString(it - struct_def.fields.vec.begin()) + "), " +
+              field_var + ")\n";
+      code += "\treturn rcv\n";
+      code += "}\n";
+    }
+
+    code += "\n// Finish ends the " + struct_type +
+            " and returns its offset, and an error\n";
+    code += "// wrapping flatbuffers.ErrRequiredField if a required field was "
+            "not added.\n";
+    code += "func (rcv *" + builder_type + ") Finish() (" +
+            OffsetType(struct_def) + ", error) {\n";
+    for (auto it = struct_def.fields.vec.begin();
+         it != struct_def.fields.vec.end(); ++it) {
+      const FieldDef &field = **it;
+      if (field.deprecated || !field.IsRequired()) continue;
+      code += "\trcv.table.Required(" +
+              NumToString(it - struct_def.fields.vec.begin()) + ", \"" +
+              full_name + "." + field.name + "\")\n";
+    }
+    code += "\treturn " + struct_type +
+            "End(rcv.table.End()), rcv.table.Err()\n";
+    code += "}\n";
   }
 
   // Generate struct or table methods.
@@ -895,6 +1223,7 @@ class GoGenerator : public BaseGenerator {
       // TODO(michaeltle): Support querying fixed struct by key. Currently,
       // we only support keyed tables.
       if (!struct_def.fixed && field.key) {
+        GenKey(struct_def, field, code_ptr);
         GenKeyCompare(struct_def, field, code_ptr);
         GenLookupByKey(struct_def, field, code_ptr);
       }
@@ -910,25 +1239,49 @@ class GoGenerator : public BaseGenerator {
     }
   }
 
+  // Generate a function that extracts the key of a table being built, for
+  // Builder.CreateVectorOfSortedTablesByKey.
+  void GenKey(const StructDef &struct_def, const FieldDef &field,
+              std::string *code_ptr) {
+    FLATBUFFERS_ASSERT(struct_def.has_key);
+    FLATBUFFERS_ASSERT(field.key);
+    std::string &code = *code_ptr;
+    const std::string type = namer_.Type(struct_def);
+    const std::string value = "obj." + namer_.Function(field.name) + "()";
+    const BaseType base_type = field.value.type.base_type;
+
+    code += "// " + type + "Key returns the key of the " + type;
+    code += " at off in buf, for\n";
+    code += "// Builder.CreateVectorOfSortedTablesByKey.\n";
+    code += "func " + type + "Key(";
+    code += "off flatbuffers.UOffsetT, buf []byte) flatbuffers.Key {\n";
+    code += "\tobj := " + type + "{}\n";
+    code += "\tobj.Init(buf, flatbuffers.UOffsetT(len(buf))-off)\n";
+    if (IsString(field.value.type)) {
+      code += "\treturn flatbuffers.StringKey(" + value + ")\n";
+    } else if (IsBool(base_type)) {
+      code += "\treturn flatbuffers.BoolKey(" + value + ")\n";
+    } else if (IsFloat(base_type)) {
+      code += "\treturn flatbuffers.Float64Key(float64(" + value + "))\n";
+    } else if (IsUnsigned(base_type)) {
+      code += "\treturn flatbuffers.Uint64Key(uint64(" + value + "))\n";
+    } else {
+      code += "\treturn flatbuffers.Int64Key(int64(" + value + "))\n";
+    }
+    code += "}\n\n";
+  }
+
   void GenKeyCompare(const StructDef &struct_def, const FieldDef &field,
                      std::string *code_ptr) {
     FLATBUFFERS_ASSERT(struct_def.has_key);
     FLATBUFFERS_ASSERT(field.key);
     std::string &code = *code_ptr;
+    const std::string type = namer_.Type(struct_def);
 
-    code += "func " + namer_.Type(struct_def) + "KeyCompare(";
+    code += "func " + type + "KeyCompare(";
     code += "o1, o2 flatbuffers.UOffsetT, buf []byte) bool {\n";
-    code += "\tobj1 := &" + namer_.Type(struct_def) + "{}\n";
-    code += "\tobj2 := &" + namer_.Type(struct_def) + "{}\n";
-    code += "\tobj1.Init(buf, flatbuffers.UOffsetT(len(buf))-o1)\n";
-    code += "\tobj2.Init(buf, flatbuffers.UOffsetT(len(buf))-o2)\n";
-    if (IsString(field.value.type)) {
-      code += "\treturn string(obj1." + namer_.Function(field.name) + "()) < ";
-      code += "string(obj2." + namer_.Function(field.name) + "())\n";
-    } else {
-      code += "\treturn obj1." + namer_.Function(field.name) + "() < ";
-      code += "obj2." + namer_.Function(field.name) + "()\n";
-    }
+    code += "\treturn " + type + "Key(o1, buf).Compare(" + type;
+    code += "Key(o2, buf)) < 0\n";
     code += "}\n\n";
   }
 
@@ -997,7 +1350,7 @@ class GoGenerator : public BaseGenerator {
         continue;
       code += "\t" + namer_.Field(field) + " ";
       if (field.IsScalarOptional()) { code += "*"; }
-      code += NativeType(field.value.type) + " `json:\"" + field.name + "\"`" +
+      code += NativeFieldType(field) + " `json:\"" + field.name + "\"`" +
               "\n";
     }
     code += "}\n\n";
@@ -1005,9 +1358,14 @@ class GoGenerator : public BaseGenerator {
     if (!struct_def.fixed) {
       GenNativeTablePack(struct_def, code_ptr);
       GenNativeTableUnPack(struct_def, code_ptr);
+      GenTableSplice(struct_def, code_ptr);
+      GenTableMask(struct_def, code_ptr);
+      GenNativeTableHashSetters(struct_def, code_ptr);
+      GenTableRandom(struct_def, code_ptr);
     } else {
       GenNativeStructPack(struct_def, code_ptr);
       GenNativeStructUnPack(struct_def, code_ptr);
+      GenStructRandom(struct_def, code_ptr);
     }
   }
 
@@ -1017,6 +1375,11 @@ class GoGenerator : public BaseGenerator {
     code += "\tType " + namer_.Type(enum_def) + "\n";
     code += "\tValue interface{}\n";
     code += "}\n\n";
+
+    code += "// Reset sets t to the zero " + NativeName(enum_def) + ".\n";
+    code += "func (t *" + NativeName(enum_def) + ") Reset() {\n";
+    code += "\t*t = " + NativeName(enum_def) + "{}\n";
+    code += "}\n\n";
   }
 
   void GenNativeUnionPack(const EnumDef &enum_def, std::string *code_ptr) {
@@ -1041,27 +1404,47 @@ class GoGenerator : public BaseGenerator {
 
   void GenNativeUnionUnPack(const EnumDef &enum_def, std::string *code_ptr) {
     std::string &code = *code_ptr;
+    const std::string native_type =
+        WrapInNameSpaceAndTrack(&enum_def, NativeName(enum_def));
 
     code += "func (rcv " + namer_.Type(enum_def) +
             ") UnPack(table flatbuffers.Table) *" + NativeName(enum_def) +
             " {\n";
+    code += "\treturn rcv.UnPackTo(table, nil, nil)\n";
+    code += "}\n\n";
+
+    code += "// UnPackTo unpacks the member of the union in table into t, "
+            "reusing t and the\n";
+    code += "// value it holds if they are not nil, and returns t, or nil if "
+            "rcv is not a\n";
+    code += "// member. Strings are taken from interner, which may be nil.\n";
+    code += "func (rcv " + namer_.Type(enum_def) +
+            ") UnPackTo(table flatbuffers.Table, t *" + NativeName(enum_def) +
+            ", interner *flatbuffers.Interner) *" + NativeName(enum_def) +
+            " {\n";
     code += "\tswitch rcv {\n";
 
     for (auto it2 = enum_def.Vals().begin(); it2 != enum_def.Vals().end();
          ++it2) {
       const EnumVal &ev = **it2;
       if (ev.IsZero()) continue;
+      const StructDef &member = *ev.union_type.struct_def;
       code += "\tcase " + namer_.EnumVariant(enum_def, ev) + ":\n";
-      code += "\t\tvar x " +
-              WrapInNameSpaceAndTrack(ev.union_type.struct_def,
-                                      ev.union_type.struct_def->name) +
+      code += "\t\tvar x " + WrapInNameSpaceAndTrack(&member, member.name) +
               "\n";
       code += "\t\tx.Init(table.Bytes, table.Pos)\n";
-
-      code += "\t\treturn &" +
-              WrapInNameSpaceAndTrack(&enum_def, NativeName(enum_def)) +
-              "{Type: " + namer_.EnumVariant(enum_def, ev) +
-              ", Value: x.UnPack()}\n";
+      code += "\t\tif t == nil {\n";
+      code += "\t\t\tt = &" + native_type + "{}\n";
+      code += "\t\t}\n";
+      code += "\t\tv, _ := t.Value.(" + NativeType(ev.union_type) + ")\n";
+      code += "\t\tif v == nil {\n";
+      code += "\t\t\tv = &" +
+              WrapInNameSpaceAndTrack(&member, NativeName(member)) + "{}\n";
+      code += "\t\t}\n";
+      code += "\t\tx.UnPackToInterned(v, interner)\n";
+      code += "\t\tt.Type, t.Value = " + namer_.EnumVariant(enum_def, ev) +
+              ", v\n";
+      code += "\t\treturn t\n";
     }
     code += "\t}\n";
     code += "\treturn nil\n";
@@ -1085,7 +1468,29 @@ class GoGenerator : public BaseGenerator {
       const std::string field_var = namer_.Variable(field);
       const std::string offset = field_var + "Offset";
 
-      if (IsString(field.value.type)) {
+      if (field.nested_flatbuffer) {
+        const StructDef &nested = *field.nested_flatbuffer;
+        const std::string nested_builder = field_var + "Builder";
+        code += "\t" + offset + " := flatbuffers.UOffsetT(0)\n";
+        code += "\tif t." + field_field + " != nil {\n";
+        code += "\t\t" + nested_builder + " := flatbuffers.NewBuilder(0)\n";
+        code += "\t\t" +
+                WrapInNameSpaceAndTrack(
+                    &nested, "Finish" + namer_.Type(nested) + "Buffer") +
+                "(" + nested_builder + ", " +
+                ToOffsetType(nested,
+                             "t." + field_field + ".Pack(" + nested_builder + ")") +
+                ")\n";
+        code += "\t\t" + offset + " = " +
+                FromOffsetType(struct_type + "Make" + namer_.Function(field) +
+                               "Vector(builder, " + nested_builder + ")") +
+                "\n";
+        code += "\t}\n";
+      } else if (IsString(field.value.type) && field.IsRequired()) {
+        // A required string is written even if empty, as in C++.
+        code += "\t" + offset + " := builder.CreateString(t." + field_field +
+                ")\n";
+      } else if (IsString(field.value.type)) {
         code += "\t" + offset + " := flatbuffers.UOffsetT(0)\n";
         code += "\tif t." + field_field + " != \"\" {\n";
         code += "\t\t" + offset + " = builder.CreateString(t." + field_field +
@@ -1182,11 +1587,11 @@ class GoGenerator : public BaseGenerator {
                   "(builder, t." + field_field + ".Type)\n";
           code += "\t}\n";
         }
-        code += "\t" + struct_type + "Add" + field_fn + "(builder, " + offset +
-                ")\n";
+        code += "\t" + struct_type + "Add" + field_fn + "(builder, " +
+                ToOffsetType(field.value.type, offset) + ")\n";
       }
     }
-    code += "\treturn " + struct_type + "End(builder)\n";
+    code += "\treturn " + FromOffsetType(struct_type + "End(builder)") + "\n";
     code += "}\n\n";
   }
 
@@ -1194,9 +1599,21 @@ class GoGenerator : public BaseGenerator {
                             std::string *code_ptr) {
     std::string &code = *code_ptr;
     const std::string struct_type = namer_.Type(struct_def);
+    const std::string native_type = NativeName(struct_def);
+
+    code += "// UnPackTo unpacks rcv into t, reusing the vectors and objects t "
+            "already holds,\n";
+    code += "// which must not be shared with other values.\n";
+    code += "func (rcv *" + struct_type + ") UnPackTo(t *" + native_type +
+            ") {\n";
+    code += "\trcv.UnPackToInterned(t, nil)\n";
+    code += "}\n\n";
 
-    code += "func (rcv *" + struct_type + ") UnPackTo(t *" +
-            NativeName(struct_def) + ") {\n";
+    code += "// UnPackToInterned is UnPackTo taking the strings it allocates "
+            "from interner,\n";
+    code += "// which may be nil.\n";
+    code += "func (rcv *" + struct_type + ") UnPackToInterned(t *" +
+            native_type + ", interner *flatbuffers.Interner) {\n";
     for (auto it = struct_def.fields.vec.begin();
          it != struct_def.fields.vec.end(); ++it) {
       const FieldDef &field = **it;
@@ -1204,45 +1621,93 @@ class GoGenerator : public BaseGenerator {
       const std::string field_field = namer_.Field(field);
       const std::string field_var = namer_.Variable(field);
       const std::string length = field_var + "Length";
-      if (IsScalar(field.value.type.base_type)) {
+      if (field.IsScalarOptional()) {
+        code += "\tif o := flatbuffers.UOffsetT(rcv._tab.Offset(" +
+                NumToString(field.value.offset) + ")); o != 0 {\n";
+        code += "\t\tif t." + field_field + " == nil {\n";
+        code += "\t\t\tt." + field_field + " = new(" +
+                GenTypeGet(field.value.type) + ")\n";
+        code += "\t\t}\n";
+        code += "\t\t*t." + field_field + " = " +
+                CastToEnum(field.value.type,
+                           GenGetter(field.value.type) + "(o + rcv._tab.Pos)") +
+                "\n";
+        code += "\t} else {\n";
+        code += "\t\tt." + field_field + " = nil\n";
+        code += "\t}\n";
+      } else if (IsScalar(field.value.type.base_type)) {
         if (field.value.type.enum_def != nullptr &&
             field.value.type.enum_def->is_union)
           continue;
         code += "\tt." + field_field + " = rcv." + field_field + "()\n";
+      } else if (field.nested_flatbuffer) {
+        const StructDef &nested = *field.nested_flatbuffer;
+        code += "\tif x := rcv." + field_field + "NestedRoot(); x != nil {\n";
+        GenReuseObject(nested, "t." + field_field, code_ptr);
+        code += "\t\tx.UnPackToInterned(t." + field_field + ", interner)\n";
+        code += "\t} else {\n";
+        code += "\t\tt." + field_field + " = nil\n";
+        code += "\t}\n";
       } else if (IsString(field.value.type)) {
-        code += "\tt." + field_field + " = string(rcv." + field_field + "())\n";
+        code += "\tif x := rcv." + field_field + "(); string(x) != t." +
+                field_field + " {\n";
+        code += "\t\tt." + field_field + " = interner.Intern(x)\n";
+        code += "\t}\n";
       } else if (IsVector(field.value.type) &&
                  field.value.type.element == BASE_TYPE_UCHAR &&
                  field.value.type.enum_def == nullptr) {
         code += "\tt." + field_field + " = rcv." + field_field + "Bytes()\n";
       } else if (IsVector(field.value.type)) {
         code += "\t" + length + " := rcv." + field_field + "Length()\n";
-        code += "\tt." + field_field + " = make(" +
+        // An absent vector is unpacked as nil, so that it is packed as
+        // absent rather than empty.
+        code += "\tif rcv._tab.Offset(" + NumToString(field.value.offset) +
+                ") == 0 {\n";
+        code += "\t\tt." + field_field + " = nil\n";
+        code += "\t} else if t." + field_field + " == nil || cap(t." +
+                field_field + ") < " + length + " {\n";
+        code += "\t\tt." + field_field + " = make(" +
                 NativeType(field.value.type) + ", " + length + ")\n";
+        code += "\t} else {\n";
+        code += "\t\tt." + field_field + " = t." + field_field + "[:" +
+                length + "]\n";
+        code += "\t}\n";
         code += "\tfor j := 0; j < " + length + "; j++ {\n";
-        if (field.value.type.element == BASE_TYPE_STRUCT) {
-          code += "\t\tx := " +
-                  WrapInNameSpaceAndTrack(field.value.type.struct_def,
-                                          field.value.type.struct_def->name) +
-                  "{}\n";
-          code += "\t\trcv." + field_field + "(&x, j)\n";
-        }
-        code += "\t\tt." + field_field + "[j] = ";
         if (IsScalar(field.value.type.element)) {
-          code += "rcv." + field_field + "(j)";
+          code += "\t\tt." + field_field + "[j] = rcv." + field_field +
+                  "(j)\n";
         } else if (field.value.type.element == BASE_TYPE_STRING) {
-          code += "string(rcv." + field_field + "(j))";
+          code += "\t\tif x := rcv." + field_field + "(j); string(x) != t." +
+                  field_field + "[j] {\n";
+          code += "\t\t\tt." + field_field + "[j] = interner.Intern(x)\n";
+          code += "\t\t}\n";
         } else if (field.value.type.element == BASE_TYPE_STRUCT) {
-          code += "x.UnPack()";
+          const StructDef &element = *field.value.type.struct_def;
+          code += "\t\tx := " +
+                  WrapInNameSpaceAndTrack(&element, element.name) + "{}\n";
+          code += "\t\trcv." + field_field + "(&x, j)\n";
+          code += "\t\tif t." + field_field + "[j] == nil {\n";
+          code += "\t\t\tt." + field_field + "[j] = &" +
+                  WrapInNameSpaceAndTrack(&element, NativeName(element)) +
+                  "{}\n";
+          code += "\t\t}\n";
+          code += "\t\t" + UnPackToCall(element, "t." + field_field + "[j]") +
+                  "\n";
         } else {
           // TODO(iceboy): Support vector of unions.
           FLATBUFFERS_ASSERT(0);
         }
-        code += "\n";
         code += "\t}\n";
       } else if (field.value.type.base_type == BASE_TYPE_STRUCT) {
-        code +=
-            "\tt." + field_field + " = rcv." + field_field + "(nil).UnPack()\n";
+        const StructDef &child = *field.value.type.struct_def;
+        code += "\tif x := rcv." + field_field + "(&" +
+                WrapInNameSpaceAndTrack(&child, child.name) +
+                "{}); x != nil {\n";
+        GenReuseObject(child, "t." + field_field, code_ptr);
+        code += "\t\t" + UnPackToCall(child, "t." + field_field) + "\n";
+        code += "\t} else {\n";
+        code += "\t\tt." + field_field + " = nil\n";
+        code += "\t}\n";
       } else if (field.value.type.base_type == BASE_TYPE_UNION) {
         const std::string field_table = field_var + "Table";
         code += "\t" + field_table + " := flatbuffers.Table{}\n";
@@ -1250,7 +1715,10 @@ class GoGenerator : public BaseGenerator {
             "\tif rcv." + namer_.Method(field) + "(&" + field_table + ") {\n";
         code += "\t\tt." + field_field + " = rcv." +
                 namer_.Method(field.name + UnionTypeFieldSuffix()) +
-                "().UnPack(" + field_table + ")\n";
+                "().UnPackTo(" + field_table + ", t." + field_field +
+                ", interner)\n";
+        code += "\t} else {\n";
+        code += "\t\tt." + field_field + " = nil\n";
         code += "\t}\n";
       } else {
         FLATBUFFERS_ASSERT(0);
@@ -1258,69 +1726,688 @@ class GoGenerator : public BaseGenerator {
     }
     code += "}\n\n";
 
-    code += "func (rcv *" + struct_type + ") UnPack() *" +
-            NativeName(struct_def) + " {\n";
+    code += "func (rcv *" + struct_type + ") UnPack() *" + native_type +
+            " {\n";
     code += "\tif rcv == nil {\n\t\treturn nil\n\t}\n";
-    code += "\tt := &" + NativeName(struct_def) + "{}\n";
+    code += "\tt := &" + native_type + "{}\n";
     code += "\trcv.UnPackTo(t)\n";
     code += "\treturn t\n";
     code += "}\n\n";
+
+    GenNativeReset(struct_def, code_ptr);
   }
 
-  void GenNativeStructPack(const StructDef &struct_def, std::string *code_ptr) {
+  // Generate the statement that allocates the T of struct_def in target,
+  // unless target already holds one to reuse.
+  void GenReuseObject(const StructDef &struct_def, const std::string &target,
+                      std::string *code_ptr) {
     std::string &code = *code_ptr;
+    code += "\t\tif " + target + " == nil {\n";
+    code += "\t\t\t" + target + " = &" +
+            WrapInNameSpaceAndTrack(&struct_def, NativeName(struct_def)) +
+            "{}\n";
+    code += "\t\t}\n";
+  }
 
-    code += "func (t *" + NativeName(struct_def) +
-            ") Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {\n";
-    code += "\tif t == nil {\n\t\treturn 0\n\t}\n";
-    code += "\treturn Create" + namer_.Type(struct_def) + "(builder";
-    StructPackArgs(struct_def, "", code_ptr);
-    code += ")\n";
-    code += "}\n";
+  // Returns the call that unpacks x, an accessor of struct_def, into target,
+  // interning strings if it is a table.
+  std::string UnPackToCall(const StructDef &struct_def,
+                           const std::string &target) {
+    if (struct_def.fixed) return "x.UnPackTo(" + target + ")";
+    return "x.UnPackToInterned(" + target + ", interner)";
   }
 
-  void StructPackArgs(const StructDef &struct_def, const char *nameprefix,
-                      std::string *code_ptr) {
+  // Generate the Reset method of the T of a table or struct, which keeps the
+  // capacity of vectors, and the nested structs of a struct, for UnPackTo to
+  // reuse.
+  void GenNativeReset(const StructDef &struct_def, std::string *code_ptr) {
     std::string &code = *code_ptr;
+    const std::string native_type = NativeName(struct_def);
+
+    std::string kept;
     for (auto it = struct_def.fields.vec.begin();
          it != struct_def.fields.vec.end(); ++it) {
       const FieldDef &field = **it;
-      if (field.value.type.base_type == BASE_TYPE_STRUCT) {
-        StructPackArgs(*field.value.type.struct_def,
-                       (nameprefix + namer_.Field(field) + ".").c_str(),
-                       code_ptr);
-      } else {
-        code += std::string(", t.") + nameprefix + namer_.Field(field);
+      if (field.deprecated) continue;
+      const Type &type = field.value.type;
+      const bool reused =
+          struct_def.fixed ? IsStruct(type)
+                           : IsVector(type) && !(type.element == BASE_TYPE_UCHAR &&
+                                                 type.enum_def == nullptr);
+      if (!reused) continue;
+      const std::string field_field = namer_.Field(field);
+      kept += "\t\t" + field_field + ": t." + field_field +
+              (struct_def.fixed ? "" : "[:0]") + ",\n";
+    }
+
+    if (struct_def.fixed) {
+      code += "// Reset sets t to the zero " + native_type +
+              ", resetting its nested structs.\n";
+    } else {
+      code += "// Reset sets t to the zero " + native_type +
+              ", except that its vectors keep\n";
+      code += "// their capacity for UnPackTo, which sets those its table "
+              "lacks to nil.\n";
+      code += "// A vector that was not nil is packed as empty until then.\n";
+    }
+    code += "func (t *" + native_type + ") Reset() {\n";
+    if (kept.empty()) {
+      code += "\t*t = " + native_type + "{}\n";
+    } else {
+      code += "\t*t = " + native_type + "{\n" + kept + "\t}\n";
+    }
+    if (struct_def.fixed) {
+      for (auto it = struct_def.fields.vec.begin();
+           it != struct_def.fields.vec.end(); ++it) {
+        const FieldDef &field = **it;
+        if (!IsStruct(field.value.type)) continue;
+        const std::string field_field = namer_.Field(field);
+        code += "\tif t." + field_field + " != nil {\n";
+        code += "\t\tt." + field_field + ".Reset()\n";
+        code += "\t}\n";
       }
     }
+    code += "}\n\n";
   }
 
-  void GenNativeStructUnPack(const StructDef &struct_def,
-                             std::string *code_ptr) {
+  // Generate a method that copies a table and the data it references into a
+  // Builder, for Builder.Splice.
+  void GenTableSplice(const StructDef &struct_def, std::string *code_ptr) {
     std::string &code = *code_ptr;
+    const std::string struct_type = namer_.Type(struct_def);
+
+    code += "// Splice writes a copy of the " + struct_type +
+            " and the data it references to\n";
+    code += "// builder, and returns its offset, like Pack without unpacking "
+            "it.\n";
+    code += "func (rcv *" + struct_type +
+            ") Splice(builder *flatbuffers.Builder) flatbuffers.UOffsetT {\n";
+    code += "\tif rcv == nil {\n\t\treturn 0\n\t}\n";
+    GenTableCopy(struct_def, false, code_ptr);
+  }
+
+  // Generate the body of Splice, or of ProjectTo if masked, which copies only
+  // the fields selected by mask.
+  void GenTableCopy(const StructDef &struct_def, bool masked,
+                    std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    const std::string struct_type = namer_.Type(struct_def);
 
-    code += "func (rcv *" + namer_.Type(struct_def) + ") UnPackTo(t *" +
-            NativeName(struct_def) + ") {\n";
     for (auto it = struct_def.fields.vec.begin();
          it != struct_def.fields.vec.end(); ++it) {
       const FieldDef &field = **it;
-      if (field.value.type.base_type == BASE_TYPE_STRUCT) {
-        code += "\tt." + namer_.Field(field) + " = rcv." +
-                namer_.Method(field) + "(nil).UnPack()\n";
+      if (field.deprecated) continue;
+      if (IsScalar(field.value.type.base_type)) continue;
+      if (IsStruct(field.value.type)) continue;
+
+      const std::string field_field = namer_.Field(field);
+      const std::string field_var = namer_.Variable(field);
+      const std::string offset = field_var + "Offset";
+      const std::string vtable_offset =
+          "flatbuffers.UOffsetT(rcv._tab.Offset(" +
+          NumToString(field.value.offset) + "))";
+      const std::string selected =
+          masked ? " && " + MaskSelects(field) : "";
+
+      if (IsString(field.value.type)) {
+        code += "\t" + offset + " := flatbuffers.UOffsetT(0)\n";
+        code += "\tif o := " + vtable_offset + "; o != 0" + selected + " {\n";
+        code += "\t\t" + offset +
+                " = builder.CreateByteString(rcv._tab.ByteVector(o + "
+                "rcv._tab.Pos))\n";
+        code += "\t}\n";
+      } else if (IsVector(field.value.type) &&
+                 (IsScalar(field.value.type.element) ||
+                  IsStruct(field.value.type.VectorType()))) {
+        const Type vector_type = field.value.type.VectorType();
+        // A nested flatbuffer keeps the alignment of its largest scalars.
+        const size_t alignment =
+            field.nested_flatbuffer ? 8 : InlineAlignment(vector_type);
+        code += "\t" + offset + " := flatbuffers.UOffsetT(0)\n";
+        code += "\tif o := " + vtable_offset + "; o != 0" + selected + " {\n";
+        code += "\t\t" + offset + " = builder.SpliceVector(&rcv._tab, o, " +
+                NumToString(InlineSize(vector_type)) + ", " +
+                NumToString(alignment) + ")\n";
+        code += "\t}\n";
+      } else if (IsVector(field.value.type)) {
+        const std::string length = field_var + "Length";
+        const std::string offsets = field_var + "Offsets";
+        code += "\t" + offset + " := flatbuffers.UOffsetT(0)\n";
+        code += "\tif " + vtable_offset + " != 0" + selected + " {\n";
+        code += "\t\t" + length + " := rcv." + field_field + "Length()\n";
+        code += "\t\t" + offsets + " := make([]flatbuffers.UOffsetT, " +
+                length + ")\n";
+        code += "\t\tfor j := 0; j < " + length + "; j++ {\n";
+        if (field.value.type.element == BASE_TYPE_STRING) {
+          code += "\t\t\t" + offsets + "[j] = builder.CreateByteString(rcv." +
+                  field_field + "(j))\n";
+        } else if (field.value.type.element == BASE_TYPE_STRUCT) {
+          code += "\t\t\tx := " +
+                  WrapInNameSpaceAndTrack(field.value.type.struct_def,
+                                          field.value.type.struct_def->name) +
+                  "{}\n";
+          code += "\t\t\trcv." + field_field + "(&x, j)\n";
+          code += "\t\t\t" + offsets + "[j] = " +
+                  (masked ? "x.ProjectTo(builder, mask." + field_field + ")"
+                          : "x.Splice(builder)") +
+                  "\n";
+        } else {
+          // TODO(iceboy): Support vector of unions.
+          FLATBUFFERS_ASSERT(0);
+        }
+        code += "\t\t}\n";
+        code += "\t\t" + struct_type + "Start" + namer_.Function(field) +
+                "Vector(builder, " + length + ")\n";
+        code += "\t\tfor j := " + length + " - 1; j >= 0; j-- {\n";
+        code += "\t\t\tbuilder.PrependUOffsetT(" + offsets + "[j])\n";
+        code += "\t\t}\n";
+        code += "\t\t" + offset + " = builder.EndVector(" + length + ")\n";
+        code += "\t}\n";
+      } else if (field.value.type.base_type == BASE_TYPE_STRUCT) {
+        if (masked) {
+          code += "\t" + offset + " := flatbuffers.UOffsetT(0)\n";
+          code += "\tif " + MaskSelects(field) + " {\n";
+          code += "\t\t" + offset + " = rcv." + field_field +
+                  "(nil).ProjectTo(builder, mask." + field_field + ")\n";
+          code += "\t}\n";
+        } else {
+          code += "\t" + offset + " := rcv." + field_field +
+                  "(nil).Splice(builder)\n";
+        }
+      } else if (field.value.type.base_type == BASE_TYPE_UNION) {
+        const std::string field_table = field_var + "Table";
+        code += "\t" + offset + " := flatbuffers.UOffsetT(0)\n";
+        code += "\t" + field_table + " := flatbuffers.Table{}\n";
+        code += "\tif " + (masked ? MaskSelects(field) + " && " : "") +
+                "rcv." + namer_.Method(field) + "(&" + field_table + ") {\n";
+        code += "\t\t" + offset + " = rcv." +
+                namer_.Method(field.name + UnionTypeFieldSuffix()) +
+                "().Splice(builder, " + field_table + ")\n";
+        code += "\t}\n";
       } else {
-        code += "\tt." + namer_.Field(field) + " = rcv." +
-                namer_.Method(field) + "()\n";
+        FLATBUFFERS_ASSERT(0);
       }
     }
-    code += "}\n\n";
-
-    code += "func (rcv *" + namer_.Type(struct_def) + ") UnPack() *" +
+    code += "\t" + struct_type + "Start(builder)\n";
+    for (auto it = struct_def.fields.vec.begin();
+         it != struct_def.fields.vec.end(); ++it) {
+      const FieldDef &field = **it;
+      if (field.deprecated) continue;
+      const std::string field_field = namer_.Field(field);
+      const std::string add = struct_type + "Add" + namer_.Function(field);
+      const std::string selected =
+          masked ? " && " + MaskSelects(field) : "";
+
+      if (field.IsScalarOptional()) {
+        code += "\tif x := rcv." + field_field + "(); x != nil" + selected +
+                " {\n";
+        code += "\t\t" + add + "(builder, *x)\n";
+        code += "\t}\n";
+      } else if (IsScalar(field.value.type.base_type)) {
+        if (masked) {
+          code += "\tif " + MaskSelects(field) + " {\n";
+          code += "\t\t" + add + "(builder, rcv." + field_field + "())\n";
+          code += "\t}\n";
+        } else {
+          code += "\t" + add + "(builder, rcv." + field_field + "())\n";
+        }
+      } else if (IsStruct(field.value.type)) {
+        const StructDef &struct_field = *field.value.type.struct_def;
+        code += "\tif o := flatbuffers.UOffsetT(rcv._tab.Offset(" +
+                NumToString(field.value.offset) + ")); o != 0" + selected +
+                " {\n";
+        code += "\t\t" + add + "(builder, " +
+                ToOffsetType(field.value.type,
+                             "builder.SpliceStruct(&rcv._tab, o, " +
+                                 NumToString(struct_field.bytesize) + ", " +
+                                 NumToString(struct_field.minalign) + ")") +
+                ")\n";
+        code += "\t}\n";
+      } else {
+        code += "\t" + add + "(builder, " +
+                ToOffsetType(field.value.type,
+                             namer_.Variable(field) + "Offset") +
+                ")\n";
+      }
+    }
+    code += "\treturn " + FromOffsetType(struct_type + "End(builder)") + "\n";
+    code += "}\n\n";
+  }
+
+  // Returns the name of the function that makes a random T of struct_def.
+  std::string RandomName(const StructDef &struct_def) {
+    return WrapInNameSpaceAndTrack(&struct_def,
+                                   "Random" + NativeName(struct_def));
+  }
+
+  // Returns an expression taking a random value of type, which is not a
+  // vector or union, from r.
+  std::string RandomValue(const Type &type) {
+    if (type.enum_def != nullptr) {
+      return WrapInNameSpaceAndTrack(type.enum_def,
+                                     "Random" + namer_.Type(*type.enum_def)) +
+             "(r)";
+    }
+    if (IsScalar(type.base_type)) {
+      return "r." + namer_.Method(GenTypeBasic(type)) + "()";
+    }
+    if (IsString(type)) return "r.Text()";
+    return RandomName(*type.struct_def) + "(r)";
+  }
+
+  // Generate a function that takes a random value of an enum from r.
+  void GenEnumRandom(const EnumDef &enum_def, std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    const std::string enum_type = namer_.Type(enum_def);
+    code += "// Random" + enum_type + " returns one of the values of " +
+            enum_type + " from r.\n";
+    code += "func Random" + enum_type + "(r *flatbuffers.Random) " +
+            enum_type + " {\n";
+    code += "\tvalues := " + enum_type + "Values()\n";
+    code += "\treturn values[r.Intn(len(values))]\n";
+    code += "}\n\n";
+  }
+
+  // Generate a function that makes a random member of a union, or none.
+  void GenUnionRandom(const EnumDef &enum_def, std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    const std::string native_type = NativeName(enum_def);
+    code += "// Random" + native_type + " returns a random member of " +
+            namer_.Type(enum_def) + " from r, or nil.\n";
+    code += "func Random" + native_type + "(r *flatbuffers.Random) *" +
+            native_type + " {\n";
+    code += "\tif !r.Child() {\n\t\treturn nil\n\t}\n";
+    int members = 0;
+    for (auto it = enum_def.Vals().begin(); it != enum_def.Vals().end();
+         ++it) {
+      if (!(*it)->IsZero()) members++;
+    }
+    code += "\tswitch r.Intn(" + NumToString(members) + ") {\n";
+    int member = 0;
+    for (auto it = enum_def.Vals().begin(); it != enum_def.Vals().end();
+         ++it) {
+      const EnumVal &ev = **it;
+      if (ev.IsZero()) continue;
+      code += "\tcase " + NumToString(member++) + ":\n";
+      code += "\t\treturn &" + native_type +
+              "{Type: " + namer_.EnumVariant(enum_def, ev) +
+              ", Value: " + RandomValue(ev.union_type) + "}\n";
+    }
+    code += "\t}\n";
+    code += "\treturn nil\n";
+    code += "}\n\n";
+  }
+
+  // Generate a function that makes a T of a table with random fields. Fields
+  // that are not required are set at random, and child tables only above
+  // the maximum depth of r.
+  void GenTableRandom(const StructDef &struct_def, std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    const std::string native_type = NativeName(struct_def);
+
+    code += "// Random" + native_type + " returns a " + native_type +
+            " with random fields from r. Required\n";
+    code += "// fields are always set.\n";
+    code += "func Random" + native_type + "(r *flatbuffers.Random) *" +
+            native_type + " {\n";
+    code += "\tr.Enter()\n";
+    code += "\tdefer r.Leave()\n";
+    code += "\tt := &" + native_type + "{}\n";
+    for (auto it = struct_def.fields.vec.begin();
+         it != struct_def.fields.vec.end(); ++it) {
+      const FieldDef &field = **it;
+      if (field.deprecated) continue;
+      const Type &type = field.value.type;
+      if (type.base_type == BASE_TYPE_UTYPE) continue;
+      const std::string field_field = namer_.Field(field);
+
+      if (field.IsScalarOptional()) {
+        code += "\tif r.Optional() {\n";
+        code += "\t\tv := " + RandomValue(type) + "\n";
+        code += "\t\tt." + field_field + " = &v\n";
+        code += "\t}\n";
+        continue;
+      }
+      if (IsScalar(type.base_type) || IsString(type)) {
+        code += "\tt." + field_field + " = " + RandomValue(type) + "\n";
+        continue;
+      }
+      if (type.base_type == BASE_TYPE_UNION) {
+        code += "\tt." + field_field + " = " +
+                WrapInNameSpaceAndTrack(type.enum_def,
+                                        "Random" + NativeName(*type.enum_def)) +
+                "(r)\n";
+        continue;
+      }
+
+      // Vectors, structs and tables are set at random unless required, and
+      // tables not below the maximum depth.
+      const bool table =
+          field.nested_flatbuffer ||
+          (type.struct_def != nullptr && !type.struct_def->fixed);
+      std::string indent = "\t";
+      if (!field.IsRequired()) {
+        code += std::string("\tif ") +
+                (table ? "r.Child()" : "r.Optional()") + " {\n";
+        indent += "\t";
+      }
+      if (field.nested_flatbuffer) {
+        code += indent + "t." + field_field + " = " +
+                RandomName(*field.nested_flatbuffer) + "(r)\n";
+      } else if (IsVector(type)) {
+        code += indent + "t." + field_field + " = make(" + NativeType(type) +
+                ", r.Length())\n";
+        code += indent + "for j := range t." + field_field + " {\n";
+        code += indent + "\tt." + field_field +
+                "[j] = " + RandomValue(type.VectorType()) + "\n";
+        code += indent + "}\n";
+      } else {
+        code += indent + "t." + field_field + " = " + RandomValue(type) + "\n";
+      }
+      if (!field.IsRequired()) code += "\t}\n";
+    }
+    code += "\treturn t\n";
+    code += "}\n\n";
+  }
+
+  // Generate a function that makes a T of a struct with random fields.
+  void GenStructRandom(const StructDef &struct_def, std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    const std::string native_type = NativeName(struct_def);
+
+    code += "// Random" + native_type + " returns a " + native_type +
+            " with random fields from r.\n";
+    code += "func Random" + native_type + "(r *flatbuffers.Random) *" +
+            native_type + " {\n";
+    code += "\treturn &" + native_type + "{\n";
+    for (auto it = struct_def.fields.vec.begin();
+         it != struct_def.fields.vec.end(); ++it) {
+      const FieldDef &field = **it;
+      if (field.deprecated) continue;
+      code += "\t\t" + namer_.Field(field) + ": " +
+              RandomValue(field.value.type) + ",\n";
+    }
+    code += "\t}\n";
+    code += "}\n\n";
+  }
+
+  // Returns the name of the mask type of a table.
+  std::string MaskName(const StructDef &struct_def) {
+    return namer_.Type(struct_def) + "Mask";
+  }
+
+  // Returns the condition that mask selects field. The type field of a union
+  // is selected with the union.
+  std::string MaskSelects(const FieldDef &field) {
+    const FieldDef *selector = &field;
+    if (field.value.type.base_type == BASE_TYPE_UTYPE) {
+      selector = field.sibling_union_field;
+    }
+    const std::string condition = "mask." + namer_.Field(*selector);
+    return IsTableField(*selector) ? condition + " != nil" : condition;
+  }
+
+  // Whether field is a table or a vector of tables, which a mask selects
+  // with the mask of the table.
+  static bool IsTableField(const FieldDef &field) {
+    const Type &type = field.value.type;
+    return (type.base_type == BASE_TYPE_STRUCT && !type.struct_def->fixed) ||
+           (IsVector(type) && type.element == BASE_TYPE_STRUCT &&
+            !type.struct_def->fixed);
+  }
+
+  // Generate the mask type of a table, which selects its fields, and the
+  // UnPackWithMask and ProjectTo methods that take it.
+  void GenTableMask(const StructDef &struct_def, std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    const std::string struct_type = namer_.Type(struct_def);
+    const std::string mask_type = MaskName(struct_def);
+    const std::string native_type = NativeName(struct_def);
+
+    code += "// " + mask_type + " selects fields of a " + struct_type +
+            " for UnPackWithMask and ProjectTo.\n";
+    code += "// A table, or a vector of tables, is selected by a mask of the "
+            "fields of its\n";
+    code += "// tables. All selects every field, including those of nested "
+            "tables, and a\n";
+    code += "// nil mask selects none.\n";
+    code += "type " + mask_type + " struct {\n";
+    code += "\tAll bool\n";
+    for (auto it = struct_def.fields.vec.begin();
+         it != struct_def.fields.vec.end(); ++it) {
+      const FieldDef &field = **it;
+      if (field.deprecated) continue;
+      if (field.value.type.base_type == BASE_TYPE_UTYPE) continue;
+      code += "\t" + namer_.Field(field) + " ";
+      if (IsTableField(field)) {
+        const StructDef &child = *field.value.type.struct_def;
+        code += "*" + WrapInNameSpaceAndTrack(&child, MaskName(child));
+      } else {
+        code += "bool";
+      }
+      code += "\n";
+    }
+    code += "}\n\n";
+
+    code += "// UnPackWithMask unpacks the fields of rcv selected by mask into a "
+            "new\n";
+    code += "// " + native_type + ", leaving the others zero.\n";
+    code += "func (rcv *" + struct_type + ") UnPackWithMask(mask *" +
+            mask_type + ") *" + native_type + " {\n";
+    code += "\tif rcv == nil {\n\t\treturn nil\n\t}\n";
+    code += "\tif mask == nil {\n\t\tmask = &" + mask_type + "{}\n\t}\n";
+    code += "\tif mask.All {\n\t\treturn rcv.UnPack()\n\t}\n";
+    code += "\tt := &" + native_type + "{}\n";
+    for (auto it = struct_def.fields.vec.begin();
+         it != struct_def.fields.vec.end(); ++it) {
+      const FieldDef &field = **it;
+      if (field.deprecated) continue;
+      const Type &type = field.value.type;
+      if (type.base_type == BASE_TYPE_UTYPE) continue;
+      const std::string field_field = namer_.Field(field);
+      const std::string field_var = namer_.Variable(field);
+
+      code += "\tif " + MaskSelects(field) + " {\n";
+      if (IsScalar(type.base_type)) {
+        code += "\t\tt." + field_field + " = rcv." + field_field + "()\n";
+      } else if (field.nested_flatbuffer) {
+        code += "\t\tt." + field_field + " = rcv." + field_field +
+                "NestedRoot().UnPack()\n";
+      } else if (IsString(type)) {
+        code += "\t\tt." + field_field + " = string(rcv." + field_field +
+                "())\n";
+      } else if (IsVector(type) && type.element == BASE_TYPE_UCHAR &&
+                 type.enum_def == nullptr) {
+        code += "\t\tt." + field_field + " = rcv." + field_field +
+                "Bytes()\n";
+      } else if (IsVector(type)) {
+        const std::string length = field_var + "Length";
+        code += "\t\t" + length + " := rcv." + field_field + "Length()\n";
+        code += "\t\tt." + field_field + " = make(" + NativeType(type) +
+                ", " + length + ")\n";
+        code += "\t\tfor j := 0; j < " + length + "; j++ {\n";
+        if (IsScalar(type.element)) {
+          code += "\t\t\tt." + field_field + "[j] = rcv." + field_field +
+                  "(j)\n";
+        } else if (type.element == BASE_TYPE_STRING) {
+          code += "\t\t\tt." + field_field + "[j] = string(rcv." +
+                  field_field + "(j))\n";
+        } else if (type.element == BASE_TYPE_STRUCT) {
+          const StructDef &element = *type.struct_def;
+          code += "\t\t\tx := " +
+                  WrapInNameSpaceAndTrack(&element, element.name) + "{}\n";
+          code += "\t\t\trcv." + field_field + "(&x, j)\n";
+          code += "\t\t\tt." + field_field + "[j] = " +
+                  (element.fixed ? "x.UnPack()"
+                                 : "x.UnPackWithMask(mask." + field_field +
+                                       ")") +
+                  "\n";
+        } else {
+          // TODO(iceboy): Support vector of unions.
+          FLATBUFFERS_ASSERT(0);
+        }
+        code += "\t\t}\n";
+      } else if (type.base_type == BASE_TYPE_STRUCT) {
+        code += "\t\tt." + field_field + " = rcv." + field_field + "(nil)." +
+                (type.struct_def->fixed
+                     ? "UnPack()"
+                     : "UnPackWithMask(mask." + field_field + ")") +
+                "\n";
+      } else if (type.base_type == BASE_TYPE_UNION) {
+        const std::string field_table = field_var + "Table";
+        code += "\t\t" + field_table + " := flatbuffers.Table{}\n";
+        code += "\t\tif rcv." + namer_.Method(field) + "(&" + field_table +
+                ") {\n";
+        code += "\t\t\tt." + field_field + " = rcv." +
+                namer_.Method(field.name + UnionTypeFieldSuffix()) +
+                "().UnPack(" + field_table + ")\n";
+        code += "\t\t}\n";
+      }
+      code += "\t}\n";
+    }
+    code += "\treturn t\n";
+    code += "}\n\n";
+
+    code += "// ProjectTo writes a copy of the fields of the " + struct_type +
+            " selected by mask, and\n";
+    code += "// the data they reference, to builder, and returns its offset. "
+            "Dropping a\n";
+    code += "// required field records an error in builder.\n";
+    code += "func (rcv *" + struct_type +
+            ") ProjectTo(builder *flatbuffers.Builder, mask *" + mask_type +
+            ") flatbuffers.UOffsetT {\n";
+    code += "\tif rcv == nil {\n\t\treturn 0\n\t}\n";
+    code += "\tif mask == nil {\n\t\tmask = &" + mask_type + "{}\n\t}\n";
+    code += "\tif mask.All {\n\t\treturn rcv.Splice(builder)\n\t}\n";
+    GenTableCopy(struct_def, true, code_ptr);
+  }
+
+  // Generate a method that copies the member of a union, for the Splice
+  // methods of the tables that hold it.
+  void GenUnionSplice(const EnumDef &enum_def, std::string *code_ptr) {
+    std::string &code = *code_ptr;
+
+    code += "// Splice writes a copy of the member of the union in table to "
+            "builder, and\n";
+    code += "// returns its offset.\n";
+    code += "func (rcv " + namer_.Type(enum_def) +
+            ") Splice(builder *flatbuffers.Builder, table flatbuffers.Table) "
+            "flatbuffers.UOffsetT {\n";
+    code += "\tswitch rcv {\n";
+    for (auto it = enum_def.Vals().begin(); it != enum_def.Vals().end();
+         ++it) {
+      const EnumVal &ev = **it;
+      if (ev.IsZero()) continue;
+      code += "\tcase " + namer_.EnumVariant(enum_def, ev) + ":\n";
+      code += "\t\tvar x " +
+              WrapInNameSpaceAndTrack(ev.union_type.struct_def,
+                                      ev.union_type.struct_def->name) +
+              "\n";
+      code += "\t\tx.Init(table.Bytes, table.Pos)\n";
+      code += "\t\treturn x.Splice(builder)\n";
+    }
+    code += "\t}\n";
+    code += "\treturn 0\n";
+    code += "}\n\n";
+  }
+
+  // Generate object API setters that store the hash of a string in each
+  // hashed field.
+  void GenNativeTableHashSetters(const StructDef &struct_def,
+                                 std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    for (auto it = struct_def.fields.vec.begin();
+         it != struct_def.fields.vec.end(); ++it) {
+      const FieldDef &field = **it;
+      if (field.deprecated || !IsHashed(field)) continue;
+      const std::string field_field = namer_.Field(field);
+
+      code += "func (t *" + NativeName(struct_def) + ") Set" + field_field;
+      if (IsVector(field.value.type)) {
+        const Type element = field.value.type.VectorType();
+        code += "FromStrings(s []string) {\n";
+        code += "\tt." + field_field + " = make(" +
+                NativeType(field.value.type) + ", len(s))\n";
+        code += "\tfor j := range s {\n";
+        code += "\t\tt." + field_field + "[j] = " +
+                HashedValue(field, element, "s[j]") + "\n";
+        code += "\t}\n";
+      } else {
+        code += "FromString(s string) {\n";
+        if (field.IsScalarOptional()) {
+          code += "\tv := " + HashedValue(field, field.value.type, "s") + "\n";
+          code += "\tt." + field_field + " = &v\n";
+        } else {
+          code += "\tt." + field_field + " = " +
+                  HashedValue(field, field.value.type, "s") + "\n";
+        }
+      }
+      code += "}\n\n";
+    }
+  }
+
+  void GenNativeStructPack(const StructDef &struct_def, std::string *code_ptr) {
+    std::string &code = *code_ptr;
+
+    code += "func (t *" + NativeName(struct_def) +
+            ") Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {\n";
+    code += "\tif t == nil {\n\t\treturn 0\n\t}\n";
+    std::string create = "Create" + namer_.Type(struct_def) + "(builder";
+    StructPackArgs(struct_def, "", &create);
+    create += ")";
+    code += "\treturn " + FromOffsetType(create) + "\n";
+    code += "}\n";
+  }
+
+  void StructPackArgs(const StructDef &struct_def, const char *nameprefix,
+                      std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    for (auto it = struct_def.fields.vec.begin();
+         it != struct_def.fields.vec.end(); ++it) {
+      const FieldDef &field = **it;
+      if (field.value.type.base_type == BASE_TYPE_STRUCT) {
+        StructPackArgs(*field.value.type.struct_def,
+                       (nameprefix + namer_.Field(field) + ".").c_str(),
+                       code_ptr);
+      } else {
+        code += std::string(", t.") + nameprefix + namer_.Field(field);
+      }
+    }
+  }
+
+  void GenNativeStructUnPack(const StructDef &struct_def,
+                             std::string *code_ptr) {
+    std::string &code = *code_ptr;
+
+    code += "func (rcv *" + namer_.Type(struct_def) + ") UnPackTo(t *" +
+            NativeName(struct_def) + ") {\n";
+    for (auto it = struct_def.fields.vec.begin();
+         it != struct_def.fields.vec.end(); ++it) {
+      const FieldDef &field = **it;
+      if (field.value.type.base_type == BASE_TYPE_STRUCT) {
+        const StructDef &child = *field.value.type.struct_def;
+        code += "\tif t." + namer_.Field(field) + " == nil {\n";
+        code += "\t\tt." + namer_.Field(field) + " = &" +
+                WrapInNameSpaceAndTrack(&child, NativeName(child)) + "{}\n";
+        code += "\t}\n";
+        code += "\trcv." + namer_.Method(field) + "(nil).UnPackTo(t." +
+                namer_.Field(field) + ")\n";
+      } else {
+        code += "\tt." + namer_.Field(field) + " = rcv." +
+                namer_.Method(field) + "()\n";
+      }
+    }
+    code += "}\n\n";
+
+    code += "func (rcv *" + namer_.Type(struct_def) + ") UnPack() *" +
             NativeName(struct_def) + " {\n";
     code += "\tif rcv == nil {\n\t\treturn nil\n\t}\n";
     code += "\tt := &" + NativeName(struct_def) + "{}\n";
     code += "\trcv.UnPackTo(t)\n";
     code += "\treturn t\n";
     code += "}\n\n";
+
+    GenNativeReset(struct_def, code_ptr);
   }
 
   // Generate enum declarations.
@@ -1355,6 +2442,12 @@ class GoGenerator : public BaseGenerator {
     EndEnumValues(code_ptr);
 
     EnumStringer(enum_def, code_ptr);
+    EnumValidation(enum_def, code_ptr);
+    if (enum_def.attributes.Lookup("bit_flags")) {
+      needs_strings_import_ = true;
+      EnumFlags(enum_def, code_ptr);
+    }
+    EnumText(enum_def, code_ptr);
   }
 
   // Returns the function name that is able to read a value of the given type.
@@ -1403,6 +2496,55 @@ class GoGenerator : public BaseGenerator {
     return IsScalar(type.base_type) ? GenTypeBasic(type) : GenTypePointer(type);
   }
 
+  // Returns the T of the flatbuffers.Offset[T] that refers to a value of type.
+  std::string OffsetTarget(const Type &type) {
+    switch (type.base_type) {
+      case BASE_TYPE_STRING: return "flatbuffers.String";
+      case BASE_TYPE_UNION: return GetEnumTypeName(*type.enum_def);
+      case BASE_TYPE_VECTOR: {
+        const Type element = type.VectorType();
+        if (IsScalar(element.base_type) || IsStruct(element)) {
+          return "flatbuffers.Vector[" + GenTypeGet(element) + "]";
+        }
+        return "flatbuffers.Vector[flatbuffers.Offset[" +
+               OffsetTarget(element) + "]]";
+      }
+      default:
+        return WrapInNameSpaceAndTrack(type.struct_def, type.struct_def->name);
+    }
+  }
+
+  // Returns the type of the offsets of type taken and returned by the builder
+  // functions, which is flatbuffers.Offset[T] with --go-typed-offsets.
+  std::string OffsetType(const Type &type) {
+    if (!parser_.opts.go_typed_offsets) return "flatbuffers.UOffsetT";
+    return "flatbuffers.Offset[" + OffsetTarget(type) + "]";
+  }
+
+  std::string OffsetType(const StructDef &struct_def) {
+    Type type(BASE_TYPE_STRUCT, const_cast<StructDef *>(&struct_def));
+    return OffsetType(type);
+  }
+
+  // Returns value, a flatbuffers.UOffsetT, converted to OffsetType(type).
+  std::string ToOffsetType(const Type &type, const std::string &value) {
+    if (!parser_.opts.go_typed_offsets) return value;
+    return OffsetType(type) + "(" + value + ")";
+  }
+
+  std::string ToOffsetType(const StructDef &struct_def,
+                           const std::string &value) {
+    if (!parser_.opts.go_typed_offsets) return value;
+    return OffsetType(struct_def) + "(" + value + ")";
+  }
+
+  // Returns value, returned by a builder function, converted to a
+  // flatbuffers.UOffsetT, for the object API.
+  std::string FromOffsetType(const std::string &value) {
+    if (!parser_.opts.go_typed_offsets) return value;
+    return "flatbuffers.UOffsetT(" + value + ")";
+  }
+
   std::string TypeName(const FieldDef &field) {
     std::string prefix;
     if (field.IsScalarOptional()) { prefix = "*"; }
@@ -1429,6 +2571,24 @@ class GoGenerator : public BaseGenerator {
     }
   }
 
+  static bool IsHashed(const FieldDef &field) {
+    return field.attributes.Lookup("hash") != nullptr;
+  }
+
+  // Returns an expression hashing the string `value` with the algorithm named
+  // by the field's hash attribute, converted to `type`.
+  std::string HashedValue(const FieldDef &field, const Type &type,
+                          const std::string &value) {
+    // "fnv1a_32" maps to flathash.Fnv1aHash32.
+    const std::string &algorithm = field.attributes.Lookup("hash")->constant;
+    const size_t sep = algorithm.find('_');
+    std::string function = algorithm.substr(0, sep);
+    function[0] = CharToUpper(function[0]);
+    function += "Hash" + algorithm.substr(sep + 1);
+    needs_flathash_import_ = true;
+    return GenTypeGet(type) + "(flathash." + function + "(" + value + "))";
+  }
+
   std::string GenConstant(const FieldDef &field) {
     if (field.IsScalarOptional()) { return "nil"; }
     switch (field.value.type.base_type) {
@@ -1485,14 +2645,313 @@ class GoGenerator : public BaseGenerator {
     return std::string();
   }
 
+  // The object API type of a table field. nested_flatbuffer fields hold the
+  // unpacked nested root rather than its bytes.
+  std::string NativeFieldType(const FieldDef &field) {
+    if (field.nested_flatbuffer) {
+      return "*" + WrapInNameSpaceAndTrack(field.nested_flatbuffer,
+                                           NativeName(*field.nested_flatbuffer));
+    }
+    return NativeType(field.value.type);
+  }
+
   // Create a struct with a builder and the struct's arguments.
   void GenStructBuilder(const StructDef &struct_def, std::string *code_ptr) {
     BeginBuilderArgs(struct_def, code_ptr);
     StructBuilderArgs(struct_def, "", code_ptr);
-    EndBuilderArgs(code_ptr);
+    EndBuilderArgs(struct_def, code_ptr);
 
     StructBuilderBody(struct_def, "", code_ptr);
-    EndBuilderBody(code_ptr);
+    EndBuilderBody(struct_def, code_ptr);
+  }
+
+  // A method of a service, as named in its net/http code.
+  struct HTTPMethod {
+    std::string name, request, response, path, stream, stream_desc;
+    bool client_streams, server_streams;
+  };
+
+  static std::string HTTPStreaming(const RPCCall &call) {
+    auto streaming = call.attributes.Lookup("streaming");
+    return streaming ? streaming->constant : "";
+  }
+
+  static bool HTTPClientStreams(const RPCCall &call) {
+    return HTTPStreaming(call) == "client" || HTTPStreaming(call) == "bidi";
+  }
+
+  static bool HTTPServerStreams(const RPCCall &call) {
+    return HTTPStreaming(call) == "server" || HTTPStreaming(call) == "bidi";
+  }
+
+  static std::string HTTPStreamDesc(const RPCCall &call) {
+    std::string desc = "flathttp.StreamDesc{";
+    if (HTTPServerStreams(call)) desc += "ServerStreams: true";
+    if (HTTPServerStreams(call) && HTTPClientStreams(call)) desc += ", ";
+    if (HTTPClientStreams(call)) desc += "ClientStreams: true";
+    return desc + "}";
+  }
+
+  // Generates the net/http client and server of a service, which follow the
+  // API of the gRPC ones and use the flathttp runtime package.
+  void GenHTTPService(const ServiceDef &service_def, std::string *code_ptr) {
+    std::string &code = *code_ptr;
+    cur_name_space_ = service_def.defined_namespace;
+    const std::string service = service_def.name;
+    const std::string unexported = ToLowerFirst(service);
+    const std::string path_prefix =
+        "/" +
+        service_def.defined_namespace->GetFullyQualifiedName(service_def.name) +
+        "/";
+    const auto &calls = service_def.calls.vec;
+
+    std::vector<HTTPMethod> methods;
+    for (auto it = calls.begin(); it != calls.end(); ++it) {
+      const RPCCall &call = **it;
+      HTTPMethod m;
+      m.name = call.name;
+      m.name[0] = CharToUpper(m.name[0]);
+      m.request = WrapInNameSpaceAndTrack(call.request, call.request->name);
+      m.response = WrapInNameSpaceAndTrack(call.response, call.response->name);
+      m.path = path_prefix + m.name;
+      m.stream = service + "_" + m.name;
+      m.client_streams = HTTPClientStreams(call);
+      m.server_streams = HTTPServerStreams(call);
+      m.stream_desc = HTTPStreamDesc(call);
+      methods.push_back(m);
+    }
+
+    // Client interface.
+    code += "// " + service + "HTTPClient is the client API of the " +
+            service + " service over\n// HTTP.\n";
+    code += "type " + service + "HTTPClient interface {\n";
+    for (auto it = methods.begin(); it != methods.end(); ++it) {
+      code += "\t" + HTTPClientSignature(*it) + "\n";
+    }
+    code += "}\n\n";
+
+    code += "type " + unexported + "HTTPClient struct {\n";
+    code += "\tcc *flathttp.Client\n";
+    code += "}\n\n";
+
+    code += "func New" + service + "HTTPClient(cc *flathttp.Client) " +
+            service + "HTTPClient {\n";
+    code += "\treturn &" + unexported + "HTTPClient{cc}\n";
+    code += "}\n\n";
+
+    for (auto it = methods.begin(); it != methods.end(); ++it) {
+      const HTTPMethod &m = *it;
+      const std::string stream_type =
+          unexported + m.name + "HTTPClient";
+      code += "func (c *" + unexported + "HTTPClient) " +
+              HTTPClientSignature(m) + " {\n";
+      if (!m.client_streams && !m.server_streams) {
+        code += "\tout := new(" + m.response + ")\n";
+        code += "\terr := c.cc.Invoke(ctx, \"" + m.path + "\", in, out)\n";
+        code += "\tif err != nil {\n\t\treturn nil, err\n\t}\n";
+        code += "\treturn out, nil\n";
+        code += "}\n\n";
+        continue;
+      }
+      code += "\tstream, err := c.cc.NewStream(ctx, &" +
+              m.stream_desc + ", \"" + m.path +
+              "\")\n";
+      code += "\tif err != nil {\n\t\treturn nil, err\n\t}\n";
+      code += "\tx := &" + stream_type + "{stream}\n";
+      if (!m.client_streams) {
+        code += "\tif err := x.ClientStream.SendMsg(in); err != nil {\n";
+        code += "\t\treturn nil, err\n\t}\n";
+        code += "\tif err := x.ClientStream.CloseSend(); err != nil {\n";
+        code += "\t\treturn nil, err\n\t}\n";
+      }
+      code += "\treturn x, nil\n";
+      code += "}\n\n";
+
+      code += "type " + m.stream + "HTTPClient interface {\n";
+      if (m.client_streams) {
+        code += "\tSend(*flatbuffers.Builder) error\n";
+      }
+      if (m.server_streams) {
+        code += "\tRecv() (*" + m.response + ", error)\n";
+      } else {
+        code += "\tCloseAndRecv() (*" + m.response + ", error)\n";
+      }
+      code += "\tflathttp.ClientStream\n";
+      code += "}\n\n";
+
+      code += "type " + stream_type + " struct {\n";
+      code += "\tflathttp.ClientStream\n";
+      code += "}\n\n";
+
+      if (m.client_streams) {
+        code += "func (x *" + stream_type +
+                ") Send(m *flatbuffers.Builder) error {\n";
+        code += "\treturn x.ClientStream.SendMsg(m)\n";
+        code += "}\n\n";
+      }
+      code += "func (x *" + stream_type + ") " +
+              (m.server_streams ? "Recv" : "CloseAndRecv") + "() (*" +
+              m.response + ", error) {\n";
+      if (!m.server_streams) {
+        code += "\tif err := x.ClientStream.CloseSend(); err != nil {\n";
+        code += "\t\treturn nil, err\n\t}\n";
+      }
+      code += "\tm := new(" + m.response + ")\n";
+      code += "\tif err := x.ClientStream.RecvMsg(m); err != nil {\n";
+      code += "\t\treturn nil, err\n\t}\n";
+      code += "\treturn m, nil\n";
+      code += "}\n\n";
+    }
+
+    // Server interface.
+    code += "// " + service + "HTTPServer is the server API of the " +
+            service + " service over\n// HTTP.\n";
+    code += "type " + service + "HTTPServer interface {\n";
+    for (auto it = methods.begin(); it != methods.end(); ++it) {
+      const HTTPMethod &m = *it;
+      if (!m.client_streams && !m.server_streams) {
+        code += "\t" + m.name + "(context.Context, *" + m.request +
+                ") (*flatbuffers.Builder, error)\n";
+      } else if (!m.client_streams) {
+        code += "\t" + m.name + "(*" + m.request + ", " + m.stream +
+                "HTTPServer) error\n";
+      } else {
+        code += "\t" + m.name + "(" + m.stream + "HTTPServer) error\n";
+      }
+    }
+    code += "}\n\n";
+
+    code += "// New" + service +
+            "HTTPHandler returns a handler serving srv's methods at\n// " +
+            path_prefix + "<Method>.\n";
+    code += "func New" + service + "HTTPHandler(srv " + service +
+            "HTTPServer) http.Handler {\n";
+    code += "\tmux := http.NewServeMux()\n";
+    for (auto it = methods.begin(); it != methods.end(); ++it) {
+      const HTTPMethod &m = *it;
+      const std::string stream_type =
+          unexported + m.name + "HTTPServer";
+      code += "\tmux.Handle(\"" + m.path + "\", ";
+      if (!m.client_streams && !m.server_streams) {
+        code +=
+            "flathttp.UnaryHandler(func(ctx context.Context, dec "
+            "func(interface{}) error) (interface{}, error) {\n";
+        code += "\t\tin := new(" + m.request + ")\n";
+        code += "\t\tif err := dec(in); err != nil {\n";
+        code += "\t\t\treturn nil, err\n\t\t}\n";
+        code += "\t\treturn srv." + m.name + "(ctx, in)\n";
+      } else {
+        code += "flathttp.StreamHandler(" + m.stream_desc +
+                ", func(stream flathttp.ServerStream) error {\n";
+        if (!m.client_streams) {
+          code += "\t\tm := new(" + m.request + ")\n";
+          code += "\t\tif err := stream.RecvMsg(m); err != nil {\n";
+          code += "\t\t\treturn err\n\t\t}\n";
+          code += "\t\treturn srv." + m.name + "(m, &" + stream_type +
+                  "{stream})\n";
+        } else {
+          code += "\t\treturn srv." + m.name + "(&" + stream_type +
+                  "{stream})\n";
+        }
+      }
+      code += "\t}))\n";
+    }
+    code += "\treturn mux\n";
+    code += "}\n\n";
+
+    for (auto it = methods.begin(); it != methods.end(); ++it) {
+      const HTTPMethod &m = *it;
+      if (!m.client_streams && !m.server_streams) continue;
+      const std::string stream_type =
+          unexported + m.name + "HTTPServer";
+      const std::string send = m.server_streams ? "Send" : "SendAndClose";
+
+      code += "type " + m.stream + "HTTPServer interface {\n";
+      code += "\t" + send + "(*flatbuffers.Builder) error\n";
+      if (m.client_streams) {
+        code += "\tRecv() (*" + m.request + ", error)\n";
+      }
+      code += "\tflathttp.ServerStream\n";
+      code += "}\n\n";
+
+      code += "type " + stream_type + " struct {\n";
+      code += "\tflathttp.ServerStream\n";
+      code += "}\n\n";
+
+      code += "func (x *" + stream_type + ") " + send +
+              "(m *flatbuffers.Builder) error {\n";
+      code += "\treturn x.ServerStream.SendMsg(m)\n";
+      code += "}\n\n";
+
+      if (m.client_streams) {
+        code += "func (x *" + stream_type + ") Recv() (*" + m.request +
+                ", error) {\n";
+        code += "\tm := new(" + m.request + ")\n";
+        code += "\tif err := x.ServerStream.RecvMsg(m); err != nil {\n";
+        code += "\t\treturn nil, err\n\t}\n";
+        code += "\treturn m, nil\n";
+        code += "}\n\n";
+      }
+    }
+  }
+
+  static std::string HTTPClientSignature(const HTTPMethod &m) {
+    std::string signature = m.name + "(ctx context.Context";
+    if (!m.client_streams) signature += ", in *flatbuffers.Builder";
+    signature += ") (";
+    if (!m.client_streams && !m.server_streams) {
+      signature += "*" + m.response;
+    } else {
+      signature += m.stream + "HTTPClient";
+    }
+    return signature + ", error)";
+  }
+
+  static std::string ToLowerFirst(std::string s) {
+    if (!s.empty()) s[0] = CharToLower(s[0]);
+    return s;
+  }
+
+  // Save out the net/http code of a service.
+  bool SaveHTTPService(const ServiceDef &def, const std::string &classcode) {
+    Namespace &ns = go_namespace_.components.empty() ? *def.defined_namespace
+                                                     : go_namespace_;
+    std::string code =
+        "// Code generated by the FlatBuffers compiler. DO NOT EDIT.\n\n";
+    code += "package " +
+            (ns.components.empty() ? def.name : LastNamespacePart(ns)) +
+            "\n\n";
+    const std::string flatbuffers_import =
+        parser_.opts.go_import.empty() ? "github.com/google/flatbuffers/go"
+                                       : parser_.opts.go_import;
+    code += "import (\n";
+    code += "\t\"context\"\n";
+    code += "\t\"net/http\"\n\n";
+    code += "\tflatbuffers \"" + flatbuffers_import + "\"\n";
+    code += "\tflathttp \"" + flatbuffers_import + "/flathttp\"\n";
+    if (tracked_imported_namespaces_.size() > 0) {
+      code += "\n";
+      for (auto it = tracked_imported_namespaces_.begin();
+           it != tracked_imported_namespaces_.end(); ++it) {
+        if ((*it)->defined_namespace->components.empty()) {
+          code += "\t" + (*it)->name + " \"" + (*it)->name + "\"\n";
+        } else {
+          code += "\t" + NamespaceImportName((*it)->defined_namespace) +
+                  " \"" + NamespaceImportPath((*it)->defined_namespace) +
+                  "\"\n";
+        }
+      }
+    }
+    code += ")\n\n";
+    code += classcode;
+    // Strip extra newlines at end of file to make it gofmt-clean.
+    while (code.length() > 2 && code.substr(code.length() - 2) == "\n\n") {
+      code.pop_back();
+    }
+    std::string directory = namer_.Directories(ns);
+    EnsureDirExists(directory);
+    std::string filename = directory + def.name + "_http.go";
+    return SaveFile(filename.c_str(), code, false);
   }
 
   // Begin by declaring namespace and imports.
@@ -1506,14 +2965,18 @@ class GoGenerator : public BaseGenerator {
       code += "import (\n";
       // standard imports, in alphabetical order for go fmt
       if (needs_bytes_import_) code += "\t\"bytes\"\n";
-      if (!parser_.opts.go_import.empty()) {
-        code += "\tflatbuffers \"" + parser_.opts.go_import + "\"\n";
-      } else {
-        code += "\tflatbuffers \"github.com/google/flatbuffers/go\"\n";
+      if (is_enum) { code += "\t\"errors\"\n"; }
+      const std::string flatbuffers_import =
+          parser_.opts.go_import.empty() ? "github.com/google/flatbuffers/go"
+                                         : parser_.opts.go_import;
+      code += "\tflatbuffers \"" + flatbuffers_import + "\"\n";
+      if (needs_flathash_import_) {
+        code += "\tflathash \"" + flatbuffers_import + "/flathash\"\n";
       }
       // math is needed to support non-finite scalar default values.
       if (needs_math_import_) { code += "\t\"math\"\n"; }
       if (is_enum) { code += "\t\"strconv\"\n"; }
+      if (needs_strings_import_) { code += "\t\"strings\"\n"; }
 
       if (tracked_imported_namespaces_.size() > 0) {
         code += "\n";
@@ -1530,7 +2993,13 @@ class GoGenerator : public BaseGenerator {
       }
       code += ")\n\n";
     } else {
-      if (is_enum) { code += "import \"strconv\"\n\n"; }
+      if (is_enum) {
+        code += "import (\n";
+        code += "\t\"errors\"\n";
+        code += "\t\"strconv\"\n";
+        if (needs_strings_import_) { code += "\t\"strings\"\n"; }
+        code += ")\n\n";
+      }
       if (needs_math_import_) {
         // math is needed to support non-finite scalar default values.
         code += "import \"math\"\n\n";
@@ -1542,7 +3011,9 @@ class GoGenerator : public BaseGenerator {
   void ResetImports() {
     tracked_imported_namespaces_.clear();
     needs_bytes_import_ = false;
+    needs_flathash_import_ = false;
     needs_math_import_ = false;
+    needs_strings_import_ = false;
   }
 
   // Save out the generated code for a Go Table type.
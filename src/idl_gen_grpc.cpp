@@ -16,6 +16,8 @@
 
 // independent from idl_parser, since this code is not needed for most clients
 
+#include <set>
+
 #include "flatbuffers/code_generators.h"
 #include "flatbuffers/flatbuffers.h"
 #include "flatbuffers/idl.h"
@@ -308,22 +310,65 @@ class GoGRPCGenerator : public flatbuffers::BaseGenerator {
     FlatBufFile file(parser_, file_name_, FlatBufFile::kLanguageGo);
     grpc_go_generator::Parameters p;
     p.custom_method_io_type = "flatbuffers.Builder";
+    p.typed_stubs = parser_.opts.go_grpc_typed;
+    p.object_api = parser_.opts.generate_object_based_api;
+    // Typed message types are declared once per package, by the first
+    // service that uses them.
+    std::set<std::string> typed_messages;
     for (int i = 0; i < file.service_count(); i++) {
       auto service = file.service(i);
-      const Definition *def = parser_.services_.vec[i];
+      const ServiceDef *def = parser_.services_.vec[i];
       p.package_name = LastNamespacePart(*(def->defined_namespace));
       p.service_prefix =
           def->defined_namespace->GetFullyQualifiedName("");  // file.package();
+      p.typed_messages.clear();
+      for (auto it = def->calls.vec.begin(); it != def->calls.vec.end(); ++it) {
+        const StructDef *tables[] = { (*it)->request, (*it)->response };
+        for (auto table : tables) {
+          if (typed_messages.insert(p.package_name + "." + table->name)
+                  .second) {
+            p.typed_messages.push_back(TypedMessageOf(*table));
+          }
+        }
+      }
       std::string output =
           grpc_go_generator::GenerateServiceSource(&file, service.get(), &p);
       std::string filename =
           NamespaceDir(*def->defined_namespace) + def->name + "_grpc.go";
       if (!flatbuffers::SaveFile(filename.c_str(), output, false)) return false;
+      if (parser_.opts.go_grpc_fakes) {
+        output = grpc_go_generator::GenerateFakeSource(&file, service.get(), &p);
+        filename = NamespaceDir(*def->defined_namespace) + def->name +
+                   "_grpc_fake.go";
+        if (!flatbuffers::SaveFile(filename.c_str(), output, false)) {
+          return false;
+        }
+      }
     }
     return true;
   }
 
  protected:
+  // Returns what the typed stubs check of the buffers of table.
+  grpc_go_generator::TypedMessage TypedMessageOf(
+      const StructDef &table) const {
+    grpc_go_generator::TypedMessage message;
+    message.name = table.name;
+    if (parser_.root_struct_def_ == &table) {
+      message.identifier = parser_.file_identifier_;
+    }
+    for (auto it = table.fields.vec.begin(); it != table.fields.vec.end();
+         ++it) {
+      const FieldDef &field = **it;
+      if (field.deprecated || !field.IsRequired()) continue;
+      message.required_fields.push_back(std::make_pair(
+          static_cast<int>(field.value.offset),
+          table.defined_namespace->GetFullyQualifiedName(table.name) + "." +
+              field.name));
+    }
+    return message;
+  }
+
   const Parser &parser_;
   const std::string &path_, &file_name_;
 };
@@ -20,9 +20,10 @@ go_path=${test_dir}/go_gen
 go_src=${go_path}/src
 
 # Emit Go code for the example schemas in the test dir:
-../flatc -g --gen-object-api -I include_test -o ${go_src} monster_test.fbs optional_scalars.fbs
+../flatc -g --gen-object-api --go-http -I include_test -o ${go_src} monster_test.fbs optional_scalars.fbs
 ../flatc -g --gen-object-api -I include_test/sub -o ${go_src} include_test/order.fbs
 ../flatc -g --gen-object-api -o ${go_src}/Pizza include_test/sub/no_namespace.fbs
+../flatc -g --gen-object-api --go-typed-offsets --go-module-name typed -I include_test -o ${go_src}/typed monster_test.fbs
 
 # Go requires a particular layout of files in order to link multiple packages.
 # Copy flatbuffer Go files to their own package directories to compile the
@@ -31,7 +32,7 @@ mkdir -p ${go_src}/github.com/google/flatbuffers/go
 mkdir -p ${go_src}/flatbuffers_test
 
 cp -a ../go/* ./go_gen/src/github.com/google/flatbuffers/go
-cp -a ./go_test.go ./go_gen/src/flatbuffers_test/
+cp -a ./go_test.go ./go_checked_test.go ./go_gen/src/flatbuffers_test/
 
 # https://stackoverflow.com/a/63545857/7024978
 # We need to turn off go modules for this script
@@ -56,6 +57,13 @@ GOPATH=${go_path} go test flatbuffers_test \
                      --fuzz_objects=10000
 
 GO_TEST_RESULT=$?
+
+# Run them again with every Builder checking the offsets it is given, see
+# NewCheckedBuilder.
+GOPATH=${go_path} go test -tags flatbuffers_checked flatbuffers_test \
+                     --cpp_data=${test_dir}/monsterdata_test.mon \
+                     --out_data=${test_dir}/monsterdata_go_wire.mon
+GO_CHECKED_TEST_RESULT=$?
 rm -rf ${go_path}/{pkg,src}
 if [[ $GO_TEST_RESULT  == 0 ]]; then
     echo "OK: Go tests passed."
@@ -63,6 +71,38 @@ else
     echo "KO: Go tests failed."
     exit 1
 fi
+if [[ $GO_CHECKED_TEST_RESULT == 0 ]]; then
+    echo "OK: Go tests passed with checked Builders."
+else
+    echo "KO: Go tests failed with checked Builders."
+    exit 1
+fi
+
+# The gRPC stubs import google.golang.org/grpc, which is not in the GOPATH
+# above, so they are generated into a module of their own and tested by the
+# tests in grpc/tests, which import them as
+# github.com/google/flatbuffers/tests/MyGame/Example.
+grpc_mod=${go_path}/grpc
+../flatc -g --gen-object-api --grpc --go-grpc-typed --go-grpc-fakes \
+    --go-module-name github.com/google/flatbuffers/tests \
+    -I include_test -o ${grpc_mod}/tests monster_test.fbs
+mkdir -p ${grpc_mod}/grpc/tests
+cp -a ../go ${grpc_mod}/go
+cp -a ../grpc/tests/*_test.go ${grpc_mod}/grpc/tests/
+
+pushd ${grpc_mod} >/dev/null
+GO111MODULE=on go mod init github.com/google/flatbuffers >/dev/null 2>&1
+GO111MODULE=on go mod tidy >/dev/null 2>&1
+GO111MODULE=on go test ./grpc/tests
+GRPC_TEST_RESULT=$?
+popd >/dev/null
+rm -rf ${grpc_mod}
+if [[ $GRPC_TEST_RESULT == 0 ]]; then
+    echo "OK: Go gRPC tests passed."
+else
+    echo "KO: Go gRPC tests failed."
+    exit 1
+fi
 
 NOT_FMT_FILES=$(gofmt -l .)
 if [[ ${NOT_FMT_FILES} != "" ]]; then
@@ -31,6 +31,19 @@ func (rcv *Ability) UnPack() *AbilityT {
 	return t
 }
 
+// Reset sets t to the zero AbilityT, resetting its nested structs.
+func (t *AbilityT) Reset() {
+	*t = AbilityT{}
+}
+
+// RandomAbilityT returns a AbilityT with random fields from r.
+func RandomAbilityT(r *flatbuffers.Random) *AbilityT {
+	return &AbilityT{
+		Id: r.Uint32(),
+		Distance: r.Uint32(),
+	}
+}
+
 type Ability struct {
 	_tab flatbuffers.Struct
 }
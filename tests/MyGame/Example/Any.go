@@ -3,6 +3,7 @@
 package Example
 
 import (
+	"errors"
 	flatbuffers "github.com/google/flatbuffers/go"
 	"strconv"
 
@@ -39,11 +40,63 @@ func (v Any) String() string {
 	return "Any(" + strconv.FormatInt(int64(v), 10) + ")"
 }
 
+// AnyValues returns the values of Any, in declaration order.
+func AnyValues() []Any {
+	return []Any{
+		AnyNONE,
+		AnyMonster,
+		AnyTestSimpleTableWithEnum,
+		AnyMyGame_Example2_Monster,
+	}
+}
+
+// IsValid reports whether v is a value of Any.
+func (v Any) IsValid() bool {
+	_, ok := EnumNamesAny[v]
+	return ok
+}
+
+// MarshalText formats v like flatc's JSON output: as the name of a value, or
+// as a number.
+func (v Any) MarshalText() ([]byte, error) {
+	if s, ok := EnumNamesAny[v]; ok {
+		return []byte(s), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText parses text like ParseAny.
+func (v *Any) UnmarshalText(text []byte) error {
+	x, err := ParseAny(string(text))
+	if err != nil {
+		return err
+	}
+	*v = x
+	return nil
+}
+
+// ParseAny parses the name or the number of a Any value.
+func ParseAny(s string) (Any, error) {
+	if v, ok := EnumValuesAny[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, errors.New("invalid Any: " + strconv.Quote(s))
+	}
+	return Any(n), nil
+}
+
 type AnyT struct {
 	Type Any
 	Value interface{}
 }
 
+// Reset sets t to the zero AnyT.
+func (t *AnyT) Reset() {
+	*t = AnyT{}
+}
+
 func (t *AnyT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	if t == nil {
 		return 0
@@ -60,19 +113,89 @@ func (t *AnyT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 }
 
 func (rcv Any) UnPack(table flatbuffers.Table) *AnyT {
+	return rcv.UnPackTo(table, nil, nil)
+}
+
+// UnPackTo unpacks the member of the union in table into t, reusing t and the
+// value it holds if they are not nil, and returns t, or nil if rcv is not a
+// member. Strings are taken from interner, which may be nil.
+func (rcv Any) UnPackTo(table flatbuffers.Table, t *AnyT, interner *flatbuffers.Interner) *AnyT {
+	switch rcv {
+	case AnyMonster:
+		var x Monster
+		x.Init(table.Bytes, table.Pos)
+		if t == nil {
+			t = &AnyT{}
+		}
+		v, _ := t.Value.(*MonsterT)
+		if v == nil {
+			v = &MonsterT{}
+		}
+		x.UnPackToInterned(v, interner)
+		t.Type, t.Value = AnyMonster, v
+		return t
+	case AnyTestSimpleTableWithEnum:
+		var x TestSimpleTableWithEnum
+		x.Init(table.Bytes, table.Pos)
+		if t == nil {
+			t = &AnyT{}
+		}
+		v, _ := t.Value.(*TestSimpleTableWithEnumT)
+		if v == nil {
+			v = &TestSimpleTableWithEnumT{}
+		}
+		x.UnPackToInterned(v, interner)
+		t.Type, t.Value = AnyTestSimpleTableWithEnum, v
+		return t
+	case AnyMyGame_Example2_Monster:
+		var x MyGame__Example2.Monster
+		x.Init(table.Bytes, table.Pos)
+		if t == nil {
+			t = &AnyT{}
+		}
+		v, _ := t.Value.(*MyGame__Example2.MonsterT)
+		if v == nil {
+			v = &MyGame__Example2.MonsterT{}
+		}
+		x.UnPackToInterned(v, interner)
+		t.Type, t.Value = AnyMyGame_Example2_Monster, v
+		return t
+	}
+	return nil
+}
+
+// Splice writes a copy of the member of the union in table to builder, and
+// returns its offset.
+func (rcv Any) Splice(builder *flatbuffers.Builder, table flatbuffers.Table) flatbuffers.UOffsetT {
 	switch rcv {
 	case AnyMonster:
 		var x Monster
 		x.Init(table.Bytes, table.Pos)
-		return &AnyT{Type: AnyMonster, Value: x.UnPack()}
+		return x.Splice(builder)
 	case AnyTestSimpleTableWithEnum:
 		var x TestSimpleTableWithEnum
 		x.Init(table.Bytes, table.Pos)
-		return &AnyT{Type: AnyTestSimpleTableWithEnum, Value: x.UnPack()}
+		return x.Splice(builder)
 	case AnyMyGame_Example2_Monster:
 		var x MyGame__Example2.Monster
 		x.Init(table.Bytes, table.Pos)
-		return &AnyT{Type: AnyMyGame_Example2_Monster, Value: x.UnPack()}
+		return x.Splice(builder)
+	}
+	return 0
+}
+
+// RandomAnyT returns a random member of Any from r, or nil.
+func RandomAnyT(r *flatbuffers.Random) *AnyT {
+	if !r.Child() {
+		return nil
+	}
+	switch r.Intn(3) {
+	case 0:
+		return &AnyT{Type: AnyMonster, Value: RandomMonsterT(r)}
+	case 1:
+		return &AnyT{Type: AnyTestSimpleTableWithEnum, Value: RandomTestSimpleTableWithEnumT(r)}
+	case 2:
+		return &AnyT{Type: AnyMyGame_Example2_Monster, Value: MyGame__Example2.RandomMonsterT(r)}
 	}
 	return nil
 }
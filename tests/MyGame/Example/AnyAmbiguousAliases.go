@@ -3,6 +3,7 @@
 package Example
 
 import (
+	"errors"
 	flatbuffers "github.com/google/flatbuffers/go"
 	"strconv"
 )
@@ -37,11 +38,63 @@ func (v AnyAmbiguousAliases) String() string {
 	return "AnyAmbiguousAliases(" + strconv.FormatInt(int64(v), 10) + ")"
 }
 
+// AnyAmbiguousAliasesValues returns the values of AnyAmbiguousAliases, in declaration order.
+func AnyAmbiguousAliasesValues() []AnyAmbiguousAliases {
+	return []AnyAmbiguousAliases{
+		AnyAmbiguousAliasesNONE,
+		AnyAmbiguousAliasesM1,
+		AnyAmbiguousAliasesM2,
+		AnyAmbiguousAliasesM3,
+	}
+}
+
+// IsValid reports whether v is a value of AnyAmbiguousAliases.
+func (v AnyAmbiguousAliases) IsValid() bool {
+	_, ok := EnumNamesAnyAmbiguousAliases[v]
+	return ok
+}
+
+// MarshalText formats v like flatc's JSON output: as the name of a value, or
+// as a number.
+func (v AnyAmbiguousAliases) MarshalText() ([]byte, error) {
+	if s, ok := EnumNamesAnyAmbiguousAliases[v]; ok {
+		return []byte(s), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText parses text like ParseAnyAmbiguousAliases.
+func (v *AnyAmbiguousAliases) UnmarshalText(text []byte) error {
+	x, err := ParseAnyAmbiguousAliases(string(text))
+	if err != nil {
+		return err
+	}
+	*v = x
+	return nil
+}
+
+// ParseAnyAmbiguousAliases parses the name or the number of a AnyAmbiguousAliases value.
+func ParseAnyAmbiguousAliases(s string) (AnyAmbiguousAliases, error) {
+	if v, ok := EnumValuesAnyAmbiguousAliases[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, errors.New("invalid AnyAmbiguousAliases: " + strconv.Quote(s))
+	}
+	return AnyAmbiguousAliases(n), nil
+}
+
 type AnyAmbiguousAliasesT struct {
 	Type AnyAmbiguousAliases
 	Value interface{}
 }
 
+// Reset sets t to the zero AnyAmbiguousAliasesT.
+func (t *AnyAmbiguousAliasesT) Reset() {
+	*t = AnyAmbiguousAliasesT{}
+}
+
 func (t *AnyAmbiguousAliasesT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	if t == nil {
 		return 0
@@ -58,19 +111,89 @@ func (t *AnyAmbiguousAliasesT) Pack(builder *flatbuffers.Builder) flatbuffers.UO
 }
 
 func (rcv AnyAmbiguousAliases) UnPack(table flatbuffers.Table) *AnyAmbiguousAliasesT {
+	return rcv.UnPackTo(table, nil, nil)
+}
+
+// UnPackTo unpacks the member of the union in table into t, reusing t and the
+// value it holds if they are not nil, and returns t, or nil if rcv is not a
+// member. Strings are taken from interner, which may be nil.
+func (rcv AnyAmbiguousAliases) UnPackTo(table flatbuffers.Table, t *AnyAmbiguousAliasesT, interner *flatbuffers.Interner) *AnyAmbiguousAliasesT {
+	switch rcv {
+	case AnyAmbiguousAliasesM1:
+		var x Monster
+		x.Init(table.Bytes, table.Pos)
+		if t == nil {
+			t = &AnyAmbiguousAliasesT{}
+		}
+		v, _ := t.Value.(*MonsterT)
+		if v == nil {
+			v = &MonsterT{}
+		}
+		x.UnPackToInterned(v, interner)
+		t.Type, t.Value = AnyAmbiguousAliasesM1, v
+		return t
+	case AnyAmbiguousAliasesM2:
+		var x Monster
+		x.Init(table.Bytes, table.Pos)
+		if t == nil {
+			t = &AnyAmbiguousAliasesT{}
+		}
+		v, _ := t.Value.(*MonsterT)
+		if v == nil {
+			v = &MonsterT{}
+		}
+		x.UnPackToInterned(v, interner)
+		t.Type, t.Value = AnyAmbiguousAliasesM2, v
+		return t
+	case AnyAmbiguousAliasesM3:
+		var x Monster
+		x.Init(table.Bytes, table.Pos)
+		if t == nil {
+			t = &AnyAmbiguousAliasesT{}
+		}
+		v, _ := t.Value.(*MonsterT)
+		if v == nil {
+			v = &MonsterT{}
+		}
+		x.UnPackToInterned(v, interner)
+		t.Type, t.Value = AnyAmbiguousAliasesM3, v
+		return t
+	}
+	return nil
+}
+
+// Splice writes a copy of the member of the union in table to builder, and
+// returns its offset.
+func (rcv AnyAmbiguousAliases) Splice(builder *flatbuffers.Builder, table flatbuffers.Table) flatbuffers.UOffsetT {
 	switch rcv {
 	case AnyAmbiguousAliasesM1:
 		var x Monster
 		x.Init(table.Bytes, table.Pos)
-		return &AnyAmbiguousAliasesT{Type: AnyAmbiguousAliasesM1, Value: x.UnPack()}
+		return x.Splice(builder)
 	case AnyAmbiguousAliasesM2:
 		var x Monster
 		x.Init(table.Bytes, table.Pos)
-		return &AnyAmbiguousAliasesT{Type: AnyAmbiguousAliasesM2, Value: x.UnPack()}
+		return x.Splice(builder)
 	case AnyAmbiguousAliasesM3:
 		var x Monster
 		x.Init(table.Bytes, table.Pos)
-		return &AnyAmbiguousAliasesT{Type: AnyAmbiguousAliasesM3, Value: x.UnPack()}
+		return x.Splice(builder)
+	}
+	return 0
+}
+
+// RandomAnyAmbiguousAliasesT returns a random member of AnyAmbiguousAliases from r, or nil.
+func RandomAnyAmbiguousAliasesT(r *flatbuffers.Random) *AnyAmbiguousAliasesT {
+	if !r.Child() {
+		return nil
+	}
+	switch r.Intn(3) {
+	case 0:
+		return &AnyAmbiguousAliasesT{Type: AnyAmbiguousAliasesM1, Value: RandomMonsterT(r)}
+	case 1:
+		return &AnyAmbiguousAliasesT{Type: AnyAmbiguousAliasesM2, Value: RandomMonsterT(r)}
+	case 2:
+		return &AnyAmbiguousAliasesT{Type: AnyAmbiguousAliasesM3, Value: RandomMonsterT(r)}
 	}
 	return nil
 }
@@ -3,6 +3,7 @@
 package Example
 
 import (
+	"errors"
 	flatbuffers "github.com/google/flatbuffers/go"
 	"strconv"
 
@@ -39,11 +40,63 @@ func (v AnyUniqueAliases) String() string {
 	return "AnyUniqueAliases(" + strconv.FormatInt(int64(v), 10) + ")"
 }
 
+// AnyUniqueAliasesValues returns the values of AnyUniqueAliases, in declaration order.
+func AnyUniqueAliasesValues() []AnyUniqueAliases {
+	return []AnyUniqueAliases{
+		AnyUniqueAliasesNONE,
+		AnyUniqueAliasesM,
+		AnyUniqueAliasesTS,
+		AnyUniqueAliasesM2,
+	}
+}
+
+// IsValid reports whether v is a value of AnyUniqueAliases.
+func (v AnyUniqueAliases) IsValid() bool {
+	_, ok := EnumNamesAnyUniqueAliases[v]
+	return ok
+}
+
+// MarshalText formats v like flatc's JSON output: as the name of a value, or
+// as a number.
+func (v AnyUniqueAliases) MarshalText() ([]byte, error) {
+	if s, ok := EnumNamesAnyUniqueAliases[v]; ok {
+		return []byte(s), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText parses text like ParseAnyUniqueAliases.
+func (v *AnyUniqueAliases) UnmarshalText(text []byte) error {
+	x, err := ParseAnyUniqueAliases(string(text))
+	if err != nil {
+		return err
+	}
+	*v = x
+	return nil
+}
+
+// ParseAnyUniqueAliases parses the name or the number of a AnyUniqueAliases value.
+func ParseAnyUniqueAliases(s string) (AnyUniqueAliases, error) {
+	if v, ok := EnumValuesAnyUniqueAliases[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, errors.New("invalid AnyUniqueAliases: " + strconv.Quote(s))
+	}
+	return AnyUniqueAliases(n), nil
+}
+
 type AnyUniqueAliasesT struct {
 	Type AnyUniqueAliases
 	Value interface{}
 }
 
+// Reset sets t to the zero AnyUniqueAliasesT.
+func (t *AnyUniqueAliasesT) Reset() {
+	*t = AnyUniqueAliasesT{}
+}
+
 func (t *AnyUniqueAliasesT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	if t == nil {
 		return 0
@@ -60,19 +113,89 @@ func (t *AnyUniqueAliasesT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffs
 }
 
 func (rcv AnyUniqueAliases) UnPack(table flatbuffers.Table) *AnyUniqueAliasesT {
+	return rcv.UnPackTo(table, nil, nil)
+}
+
+// UnPackTo unpacks the member of the union in table into t, reusing t and the
+// value it holds if they are not nil, and returns t, or nil if rcv is not a
+// member. Strings are taken from interner, which may be nil.
+func (rcv AnyUniqueAliases) UnPackTo(table flatbuffers.Table, t *AnyUniqueAliasesT, interner *flatbuffers.Interner) *AnyUniqueAliasesT {
+	switch rcv {
+	case AnyUniqueAliasesM:
+		var x Monster
+		x.Init(table.Bytes, table.Pos)
+		if t == nil {
+			t = &AnyUniqueAliasesT{}
+		}
+		v, _ := t.Value.(*MonsterT)
+		if v == nil {
+			v = &MonsterT{}
+		}
+		x.UnPackToInterned(v, interner)
+		t.Type, t.Value = AnyUniqueAliasesM, v
+		return t
+	case AnyUniqueAliasesTS:
+		var x TestSimpleTableWithEnum
+		x.Init(table.Bytes, table.Pos)
+		if t == nil {
+			t = &AnyUniqueAliasesT{}
+		}
+		v, _ := t.Value.(*TestSimpleTableWithEnumT)
+		if v == nil {
+			v = &TestSimpleTableWithEnumT{}
+		}
+		x.UnPackToInterned(v, interner)
+		t.Type, t.Value = AnyUniqueAliasesTS, v
+		return t
+	case AnyUniqueAliasesM2:
+		var x MyGame__Example2.Monster
+		x.Init(table.Bytes, table.Pos)
+		if t == nil {
+			t = &AnyUniqueAliasesT{}
+		}
+		v, _ := t.Value.(*MyGame__Example2.MonsterT)
+		if v == nil {
+			v = &MyGame__Example2.MonsterT{}
+		}
+		x.UnPackToInterned(v, interner)
+		t.Type, t.Value = AnyUniqueAliasesM2, v
+		return t
+	}
+	return nil
+}
+
+// Splice writes a copy of the member of the union in table to builder, and
+// returns its offset.
+func (rcv AnyUniqueAliases) Splice(builder *flatbuffers.Builder, table flatbuffers.Table) flatbuffers.UOffsetT {
 	switch rcv {
 	case AnyUniqueAliasesM:
 		var x Monster
 		x.Init(table.Bytes, table.Pos)
-		return &AnyUniqueAliasesT{Type: AnyUniqueAliasesM, Value: x.UnPack()}
+		return x.Splice(builder)
 	case AnyUniqueAliasesTS:
 		var x TestSimpleTableWithEnum
 		x.Init(table.Bytes, table.Pos)
-		return &AnyUniqueAliasesT{Type: AnyUniqueAliasesTS, Value: x.UnPack()}
+		return x.Splice(builder)
 	case AnyUniqueAliasesM2:
 		var x MyGame__Example2.Monster
 		x.Init(table.Bytes, table.Pos)
-		return &AnyUniqueAliasesT{Type: AnyUniqueAliasesM2, Value: x.UnPack()}
+		return x.Splice(builder)
+	}
+	return 0
+}
+
+// RandomAnyUniqueAliasesT returns a random member of AnyUniqueAliases from r, or nil.
+func RandomAnyUniqueAliasesT(r *flatbuffers.Random) *AnyUniqueAliasesT {
+	if !r.Child() {
+		return nil
+	}
+	switch r.Intn(3) {
+	case 0:
+		return &AnyUniqueAliasesT{Type: AnyUniqueAliasesM, Value: RandomMonsterT(r)}
+	case 1:
+		return &AnyUniqueAliasesT{Type: AnyUniqueAliasesTS, Value: RandomTestSimpleTableWithEnumT(r)}
+	case 2:
+		return &AnyUniqueAliasesT{Type: AnyUniqueAliasesM2, Value: MyGame__Example2.RandomMonsterT(r)}
 	}
 	return nil
 }
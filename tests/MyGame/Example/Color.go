@@ -2,7 +2,12 @@
 
 package Example
 
-import "strconv"
+import (
+	"errors"
+	flatbuffers "github.com/google/flatbuffers/go"
+	"strconv"
+	"strings"
+)
 
 /// Composite components of Monster color.
 type Color byte
@@ -32,5 +37,102 @@ func (v Color) String() string {
 	if s, ok := EnumNamesColor[v]; ok {
 		return s
 	}
+	if s := v.format("|"); s != "" {
+		return s
+	}
 	return "Color(" + strconv.FormatInt(int64(v), 10) + ")"
 }
+
+// ColorValues returns the values of Color, in declaration order.
+func ColorValues() []Color {
+	return []Color{
+		ColorRed,
+		ColorGreen,
+		ColorBlue,
+	}
+}
+
+// IsValid reports whether v is a combination of the flags of Color.
+func (v Color) IsValid() bool {
+	return v&^(ColorRed|ColorGreen|ColorBlue) == 0
+}
+
+// Has reports whether all of flags are set in v.
+func (v Color) Has(flags Color) bool {
+	return v&flags == flags
+}
+
+// Set sets flags in v.
+func (v *Color) Set(flags Color) {
+	*v |= flags
+}
+
+// Clear clears flags in v.
+func (v *Color) Clear(flags Color) {
+	*v &^= flags
+}
+
+// format joins the names of the flags set in v with sep, or returns "" if v
+// is not a combination of flags.
+func (v Color) format(sep string) string {
+	if v == 0 || !v.IsValid() {
+		return ""
+	}
+	var names []string
+	for _, f := range ColorValues() {
+		if v.Has(f) {
+			names = append(names, EnumNamesColor[f])
+		}
+	}
+	return strings.Join(names, sep)
+}
+
+// MarshalText formats v like flatc's JSON output: as the name of a value, as
+// the names of its flags separated by spaces, or as a number.
+func (v Color) MarshalText() ([]byte, error) {
+	if s, ok := EnumNamesColor[v]; ok {
+		return []byte(s), nil
+	}
+	if s := v.format(" "); s != "" {
+		return []byte(s), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText parses text like ParseColor.
+func (v *Color) UnmarshalText(text []byte) error {
+	x, err := ParseColor(string(text))
+	if err != nil {
+		return err
+	}
+	*v = x
+	return nil
+}
+
+// ParseColor parses the names or numbers of Color flags, separated by
+// spaces as in flatc's JSON or by "|" as in String.
+func ParseColor(s string) (Color, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool { return r == ' ' || r == '|' })
+	if len(fields) == 0 {
+		return 0, errors.New("invalid Color: " + strconv.Quote(s))
+	}
+	var v Color
+	for _, f := range fields {
+		if x, ok := EnumValuesColor[f]; ok {
+			v |= x
+			continue
+		}
+		n, err := strconv.ParseUint(f, 10, 8)
+		if err != nil {
+			return 0, errors.New("invalid Color: " + strconv.Quote(s))
+		}
+		v |= Color(n)
+	}
+	return v, nil
+}
+
+// RandomColor returns one of the values of Color from r.
+func RandomColor(r *flatbuffers.Random) Color {
+	values := ColorValues()
+	return values[r.Intn(len(values))]
+}
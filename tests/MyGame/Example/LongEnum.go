@@ -2,7 +2,12 @@
 
 package Example
 
-import "strconv"
+import (
+	"errors"
+	flatbuffers "github.com/google/flatbuffers/go"
+	"strconv"
+	"strings"
+)
 
 type LongEnum uint64
 
@@ -28,5 +33,102 @@ func (v LongEnum) String() string {
 	if s, ok := EnumNamesLongEnum[v]; ok {
 		return s
 	}
+	if s := v.format("|"); s != "" {
+		return s
+	}
 	return "LongEnum(" + strconv.FormatInt(int64(v), 10) + ")"
 }
+
+// LongEnumValues returns the values of LongEnum, in declaration order.
+func LongEnumValues() []LongEnum {
+	return []LongEnum{
+		LongEnumLongOne,
+		LongEnumLongTwo,
+		LongEnumLongBig,
+	}
+}
+
+// IsValid reports whether v is a combination of the flags of LongEnum.
+func (v LongEnum) IsValid() bool {
+	return v&^(LongEnumLongOne|LongEnumLongTwo|LongEnumLongBig) == 0
+}
+
+// Has reports whether all of flags are set in v.
+func (v LongEnum) Has(flags LongEnum) bool {
+	return v&flags == flags
+}
+
+// Set sets flags in v.
+func (v *LongEnum) Set(flags LongEnum) {
+	*v |= flags
+}
+
+// Clear clears flags in v.
+func (v *LongEnum) Clear(flags LongEnum) {
+	*v &^= flags
+}
+
+// format joins the names of the flags set in v with sep, or returns "" if v
+// is not a combination of flags.
+func (v LongEnum) format(sep string) string {
+	if v == 0 || !v.IsValid() {
+		return ""
+	}
+	var names []string
+	for _, f := range LongEnumValues() {
+		if v.Has(f) {
+			names = append(names, EnumNamesLongEnum[f])
+		}
+	}
+	return strings.Join(names, sep)
+}
+
+// MarshalText formats v like flatc's JSON output: as the name of a value, as
+// the names of its flags separated by spaces, or as a number.
+func (v LongEnum) MarshalText() ([]byte, error) {
+	if s, ok := EnumNamesLongEnum[v]; ok {
+		return []byte(s), nil
+	}
+	if s := v.format(" "); s != "" {
+		return []byte(s), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText parses text like ParseLongEnum.
+func (v *LongEnum) UnmarshalText(text []byte) error {
+	x, err := ParseLongEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*v = x
+	return nil
+}
+
+// ParseLongEnum parses the names or numbers of LongEnum flags, separated by
+// spaces as in flatc's JSON or by "|" as in String.
+func ParseLongEnum(s string) (LongEnum, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool { return r == ' ' || r == '|' })
+	if len(fields) == 0 {
+		return 0, errors.New("invalid LongEnum: " + strconv.Quote(s))
+	}
+	var v LongEnum
+	for _, f := range fields {
+		if x, ok := EnumValuesLongEnum[f]; ok {
+			v |= x
+			continue
+		}
+		n, err := strconv.ParseUint(f, 10, 64)
+		if err != nil {
+			return 0, errors.New("invalid LongEnum: " + strconv.Quote(s))
+		}
+		v |= LongEnum(n)
+	}
+	return v, nil
+}
+
+// RandomLongEnum returns one of the values of LongEnum from r.
+func RandomLongEnum(r *flatbuffers.Random) LongEnum {
+	values := LongEnumValues()
+	return values[r.Intn(len(values))]
+}
@@ -5,6 +5,7 @@ package Example
 import (
 	"bytes"
 	flatbuffers "github.com/google/flatbuffers/go"
+	flathash "github.com/google/flatbuffers/go/flathash"
 	"math"
 
 	MyGame "MyGame"
@@ -23,7 +24,7 @@ type MonsterT struct {
 	Testarrayofstring []string `json:"testarrayofstring"`
 	Testarrayoftables []*MonsterT `json:"testarrayoftables"`
 	Enemy *MonsterT `json:"enemy"`
-	Testnestedflatbuffer []byte `json:"testnestedflatbuffer"`
+	Testnestedflatbuffer *MonsterT `json:"testnestedflatbuffer"`
 	Testempty *StatT `json:"testempty"`
 	Testbool bool `json:"testbool"`
 	Testhashs32Fnv1 int32 `json:"testhashs32_fnv1"`
@@ -57,7 +58,7 @@ type MonsterT struct {
 	AnyAmbiguous *AnyAmbiguousAliasesT `json:"any_ambiguous"`
 	VectorOfEnums []Color `json:"vector_of_enums"`
 	SignedEnum Race `json:"signed_enum"`
-	Testrequirednestedflatbuffer []byte `json:"testrequirednestedflatbuffer"`
+	Testrequirednestedflatbuffer *MonsterT `json:"testrequirednestedflatbuffer"`
 	ScalarKeySortedTables []*StatT `json:"scalar_key_sorted_tables"`
 	NativeInline *TestT `json:"native_inline"`
 	LongEnumNonEnumDefault LongEnum `json:"long_enum_non_enum_default"`
@@ -76,10 +77,7 @@ func (t *MonsterT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	if t == nil {
 		return 0
 	}
-	nameOffset := flatbuffers.UOffsetT(0)
-	if t.Name != "" {
-		nameOffset = builder.CreateString(t.Name)
-	}
+	nameOffset := builder.CreateString(t.Name)
 	inventoryOffset := flatbuffers.UOffsetT(0)
 	if t.Inventory != nil {
 		inventoryOffset = builder.CreateByteString(t.Inventory)
@@ -124,7 +122,9 @@ func (t *MonsterT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	enemyOffset := t.Enemy.Pack(builder)
 	testnestedflatbufferOffset := flatbuffers.UOffsetT(0)
 	if t.Testnestedflatbuffer != nil {
-		testnestedflatbufferOffset = builder.CreateByteString(t.Testnestedflatbuffer)
+		testnestedflatbufferBuilder := flatbuffers.NewBuilder(0)
+		FinishMonsterBuffer(testnestedflatbufferBuilder, t.Testnestedflatbuffer.Pack(testnestedflatbufferBuilder))
+		testnestedflatbufferOffset = MonsterMakeTestnestedflatbufferVector(builder, testnestedflatbufferBuilder)
 	}
 	testemptyOffset := t.Testempty.Pack(builder)
 	testarrayofboolsOffset := flatbuffers.UOffsetT(0)
@@ -258,7 +258,9 @@ func (t *MonsterT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	}
 	testrequirednestedflatbufferOffset := flatbuffers.UOffsetT(0)
 	if t.Testrequirednestedflatbuffer != nil {
-		testrequirednestedflatbufferOffset = builder.CreateByteString(t.Testrequirednestedflatbuffer)
+		testrequirednestedflatbufferBuilder := flatbuffers.NewBuilder(0)
+		FinishMonsterBuffer(testrequirednestedflatbufferBuilder, t.Testrequirednestedflatbuffer.Pack(testrequirednestedflatbufferBuilder))
+		testrequirednestedflatbufferOffset = MonsterMakeTestrequirednestedflatbufferVector(builder, testrequirednestedflatbufferBuilder)
 	}
 	scalarKeySortedTablesOffset := flatbuffers.UOffsetT(0)
 	if t.ScalarKeySortedTables != nil {
@@ -322,184 +324,1531 @@ func (t *MonsterT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	if t.AnyUnique != nil {
 		MonsterAddAnyUniqueType(builder, t.AnyUnique.Type)
 	}
-	MonsterAddAnyUnique(builder, anyUniqueOffset)
-	if t.AnyAmbiguous != nil {
-		MonsterAddAnyAmbiguousType(builder, t.AnyAmbiguous.Type)
+	MonsterAddAnyUnique(builder, anyUniqueOffset)
+	if t.AnyAmbiguous != nil {
+		MonsterAddAnyAmbiguousType(builder, t.AnyAmbiguous.Type)
+	}
+	MonsterAddAnyAmbiguous(builder, anyAmbiguousOffset)
+	MonsterAddVectorOfEnums(builder, vectorOfEnumsOffset)
+	MonsterAddSignedEnum(builder, t.SignedEnum)
+	MonsterAddTestrequirednestedflatbuffer(builder, testrequirednestedflatbufferOffset)
+	MonsterAddScalarKeySortedTables(builder, scalarKeySortedTablesOffset)
+	nativeInlineOffset := t.NativeInline.Pack(builder)
+	MonsterAddNativeInline(builder, nativeInlineOffset)
+	MonsterAddLongEnumNonEnumDefault(builder, t.LongEnumNonEnumDefault)
+	MonsterAddLongEnumNormalDefault(builder, t.LongEnumNormalDefault)
+	MonsterAddNanDefault(builder, t.NanDefault)
+	MonsterAddInfDefault(builder, t.InfDefault)
+	MonsterAddPositiveInfDefault(builder, t.PositiveInfDefault)
+	MonsterAddInfinityDefault(builder, t.InfinityDefault)
+	MonsterAddPositiveInfinityDefault(builder, t.PositiveInfinityDefault)
+	MonsterAddNegativeInfDefault(builder, t.NegativeInfDefault)
+	MonsterAddNegativeInfinityDefault(builder, t.NegativeInfinityDefault)
+	MonsterAddDoubleInfDefault(builder, t.DoubleInfDefault)
+	return MonsterEnd(builder)
+}
+
+// UnPackTo unpacks rcv into t, reusing the vectors and objects t already holds,
+// which must not be shared with other values.
+func (rcv *Monster) UnPackTo(t *MonsterT) {
+	rcv.UnPackToInterned(t, nil)
+}
+
+// UnPackToInterned is UnPackTo taking the strings it allocates from interner,
+// which may be nil.
+func (rcv *Monster) UnPackToInterned(t *MonsterT, interner *flatbuffers.Interner) {
+	if x := rcv.Pos(&Vec3{}); x != nil {
+		if t.Pos == nil {
+			t.Pos = &Vec3T{}
+		}
+		x.UnPackTo(t.Pos)
+	} else {
+		t.Pos = nil
+	}
+	t.Mana = rcv.Mana()
+	t.Hp = rcv.Hp()
+	if x := rcv.Name(); string(x) != t.Name {
+		t.Name = interner.Intern(x)
+	}
+	t.Inventory = rcv.InventoryBytes()
+	t.Color = rcv.Color()
+	testTable := flatbuffers.Table{}
+	if rcv.Test(&testTable) {
+		t.Test = rcv.TestType().UnPackTo(testTable, t.Test, interner)
+	} else {
+		t.Test = nil
+	}
+	test4Length := rcv.Test4Length()
+	if rcv._tab.Offset(22) == 0 {
+		t.Test4 = nil
+	} else if t.Test4 == nil || cap(t.Test4) < test4Length {
+		t.Test4 = make([]*TestT, test4Length)
+	} else {
+		t.Test4 = t.Test4[:test4Length]
+	}
+	for j := 0; j < test4Length; j++ {
+		x := Test{}
+		rcv.Test4(&x, j)
+		if t.Test4[j] == nil {
+			t.Test4[j] = &TestT{}
+		}
+		x.UnPackTo(t.Test4[j])
+	}
+	testarrayofstringLength := rcv.TestarrayofstringLength()
+	if rcv._tab.Offset(24) == 0 {
+		t.Testarrayofstring = nil
+	} else if t.Testarrayofstring == nil || cap(t.Testarrayofstring) < testarrayofstringLength {
+		t.Testarrayofstring = make([]string, testarrayofstringLength)
+	} else {
+		t.Testarrayofstring = t.Testarrayofstring[:testarrayofstringLength]
+	}
+	for j := 0; j < testarrayofstringLength; j++ {
+		if x := rcv.Testarrayofstring(j); string(x) != t.Testarrayofstring[j] {
+			t.Testarrayofstring[j] = interner.Intern(x)
+		}
+	}
+	testarrayoftablesLength := rcv.TestarrayoftablesLength()
+	if rcv._tab.Offset(26) == 0 {
+		t.Testarrayoftables = nil
+	} else if t.Testarrayoftables == nil || cap(t.Testarrayoftables) < testarrayoftablesLength {
+		t.Testarrayoftables = make([]*MonsterT, testarrayoftablesLength)
+	} else {
+		t.Testarrayoftables = t.Testarrayoftables[:testarrayoftablesLength]
+	}
+	for j := 0; j < testarrayoftablesLength; j++ {
+		x := Monster{}
+		rcv.Testarrayoftables(&x, j)
+		if t.Testarrayoftables[j] == nil {
+			t.Testarrayoftables[j] = &MonsterT{}
+		}
+		x.UnPackToInterned(t.Testarrayoftables[j], interner)
+	}
+	if x := rcv.Enemy(&Monster{}); x != nil {
+		if t.Enemy == nil {
+			t.Enemy = &MonsterT{}
+		}
+		x.UnPackToInterned(t.Enemy, interner)
+	} else {
+		t.Enemy = nil
+	}
+	if x := rcv.TestnestedflatbufferNestedRoot(); x != nil {
+		if t.Testnestedflatbuffer == nil {
+			t.Testnestedflatbuffer = &MonsterT{}
+		}
+		x.UnPackToInterned(t.Testnestedflatbuffer, interner)
+	} else {
+		t.Testnestedflatbuffer = nil
+	}
+	if x := rcv.Testempty(&Stat{}); x != nil {
+		if t.Testempty == nil {
+			t.Testempty = &StatT{}
+		}
+		x.UnPackToInterned(t.Testempty, interner)
+	} else {
+		t.Testempty = nil
+	}
+	t.Testbool = rcv.Testbool()
+	t.Testhashs32Fnv1 = rcv.Testhashs32Fnv1()
+	t.Testhashu32Fnv1 = rcv.Testhashu32Fnv1()
+	t.Testhashs64Fnv1 = rcv.Testhashs64Fnv1()
+	t.Testhashu64Fnv1 = rcv.Testhashu64Fnv1()
+	t.Testhashs32Fnv1a = rcv.Testhashs32Fnv1a()
+	t.Testhashu32Fnv1a = rcv.Testhashu32Fnv1a()
+	t.Testhashs64Fnv1a = rcv.Testhashs64Fnv1a()
+	t.Testhashu64Fnv1a = rcv.Testhashu64Fnv1a()
+	testarrayofboolsLength := rcv.TestarrayofboolsLength()
+	if rcv._tab.Offset(52) == 0 {
+		t.Testarrayofbools = nil
+	} else if t.Testarrayofbools == nil || cap(t.Testarrayofbools) < testarrayofboolsLength {
+		t.Testarrayofbools = make([]bool, testarrayofboolsLength)
+	} else {
+		t.Testarrayofbools = t.Testarrayofbools[:testarrayofboolsLength]
+	}
+	for j := 0; j < testarrayofboolsLength; j++ {
+		t.Testarrayofbools[j] = rcv.Testarrayofbools(j)
+	}
+	t.Testf = rcv.Testf()
+	t.Testf2 = rcv.Testf2()
+	t.Testf3 = rcv.Testf3()
+	testarrayofstring2Length := rcv.Testarrayofstring2Length()
+	if rcv._tab.Offset(60) == 0 {
+		t.Testarrayofstring2 = nil
+	} else if t.Testarrayofstring2 == nil || cap(t.Testarrayofstring2) < testarrayofstring2Length {
+		t.Testarrayofstring2 = make([]string, testarrayofstring2Length)
+	} else {
+		t.Testarrayofstring2 = t.Testarrayofstring2[:testarrayofstring2Length]
+	}
+	for j := 0; j < testarrayofstring2Length; j++ {
+		if x := rcv.Testarrayofstring2(j); string(x) != t.Testarrayofstring2[j] {
+			t.Testarrayofstring2[j] = interner.Intern(x)
+		}
+	}
+	testarrayofsortedstructLength := rcv.TestarrayofsortedstructLength()
+	if rcv._tab.Offset(62) == 0 {
+		t.Testarrayofsortedstruct = nil
+	} else if t.Testarrayofsortedstruct == nil || cap(t.Testarrayofsortedstruct) < testarrayofsortedstructLength {
+		t.Testarrayofsortedstruct = make([]*AbilityT, testarrayofsortedstructLength)
+	} else {
+		t.Testarrayofsortedstruct = t.Testarrayofsortedstruct[:testarrayofsortedstructLength]
+	}
+	for j := 0; j < testarrayofsortedstructLength; j++ {
+		x := Ability{}
+		rcv.Testarrayofsortedstruct(&x, j)
+		if t.Testarrayofsortedstruct[j] == nil {
+			t.Testarrayofsortedstruct[j] = &AbilityT{}
+		}
+		x.UnPackTo(t.Testarrayofsortedstruct[j])
+	}
+	t.Flex = rcv.FlexBytes()
+	test5Length := rcv.Test5Length()
+	if rcv._tab.Offset(66) == 0 {
+		t.Test5 = nil
+	} else if t.Test5 == nil || cap(t.Test5) < test5Length {
+		t.Test5 = make([]*TestT, test5Length)
+	} else {
+		t.Test5 = t.Test5[:test5Length]
+	}
+	for j := 0; j < test5Length; j++ {
+		x := Test{}
+		rcv.Test5(&x, j)
+		if t.Test5[j] == nil {
+			t.Test5[j] = &TestT{}
+		}
+		x.UnPackTo(t.Test5[j])
+	}
+	vectorOfLongsLength := rcv.VectorOfLongsLength()
+	if rcv._tab.Offset(68) == 0 {
+		t.VectorOfLongs = nil
+	} else if t.VectorOfLongs == nil || cap(t.VectorOfLongs) < vectorOfLongsLength {
+		t.VectorOfLongs = make([]int64, vectorOfLongsLength)
+	} else {
+		t.VectorOfLongs = t.VectorOfLongs[:vectorOfLongsLength]
+	}
+	for j := 0; j < vectorOfLongsLength; j++ {
+		t.VectorOfLongs[j] = rcv.VectorOfLongs(j)
+	}
+	vectorOfDoublesLength := rcv.VectorOfDoublesLength()
+	if rcv._tab.Offset(70) == 0 {
+		t.VectorOfDoubles = nil
+	} else if t.VectorOfDoubles == nil || cap(t.VectorOfDoubles) < vectorOfDoublesLength {
+		t.VectorOfDoubles = make([]float64, vectorOfDoublesLength)
+	} else {
+		t.VectorOfDoubles = t.VectorOfDoubles[:vectorOfDoublesLength]
+	}
+	for j := 0; j < vectorOfDoublesLength; j++ {
+		t.VectorOfDoubles[j] = rcv.VectorOfDoubles(j)
+	}
+	if x := rcv.ParentNamespaceTest(&MyGame.InParentNamespace{}); x != nil {
+		if t.ParentNamespaceTest == nil {
+			t.ParentNamespaceTest = &MyGame.InParentNamespaceT{}
+		}
+		x.UnPackToInterned(t.ParentNamespaceTest, interner)
+	} else {
+		t.ParentNamespaceTest = nil
+	}
+	vectorOfReferrablesLength := rcv.VectorOfReferrablesLength()
+	if rcv._tab.Offset(74) == 0 {
+		t.VectorOfReferrables = nil
+	} else if t.VectorOfReferrables == nil || cap(t.VectorOfReferrables) < vectorOfReferrablesLength {
+		t.VectorOfReferrables = make([]*ReferrableT, vectorOfReferrablesLength)
+	} else {
+		t.VectorOfReferrables = t.VectorOfReferrables[:vectorOfReferrablesLength]
+	}
+	for j := 0; j < vectorOfReferrablesLength; j++ {
+		x := Referrable{}
+		rcv.VectorOfReferrables(&x, j)
+		if t.VectorOfReferrables[j] == nil {
+			t.VectorOfReferrables[j] = &ReferrableT{}
+		}
+		x.UnPackToInterned(t.VectorOfReferrables[j], interner)
+	}
+	t.SingleWeakReference = rcv.SingleWeakReference()
+	vectorOfWeakReferencesLength := rcv.VectorOfWeakReferencesLength()
+	if rcv._tab.Offset(78) == 0 {
+		t.VectorOfWeakReferences = nil
+	} else if t.VectorOfWeakReferences == nil || cap(t.VectorOfWeakReferences) < vectorOfWeakReferencesLength {
+		t.VectorOfWeakReferences = make([]uint64, vectorOfWeakReferencesLength)
+	} else {
+		t.VectorOfWeakReferences = t.VectorOfWeakReferences[:vectorOfWeakReferencesLength]
+	}
+	for j := 0; j < vectorOfWeakReferencesLength; j++ {
+		t.VectorOfWeakReferences[j] = rcv.VectorOfWeakReferences(j)
+	}
+	vectorOfStrongReferrablesLength := rcv.VectorOfStrongReferrablesLength()
+	if rcv._tab.Offset(80) == 0 {
+		t.VectorOfStrongReferrables = nil
+	} else if t.VectorOfStrongReferrables == nil || cap(t.VectorOfStrongReferrables) < vectorOfStrongReferrablesLength {
+		t.VectorOfStrongReferrables = make([]*ReferrableT, vectorOfStrongReferrablesLength)
+	} else {
+		t.VectorOfStrongReferrables = t.VectorOfStrongReferrables[:vectorOfStrongReferrablesLength]
+	}
+	for j := 0; j < vectorOfStrongReferrablesLength; j++ {
+		x := Referrable{}
+		rcv.VectorOfStrongReferrables(&x, j)
+		if t.VectorOfStrongReferrables[j] == nil {
+			t.VectorOfStrongReferrables[j] = &ReferrableT{}
+		}
+		x.UnPackToInterned(t.VectorOfStrongReferrables[j], interner)
+	}
+	t.CoOwningReference = rcv.CoOwningReference()
+	vectorOfCoOwningReferencesLength := rcv.VectorOfCoOwningReferencesLength()
+	if rcv._tab.Offset(84) == 0 {
+		t.VectorOfCoOwningReferences = nil
+	} else if t.VectorOfCoOwningReferences == nil || cap(t.VectorOfCoOwningReferences) < vectorOfCoOwningReferencesLength {
+		t.VectorOfCoOwningReferences = make([]uint64, vectorOfCoOwningReferencesLength)
+	} else {
+		t.VectorOfCoOwningReferences = t.VectorOfCoOwningReferences[:vectorOfCoOwningReferencesLength]
+	}
+	for j := 0; j < vectorOfCoOwningReferencesLength; j++ {
+		t.VectorOfCoOwningReferences[j] = rcv.VectorOfCoOwningReferences(j)
+	}
+	t.NonOwningReference = rcv.NonOwningReference()
+	vectorOfNonOwningReferencesLength := rcv.VectorOfNonOwningReferencesLength()
+	if rcv._tab.Offset(88) == 0 {
+		t.VectorOfNonOwningReferences = nil
+	} else if t.VectorOfNonOwningReferences == nil || cap(t.VectorOfNonOwningReferences) < vectorOfNonOwningReferencesLength {
+		t.VectorOfNonOwningReferences = make([]uint64, vectorOfNonOwningReferencesLength)
+	} else {
+		t.VectorOfNonOwningReferences = t.VectorOfNonOwningReferences[:vectorOfNonOwningReferencesLength]
+	}
+	for j := 0; j < vectorOfNonOwningReferencesLength; j++ {
+		t.VectorOfNonOwningReferences[j] = rcv.VectorOfNonOwningReferences(j)
+	}
+	anyUniqueTable := flatbuffers.Table{}
+	if rcv.AnyUnique(&anyUniqueTable) {
+		t.AnyUnique = rcv.AnyUniqueType().UnPackTo(anyUniqueTable, t.AnyUnique, interner)
+	} else {
+		t.AnyUnique = nil
+	}
+	anyAmbiguousTable := flatbuffers.Table{}
+	if rcv.AnyAmbiguous(&anyAmbiguousTable) {
+		t.AnyAmbiguous = rcv.AnyAmbiguousType().UnPackTo(anyAmbiguousTable, t.AnyAmbiguous, interner)
+	} else {
+		t.AnyAmbiguous = nil
+	}
+	vectorOfEnumsLength := rcv.VectorOfEnumsLength()
+	if rcv._tab.Offset(98) == 0 {
+		t.VectorOfEnums = nil
+	} else if t.VectorOfEnums == nil || cap(t.VectorOfEnums) < vectorOfEnumsLength {
+		t.VectorOfEnums = make([]Color, vectorOfEnumsLength)
+	} else {
+		t.VectorOfEnums = t.VectorOfEnums[:vectorOfEnumsLength]
+	}
+	for j := 0; j < vectorOfEnumsLength; j++ {
+		t.VectorOfEnums[j] = rcv.VectorOfEnums(j)
+	}
+	t.SignedEnum = rcv.SignedEnum()
+	if x := rcv.TestrequirednestedflatbufferNestedRoot(); x != nil {
+		if t.Testrequirednestedflatbuffer == nil {
+			t.Testrequirednestedflatbuffer = &MonsterT{}
+		}
+		x.UnPackToInterned(t.Testrequirednestedflatbuffer, interner)
+	} else {
+		t.Testrequirednestedflatbuffer = nil
+	}
+	scalarKeySortedTablesLength := rcv.ScalarKeySortedTablesLength()
+	if rcv._tab.Offset(104) == 0 {
+		t.ScalarKeySortedTables = nil
+	} else if t.ScalarKeySortedTables == nil || cap(t.ScalarKeySortedTables) < scalarKeySortedTablesLength {
+		t.ScalarKeySortedTables = make([]*StatT, scalarKeySortedTablesLength)
+	} else {
+		t.ScalarKeySortedTables = t.ScalarKeySortedTables[:scalarKeySortedTablesLength]
+	}
+	for j := 0; j < scalarKeySortedTablesLength; j++ {
+		x := Stat{}
+		rcv.ScalarKeySortedTables(&x, j)
+		if t.ScalarKeySortedTables[j] == nil {
+			t.ScalarKeySortedTables[j] = &StatT{}
+		}
+		x.UnPackToInterned(t.ScalarKeySortedTables[j], interner)
+	}
+	if x := rcv.NativeInline(&Test{}); x != nil {
+		if t.NativeInline == nil {
+			t.NativeInline = &TestT{}
+		}
+		x.UnPackTo(t.NativeInline)
+	} else {
+		t.NativeInline = nil
+	}
+	t.LongEnumNonEnumDefault = rcv.LongEnumNonEnumDefault()
+	t.LongEnumNormalDefault = rcv.LongEnumNormalDefault()
+	t.NanDefault = rcv.NanDefault()
+	t.InfDefault = rcv.InfDefault()
+	t.PositiveInfDefault = rcv.PositiveInfDefault()
+	t.InfinityDefault = rcv.InfinityDefault()
+	t.PositiveInfinityDefault = rcv.PositiveInfinityDefault()
+	t.NegativeInfDefault = rcv.NegativeInfDefault()
+	t.NegativeInfinityDefault = rcv.NegativeInfinityDefault()
+	t.DoubleInfDefault = rcv.DoubleInfDefault()
+}
+
+func (rcv *Monster) UnPack() *MonsterT {
+	if rcv == nil {
+		return nil
+	}
+	t := &MonsterT{}
+	rcv.UnPackTo(t)
+	return t
+}
+
+// Reset sets t to the zero MonsterT, except that its vectors keep
+// their capacity for UnPackTo, which sets those its table lacks to nil.
+// A vector that was not nil is packed as empty until then.
+func (t *MonsterT) Reset() {
+	*t = MonsterT{
+		Test4: t.Test4[:0],
+		Testarrayofstring: t.Testarrayofstring[:0],
+		Testarrayoftables: t.Testarrayoftables[:0],
+		Testarrayofbools: t.Testarrayofbools[:0],
+		Testarrayofstring2: t.Testarrayofstring2[:0],
+		Testarrayofsortedstruct: t.Testarrayofsortedstruct[:0],
+		Test5: t.Test5[:0],
+		VectorOfLongs: t.VectorOfLongs[:0],
+		VectorOfDoubles: t.VectorOfDoubles[:0],
+		VectorOfReferrables: t.VectorOfReferrables[:0],
+		VectorOfWeakReferences: t.VectorOfWeakReferences[:0],
+		VectorOfStrongReferrables: t.VectorOfStrongReferrables[:0],
+		VectorOfCoOwningReferences: t.VectorOfCoOwningReferences[:0],
+		VectorOfNonOwningReferences: t.VectorOfNonOwningReferences[:0],
+		VectorOfEnums: t.VectorOfEnums[:0],
+		ScalarKeySortedTables: t.ScalarKeySortedTables[:0],
+	}
+}
+
+// Splice writes a copy of the Monster and the data it references to
+// builder, and returns its offset, like Pack without unpacking it.
+func (rcv *Monster) Splice(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	nameOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(10)); o != 0 {
+		nameOffset = builder.CreateByteString(rcv._tab.ByteVector(o + rcv._tab.Pos))
+	}
+	inventoryOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(14)); o != 0 {
+		inventoryOffset = builder.SpliceVector(&rcv._tab, o, 1, 1)
+	}
+	testOffset := flatbuffers.UOffsetT(0)
+	testTable := flatbuffers.Table{}
+	if rcv.Test(&testTable) {
+		testOffset = rcv.TestType().Splice(builder, testTable)
+	}
+	test4Offset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(22)); o != 0 {
+		test4Offset = builder.SpliceVector(&rcv._tab, o, 4, 2)
+	}
+	testarrayofstringOffset := flatbuffers.UOffsetT(0)
+	if flatbuffers.UOffsetT(rcv._tab.Offset(24)) != 0 {
+		testarrayofstringLength := rcv.TestarrayofstringLength()
+		testarrayofstringOffsets := make([]flatbuffers.UOffsetT, testarrayofstringLength)
+		for j := 0; j < testarrayofstringLength; j++ {
+			testarrayofstringOffsets[j] = builder.CreateByteString(rcv.Testarrayofstring(j))
+		}
+		MonsterStartTestarrayofstringVector(builder, testarrayofstringLength)
+		for j := testarrayofstringLength - 1; j >= 0; j-- {
+			builder.PrependUOffsetT(testarrayofstringOffsets[j])
+		}
+		testarrayofstringOffset = builder.EndVector(testarrayofstringLength)
+	}
+	testarrayoftablesOffset := flatbuffers.UOffsetT(0)
+	if flatbuffers.UOffsetT(rcv._tab.Offset(26)) != 0 {
+		testarrayoftablesLength := rcv.TestarrayoftablesLength()
+		testarrayoftablesOffsets := make([]flatbuffers.UOffsetT, testarrayoftablesLength)
+		for j := 0; j < testarrayoftablesLength; j++ {
+			x := Monster{}
+			rcv.Testarrayoftables(&x, j)
+			testarrayoftablesOffsets[j] = x.Splice(builder)
+		}
+		MonsterStartTestarrayoftablesVector(builder, testarrayoftablesLength)
+		for j := testarrayoftablesLength - 1; j >= 0; j-- {
+			builder.PrependUOffsetT(testarrayoftablesOffsets[j])
+		}
+		testarrayoftablesOffset = builder.EndVector(testarrayoftablesLength)
+	}
+	enemyOffset := rcv.Enemy(nil).Splice(builder)
+	testnestedflatbufferOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(30)); o != 0 {
+		testnestedflatbufferOffset = builder.SpliceVector(&rcv._tab, o, 1, 8)
+	}
+	testemptyOffset := rcv.Testempty(nil).Splice(builder)
+	testarrayofboolsOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(52)); o != 0 {
+		testarrayofboolsOffset = builder.SpliceVector(&rcv._tab, o, 1, 1)
+	}
+	testarrayofstring2Offset := flatbuffers.UOffsetT(0)
+	if flatbuffers.UOffsetT(rcv._tab.Offset(60)) != 0 {
+		testarrayofstring2Length := rcv.Testarrayofstring2Length()
+		testarrayofstring2Offsets := make([]flatbuffers.UOffsetT, testarrayofstring2Length)
+		for j := 0; j < testarrayofstring2Length; j++ {
+			testarrayofstring2Offsets[j] = builder.CreateByteString(rcv.Testarrayofstring2(j))
+		}
+		MonsterStartTestarrayofstring2Vector(builder, testarrayofstring2Length)
+		for j := testarrayofstring2Length - 1; j >= 0; j-- {
+			builder.PrependUOffsetT(testarrayofstring2Offsets[j])
+		}
+		testarrayofstring2Offset = builder.EndVector(testarrayofstring2Length)
+	}
+	testarrayofsortedstructOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(62)); o != 0 {
+		testarrayofsortedstructOffset = builder.SpliceVector(&rcv._tab, o, 8, 4)
+	}
+	flexOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(64)); o != 0 {
+		flexOffset = builder.SpliceVector(&rcv._tab, o, 1, 1)
+	}
+	test5Offset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(66)); o != 0 {
+		test5Offset = builder.SpliceVector(&rcv._tab, o, 4, 2)
+	}
+	vectorOfLongsOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(68)); o != 0 {
+		vectorOfLongsOffset = builder.SpliceVector(&rcv._tab, o, 8, 8)
+	}
+	vectorOfDoublesOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(70)); o != 0 {
+		vectorOfDoublesOffset = builder.SpliceVector(&rcv._tab, o, 8, 8)
+	}
+	parentNamespaceTestOffset := rcv.ParentNamespaceTest(nil).Splice(builder)
+	vectorOfReferrablesOffset := flatbuffers.UOffsetT(0)
+	if flatbuffers.UOffsetT(rcv._tab.Offset(74)) != 0 {
+		vectorOfReferrablesLength := rcv.VectorOfReferrablesLength()
+		vectorOfReferrablesOffsets := make([]flatbuffers.UOffsetT, vectorOfReferrablesLength)
+		for j := 0; j < vectorOfReferrablesLength; j++ {
+			x := Referrable{}
+			rcv.VectorOfReferrables(&x, j)
+			vectorOfReferrablesOffsets[j] = x.Splice(builder)
+		}
+		MonsterStartVectorOfReferrablesVector(builder, vectorOfReferrablesLength)
+		for j := vectorOfReferrablesLength - 1; j >= 0; j-- {
+			builder.PrependUOffsetT(vectorOfReferrablesOffsets[j])
+		}
+		vectorOfReferrablesOffset = builder.EndVector(vectorOfReferrablesLength)
+	}
+	vectorOfWeakReferencesOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(78)); o != 0 {
+		vectorOfWeakReferencesOffset = builder.SpliceVector(&rcv._tab, o, 8, 8)
+	}
+	vectorOfStrongReferrablesOffset := flatbuffers.UOffsetT(0)
+	if flatbuffers.UOffsetT(rcv._tab.Offset(80)) != 0 {
+		vectorOfStrongReferrablesLength := rcv.VectorOfStrongReferrablesLength()
+		vectorOfStrongReferrablesOffsets := make([]flatbuffers.UOffsetT, vectorOfStrongReferrablesLength)
+		for j := 0; j < vectorOfStrongReferrablesLength; j++ {
+			x := Referrable{}
+			rcv.VectorOfStrongReferrables(&x, j)
+			vectorOfStrongReferrablesOffsets[j] = x.Splice(builder)
+		}
+		MonsterStartVectorOfStrongReferrablesVector(builder, vectorOfStrongReferrablesLength)
+		for j := vectorOfStrongReferrablesLength - 1; j >= 0; j-- {
+			builder.PrependUOffsetT(vectorOfStrongReferrablesOffsets[j])
+		}
+		vectorOfStrongReferrablesOffset = builder.EndVector(vectorOfStrongReferrablesLength)
+	}
+	vectorOfCoOwningReferencesOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(84)); o != 0 {
+		vectorOfCoOwningReferencesOffset = builder.SpliceVector(&rcv._tab, o, 8, 8)
+	}
+	vectorOfNonOwningReferencesOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(88)); o != 0 {
+		vectorOfNonOwningReferencesOffset = builder.SpliceVector(&rcv._tab, o, 8, 8)
+	}
+	anyUniqueOffset := flatbuffers.UOffsetT(0)
+	anyUniqueTable := flatbuffers.Table{}
+	if rcv.AnyUnique(&anyUniqueTable) {
+		anyUniqueOffset = rcv.AnyUniqueType().Splice(builder, anyUniqueTable)
+	}
+	anyAmbiguousOffset := flatbuffers.UOffsetT(0)
+	anyAmbiguousTable := flatbuffers.Table{}
+	if rcv.AnyAmbiguous(&anyAmbiguousTable) {
+		anyAmbiguousOffset = rcv.AnyAmbiguousType().Splice(builder, anyAmbiguousTable)
+	}
+	vectorOfEnumsOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(98)); o != 0 {
+		vectorOfEnumsOffset = builder.SpliceVector(&rcv._tab, o, 1, 1)
+	}
+	testrequirednestedflatbufferOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(102)); o != 0 {
+		testrequirednestedflatbufferOffset = builder.SpliceVector(&rcv._tab, o, 1, 8)
+	}
+	scalarKeySortedTablesOffset := flatbuffers.UOffsetT(0)
+	if flatbuffers.UOffsetT(rcv._tab.Offset(104)) != 0 {
+		scalarKeySortedTablesLength := rcv.ScalarKeySortedTablesLength()
+		scalarKeySortedTablesOffsets := make([]flatbuffers.UOffsetT, scalarKeySortedTablesLength)
+		for j := 0; j < scalarKeySortedTablesLength; j++ {
+			x := Stat{}
+			rcv.ScalarKeySortedTables(&x, j)
+			scalarKeySortedTablesOffsets[j] = x.Splice(builder)
+		}
+		MonsterStartScalarKeySortedTablesVector(builder, scalarKeySortedTablesLength)
+		for j := scalarKeySortedTablesLength - 1; j >= 0; j-- {
+			builder.PrependUOffsetT(scalarKeySortedTablesOffsets[j])
+		}
+		scalarKeySortedTablesOffset = builder.EndVector(scalarKeySortedTablesLength)
+	}
+	MonsterStart(builder)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(4)); o != 0 {
+		MonsterAddPos(builder, builder.SpliceStruct(&rcv._tab, o, 32, 8))
+	}
+	MonsterAddMana(builder, rcv.Mana())
+	MonsterAddHp(builder, rcv.Hp())
+	MonsterAddName(builder, nameOffset)
+	MonsterAddInventory(builder, inventoryOffset)
+	MonsterAddColor(builder, rcv.Color())
+	MonsterAddTestType(builder, rcv.TestType())
+	MonsterAddTest(builder, testOffset)
+	MonsterAddTest4(builder, test4Offset)
+	MonsterAddTestarrayofstring(builder, testarrayofstringOffset)
+	MonsterAddTestarrayoftables(builder, testarrayoftablesOffset)
+	MonsterAddEnemy(builder, enemyOffset)
+	MonsterAddTestnestedflatbuffer(builder, testnestedflatbufferOffset)
+	MonsterAddTestempty(builder, testemptyOffset)
+	MonsterAddTestbool(builder, rcv.Testbool())
+	MonsterAddTesthashs32Fnv1(builder, rcv.Testhashs32Fnv1())
+	MonsterAddTesthashu32Fnv1(builder, rcv.Testhashu32Fnv1())
+	MonsterAddTesthashs64Fnv1(builder, rcv.Testhashs64Fnv1())
+	MonsterAddTesthashu64Fnv1(builder, rcv.Testhashu64Fnv1())
+	MonsterAddTesthashs32Fnv1a(builder, rcv.Testhashs32Fnv1a())
+	MonsterAddTesthashu32Fnv1a(builder, rcv.Testhashu32Fnv1a())
+	MonsterAddTesthashs64Fnv1a(builder, rcv.Testhashs64Fnv1a())
+	MonsterAddTesthashu64Fnv1a(builder, rcv.Testhashu64Fnv1a())
+	MonsterAddTestarrayofbools(builder, testarrayofboolsOffset)
+	MonsterAddTestf(builder, rcv.Testf())
+	MonsterAddTestf2(builder, rcv.Testf2())
+	MonsterAddTestf3(builder, rcv.Testf3())
+	MonsterAddTestarrayofstring2(builder, testarrayofstring2Offset)
+	MonsterAddTestarrayofsortedstruct(builder, testarrayofsortedstructOffset)
+	MonsterAddFlex(builder, flexOffset)
+	MonsterAddTest5(builder, test5Offset)
+	MonsterAddVectorOfLongs(builder, vectorOfLongsOffset)
+	MonsterAddVectorOfDoubles(builder, vectorOfDoublesOffset)
+	MonsterAddParentNamespaceTest(builder, parentNamespaceTestOffset)
+	MonsterAddVectorOfReferrables(builder, vectorOfReferrablesOffset)
+	MonsterAddSingleWeakReference(builder, rcv.SingleWeakReference())
+	MonsterAddVectorOfWeakReferences(builder, vectorOfWeakReferencesOffset)
+	MonsterAddVectorOfStrongReferrables(builder, vectorOfStrongReferrablesOffset)
+	MonsterAddCoOwningReference(builder, rcv.CoOwningReference())
+	MonsterAddVectorOfCoOwningReferences(builder, vectorOfCoOwningReferencesOffset)
+	MonsterAddNonOwningReference(builder, rcv.NonOwningReference())
+	MonsterAddVectorOfNonOwningReferences(builder, vectorOfNonOwningReferencesOffset)
+	MonsterAddAnyUniqueType(builder, rcv.AnyUniqueType())
+	MonsterAddAnyUnique(builder, anyUniqueOffset)
+	MonsterAddAnyAmbiguousType(builder, rcv.AnyAmbiguousType())
+	MonsterAddAnyAmbiguous(builder, anyAmbiguousOffset)
+	MonsterAddVectorOfEnums(builder, vectorOfEnumsOffset)
+	MonsterAddSignedEnum(builder, rcv.SignedEnum())
+	MonsterAddTestrequirednestedflatbuffer(builder, testrequirednestedflatbufferOffset)
+	MonsterAddScalarKeySortedTables(builder, scalarKeySortedTablesOffset)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(106)); o != 0 {
+		MonsterAddNativeInline(builder, builder.SpliceStruct(&rcv._tab, o, 4, 2))
+	}
+	MonsterAddLongEnumNonEnumDefault(builder, rcv.LongEnumNonEnumDefault())
+	MonsterAddLongEnumNormalDefault(builder, rcv.LongEnumNormalDefault())
+	MonsterAddNanDefault(builder, rcv.NanDefault())
+	MonsterAddInfDefault(builder, rcv.InfDefault())
+	MonsterAddPositiveInfDefault(builder, rcv.PositiveInfDefault())
+	MonsterAddInfinityDefault(builder, rcv.InfinityDefault())
+	MonsterAddPositiveInfinityDefault(builder, rcv.PositiveInfinityDefault())
+	MonsterAddNegativeInfDefault(builder, rcv.NegativeInfDefault())
+	MonsterAddNegativeInfinityDefault(builder, rcv.NegativeInfinityDefault())
+	MonsterAddDoubleInfDefault(builder, rcv.DoubleInfDefault())
+	return MonsterEnd(builder)
+}
+
+// MonsterMask selects fields of a Monster for UnPackWithMask and ProjectTo.
+// A table, or a vector of tables, is selected by a mask of the fields of its
+// tables. All selects every field, including those of nested tables, and a
+// nil mask selects none.
+type MonsterMask struct {
+	All bool
+	Pos bool
+	Mana bool
+	Hp bool
+	Name bool
+	Inventory bool
+	Color bool
+	Test bool
+	Test4 bool
+	Testarrayofstring bool
+	Testarrayoftables *MonsterMask
+	Enemy *MonsterMask
+	Testnestedflatbuffer bool
+	Testempty *StatMask
+	Testbool bool
+	Testhashs32Fnv1 bool
+	Testhashu32Fnv1 bool
+	Testhashs64Fnv1 bool
+	Testhashu64Fnv1 bool
+	Testhashs32Fnv1a bool
+	Testhashu32Fnv1a bool
+	Testhashs64Fnv1a bool
+	Testhashu64Fnv1a bool
+	Testarrayofbools bool
+	Testf bool
+	Testf2 bool
+	Testf3 bool
+	Testarrayofstring2 bool
+	Testarrayofsortedstruct bool
+	Flex bool
+	Test5 bool
+	VectorOfLongs bool
+	VectorOfDoubles bool
+	ParentNamespaceTest *MyGame.InParentNamespaceMask
+	VectorOfReferrables *ReferrableMask
+	SingleWeakReference bool
+	VectorOfWeakReferences bool
+	VectorOfStrongReferrables *ReferrableMask
+	CoOwningReference bool
+	VectorOfCoOwningReferences bool
+	NonOwningReference bool
+	VectorOfNonOwningReferences bool
+	AnyUnique bool
+	AnyAmbiguous bool
+	VectorOfEnums bool
+	SignedEnum bool
+	Testrequirednestedflatbuffer bool
+	ScalarKeySortedTables *StatMask
+	NativeInline bool
+	LongEnumNonEnumDefault bool
+	LongEnumNormalDefault bool
+	NanDefault bool
+	InfDefault bool
+	PositiveInfDefault bool
+	InfinityDefault bool
+	PositiveInfinityDefault bool
+	NegativeInfDefault bool
+	NegativeInfinityDefault bool
+	DoubleInfDefault bool
+}
+
+// UnPackWithMask unpacks the fields of rcv selected by mask into a new
+// MonsterT, leaving the others zero.
+func (rcv *Monster) UnPackWithMask(mask *MonsterMask) *MonsterT {
+	if rcv == nil {
+		return nil
+	}
+	if mask == nil {
+		mask = &MonsterMask{}
+	}
+	if mask.All {
+		return rcv.UnPack()
+	}
+	t := &MonsterT{}
+	if mask.Pos {
+		t.Pos = rcv.Pos(nil).UnPack()
+	}
+	if mask.Mana {
+		t.Mana = rcv.Mana()
+	}
+	if mask.Hp {
+		t.Hp = rcv.Hp()
+	}
+	if mask.Name {
+		t.Name = string(rcv.Name())
+	}
+	if mask.Inventory {
+		t.Inventory = rcv.InventoryBytes()
+	}
+	if mask.Color {
+		t.Color = rcv.Color()
+	}
+	if mask.Test {
+		testTable := flatbuffers.Table{}
+		if rcv.Test(&testTable) {
+			t.Test = rcv.TestType().UnPack(testTable)
+		}
+	}
+	if mask.Test4 {
+		test4Length := rcv.Test4Length()
+		t.Test4 = make([]*TestT, test4Length)
+		for j := 0; j < test4Length; j++ {
+			x := Test{}
+			rcv.Test4(&x, j)
+			t.Test4[j] = x.UnPack()
+		}
+	}
+	if mask.Testarrayofstring {
+		testarrayofstringLength := rcv.TestarrayofstringLength()
+		t.Testarrayofstring = make([]string, testarrayofstringLength)
+		for j := 0; j < testarrayofstringLength; j++ {
+			t.Testarrayofstring[j] = string(rcv.Testarrayofstring(j))
+		}
+	}
+	if mask.Testarrayoftables != nil {
+		testarrayoftablesLength := rcv.TestarrayoftablesLength()
+		t.Testarrayoftables = make([]*MonsterT, testarrayoftablesLength)
+		for j := 0; j < testarrayoftablesLength; j++ {
+			x := Monster{}
+			rcv.Testarrayoftables(&x, j)
+			t.Testarrayoftables[j] = x.UnPackWithMask(mask.Testarrayoftables)
+		}
+	}
+	if mask.Enemy != nil {
+		t.Enemy = rcv.Enemy(nil).UnPackWithMask(mask.Enemy)
+	}
+	if mask.Testnestedflatbuffer {
+		t.Testnestedflatbuffer = rcv.TestnestedflatbufferNestedRoot().UnPack()
+	}
+	if mask.Testempty != nil {
+		t.Testempty = rcv.Testempty(nil).UnPackWithMask(mask.Testempty)
+	}
+	if mask.Testbool {
+		t.Testbool = rcv.Testbool()
+	}
+	if mask.Testhashs32Fnv1 {
+		t.Testhashs32Fnv1 = rcv.Testhashs32Fnv1()
+	}
+	if mask.Testhashu32Fnv1 {
+		t.Testhashu32Fnv1 = rcv.Testhashu32Fnv1()
+	}
+	if mask.Testhashs64Fnv1 {
+		t.Testhashs64Fnv1 = rcv.Testhashs64Fnv1()
+	}
+	if mask.Testhashu64Fnv1 {
+		t.Testhashu64Fnv1 = rcv.Testhashu64Fnv1()
+	}
+	if mask.Testhashs32Fnv1a {
+		t.Testhashs32Fnv1a = rcv.Testhashs32Fnv1a()
+	}
+	if mask.Testhashu32Fnv1a {
+		t.Testhashu32Fnv1a = rcv.Testhashu32Fnv1a()
+	}
+	if mask.Testhashs64Fnv1a {
+		t.Testhashs64Fnv1a = rcv.Testhashs64Fnv1a()
+	}
+	if mask.Testhashu64Fnv1a {
+		t.Testhashu64Fnv1a = rcv.Testhashu64Fnv1a()
+	}
+	if mask.Testarrayofbools {
+		testarrayofboolsLength := rcv.TestarrayofboolsLength()
+		t.Testarrayofbools = make([]bool, testarrayofboolsLength)
+		for j := 0; j < testarrayofboolsLength; j++ {
+			t.Testarrayofbools[j] = rcv.Testarrayofbools(j)
+		}
+	}
+	if mask.Testf {
+		t.Testf = rcv.Testf()
+	}
+	if mask.Testf2 {
+		t.Testf2 = rcv.Testf2()
+	}
+	if mask.Testf3 {
+		t.Testf3 = rcv.Testf3()
+	}
+	if mask.Testarrayofstring2 {
+		testarrayofstring2Length := rcv.Testarrayofstring2Length()
+		t.Testarrayofstring2 = make([]string, testarrayofstring2Length)
+		for j := 0; j < testarrayofstring2Length; j++ {
+			t.Testarrayofstring2[j] = string(rcv.Testarrayofstring2(j))
+		}
+	}
+	if mask.Testarrayofsortedstruct {
+		testarrayofsortedstructLength := rcv.TestarrayofsortedstructLength()
+		t.Testarrayofsortedstruct = make([]*AbilityT, testarrayofsortedstructLength)
+		for j := 0; j < testarrayofsortedstructLength; j++ {
+			x := Ability{}
+			rcv.Testarrayofsortedstruct(&x, j)
+			t.Testarrayofsortedstruct[j] = x.UnPack()
+		}
+	}
+	if mask.Flex {
+		t.Flex = rcv.FlexBytes()
+	}
+	if mask.Test5 {
+		test5Length := rcv.Test5Length()
+		t.Test5 = make([]*TestT, test5Length)
+		for j := 0; j < test5Length; j++ {
+			x := Test{}
+			rcv.Test5(&x, j)
+			t.Test5[j] = x.UnPack()
+		}
+	}
+	if mask.VectorOfLongs {
+		vectorOfLongsLength := rcv.VectorOfLongsLength()
+		t.VectorOfLongs = make([]int64, vectorOfLongsLength)
+		for j := 0; j < vectorOfLongsLength; j++ {
+			t.VectorOfLongs[j] = rcv.VectorOfLongs(j)
+		}
+	}
+	if mask.VectorOfDoubles {
+		vectorOfDoublesLength := rcv.VectorOfDoublesLength()
+		t.VectorOfDoubles = make([]float64, vectorOfDoublesLength)
+		for j := 0; j < vectorOfDoublesLength; j++ {
+			t.VectorOfDoubles[j] = rcv.VectorOfDoubles(j)
+		}
+	}
+	if mask.ParentNamespaceTest != nil {
+		t.ParentNamespaceTest = rcv.ParentNamespaceTest(nil).UnPackWithMask(mask.ParentNamespaceTest)
+	}
+	if mask.VectorOfReferrables != nil {
+		vectorOfReferrablesLength := rcv.VectorOfReferrablesLength()
+		t.VectorOfReferrables = make([]*ReferrableT, vectorOfReferrablesLength)
+		for j := 0; j < vectorOfReferrablesLength; j++ {
+			x := Referrable{}
+			rcv.VectorOfReferrables(&x, j)
+			t.VectorOfReferrables[j] = x.UnPackWithMask(mask.VectorOfReferrables)
+		}
+	}
+	if mask.SingleWeakReference {
+		t.SingleWeakReference = rcv.SingleWeakReference()
+	}
+	if mask.VectorOfWeakReferences {
+		vectorOfWeakReferencesLength := rcv.VectorOfWeakReferencesLength()
+		t.VectorOfWeakReferences = make([]uint64, vectorOfWeakReferencesLength)
+		for j := 0; j < vectorOfWeakReferencesLength; j++ {
+			t.VectorOfWeakReferences[j] = rcv.VectorOfWeakReferences(j)
+		}
+	}
+	if mask.VectorOfStrongReferrables != nil {
+		vectorOfStrongReferrablesLength := rcv.VectorOfStrongReferrablesLength()
+		t.VectorOfStrongReferrables = make([]*ReferrableT, vectorOfStrongReferrablesLength)
+		for j := 0; j < vectorOfStrongReferrablesLength; j++ {
+			x := Referrable{}
+			rcv.VectorOfStrongReferrables(&x, j)
+			t.VectorOfStrongReferrables[j] = x.UnPackWithMask(mask.VectorOfStrongReferrables)
+		}
+	}
+	if mask.CoOwningReference {
+		t.CoOwningReference = rcv.CoOwningReference()
+	}
+	if mask.VectorOfCoOwningReferences {
+		vectorOfCoOwningReferencesLength := rcv.VectorOfCoOwningReferencesLength()
+		t.VectorOfCoOwningReferences = make([]uint64, vectorOfCoOwningReferencesLength)
+		for j := 0; j < vectorOfCoOwningReferencesLength; j++ {
+			t.VectorOfCoOwningReferences[j] = rcv.VectorOfCoOwningReferences(j)
+		}
+	}
+	if mask.NonOwningReference {
+		t.NonOwningReference = rcv.NonOwningReference()
+	}
+	if mask.VectorOfNonOwningReferences {
+		vectorOfNonOwningReferencesLength := rcv.VectorOfNonOwningReferencesLength()
+		t.VectorOfNonOwningReferences = make([]uint64, vectorOfNonOwningReferencesLength)
+		for j := 0; j < vectorOfNonOwningReferencesLength; j++ {
+			t.VectorOfNonOwningReferences[j] = rcv.VectorOfNonOwningReferences(j)
+		}
+	}
+	if mask.AnyUnique {
+		anyUniqueTable := flatbuffers.Table{}
+		if rcv.AnyUnique(&anyUniqueTable) {
+			t.AnyUnique = rcv.AnyUniqueType().UnPack(anyUniqueTable)
+		}
+	}
+	if mask.AnyAmbiguous {
+		anyAmbiguousTable := flatbuffers.Table{}
+		if rcv.AnyAmbiguous(&anyAmbiguousTable) {
+			t.AnyAmbiguous = rcv.AnyAmbiguousType().UnPack(anyAmbiguousTable)
+		}
+	}
+	if mask.VectorOfEnums {
+		vectorOfEnumsLength := rcv.VectorOfEnumsLength()
+		t.VectorOfEnums = make([]Color, vectorOfEnumsLength)
+		for j := 0; j < vectorOfEnumsLength; j++ {
+			t.VectorOfEnums[j] = rcv.VectorOfEnums(j)
+		}
+	}
+	if mask.SignedEnum {
+		t.SignedEnum = rcv.SignedEnum()
+	}
+	if mask.Testrequirednestedflatbuffer {
+		t.Testrequirednestedflatbuffer = rcv.TestrequirednestedflatbufferNestedRoot().UnPack()
+	}
+	if mask.ScalarKeySortedTables != nil {
+		scalarKeySortedTablesLength := rcv.ScalarKeySortedTablesLength()
+		t.ScalarKeySortedTables = make([]*StatT, scalarKeySortedTablesLength)
+		for j := 0; j < scalarKeySortedTablesLength; j++ {
+			x := Stat{}
+			rcv.ScalarKeySortedTables(&x, j)
+			t.ScalarKeySortedTables[j] = x.UnPackWithMask(mask.ScalarKeySortedTables)
+		}
+	}
+	if mask.NativeInline {
+		t.NativeInline = rcv.NativeInline(nil).UnPack()
+	}
+	if mask.LongEnumNonEnumDefault {
+		t.LongEnumNonEnumDefault = rcv.LongEnumNonEnumDefault()
+	}
+	if mask.LongEnumNormalDefault {
+		t.LongEnumNormalDefault = rcv.LongEnumNormalDefault()
+	}
+	if mask.NanDefault {
+		t.NanDefault = rcv.NanDefault()
+	}
+	if mask.InfDefault {
+		t.InfDefault = rcv.InfDefault()
+	}
+	if mask.PositiveInfDefault {
+		t.PositiveInfDefault = rcv.PositiveInfDefault()
+	}
+	if mask.InfinityDefault {
+		t.InfinityDefault = rcv.InfinityDefault()
+	}
+	if mask.PositiveInfinityDefault {
+		t.PositiveInfinityDefault = rcv.PositiveInfinityDefault()
+	}
+	if mask.NegativeInfDefault {
+		t.NegativeInfDefault = rcv.NegativeInfDefault()
+	}
+	if mask.NegativeInfinityDefault {
+		t.NegativeInfinityDefault = rcv.NegativeInfinityDefault()
+	}
+	if mask.DoubleInfDefault {
+		t.DoubleInfDefault = rcv.DoubleInfDefault()
+	}
+	return t
+}
+
+// ProjectTo writes a copy of the fields of the Monster selected by mask, and
+// the data they reference, to builder, and returns its offset. Dropping a
+// required field records an error in builder.
+func (rcv *Monster) ProjectTo(builder *flatbuffers.Builder, mask *MonsterMask) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	if mask == nil {
+		mask = &MonsterMask{}
+	}
+	if mask.All {
+		return rcv.Splice(builder)
+	}
+	nameOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(10)); o != 0 && mask.Name {
+		nameOffset = builder.CreateByteString(rcv._tab.ByteVector(o + rcv._tab.Pos))
+	}
+	inventoryOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(14)); o != 0 && mask.Inventory {
+		inventoryOffset = builder.SpliceVector(&rcv._tab, o, 1, 1)
+	}
+	testOffset := flatbuffers.UOffsetT(0)
+	testTable := flatbuffers.Table{}
+	if mask.Test && rcv.Test(&testTable) {
+		testOffset = rcv.TestType().Splice(builder, testTable)
+	}
+	test4Offset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(22)); o != 0 && mask.Test4 {
+		test4Offset = builder.SpliceVector(&rcv._tab, o, 4, 2)
+	}
+	testarrayofstringOffset := flatbuffers.UOffsetT(0)
+	if flatbuffers.UOffsetT(rcv._tab.Offset(24)) != 0 && mask.Testarrayofstring {
+		testarrayofstringLength := rcv.TestarrayofstringLength()
+		testarrayofstringOffsets := make([]flatbuffers.UOffsetT, testarrayofstringLength)
+		for j := 0; j < testarrayofstringLength; j++ {
+			testarrayofstringOffsets[j] = builder.CreateByteString(rcv.Testarrayofstring(j))
+		}
+		MonsterStartTestarrayofstringVector(builder, testarrayofstringLength)
+		for j := testarrayofstringLength - 1; j >= 0; j-- {
+			builder.PrependUOffsetT(testarrayofstringOffsets[j])
+		}
+		testarrayofstringOffset = builder.EndVector(testarrayofstringLength)
+	}
+	testarrayoftablesOffset := flatbuffers.UOffsetT(0)
+	if flatbuffers.UOffsetT(rcv._tab.Offset(26)) != 0 && mask.Testarrayoftables != nil {
+		testarrayoftablesLength := rcv.TestarrayoftablesLength()
+		testarrayoftablesOffsets := make([]flatbuffers.UOffsetT, testarrayoftablesLength)
+		for j := 0; j < testarrayoftablesLength; j++ {
+			x := Monster{}
+			rcv.Testarrayoftables(&x, j)
+			testarrayoftablesOffsets[j] = x.ProjectTo(builder, mask.Testarrayoftables)
+		}
+		MonsterStartTestarrayoftablesVector(builder, testarrayoftablesLength)
+		for j := testarrayoftablesLength - 1; j >= 0; j-- {
+			builder.PrependUOffsetT(testarrayoftablesOffsets[j])
+		}
+		testarrayoftablesOffset = builder.EndVector(testarrayoftablesLength)
+	}
+	enemyOffset := flatbuffers.UOffsetT(0)
+	if mask.Enemy != nil {
+		enemyOffset = rcv.Enemy(nil).ProjectTo(builder, mask.Enemy)
+	}
+	testnestedflatbufferOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(30)); o != 0 && mask.Testnestedflatbuffer {
+		testnestedflatbufferOffset = builder.SpliceVector(&rcv._tab, o, 1, 8)
+	}
+	testemptyOffset := flatbuffers.UOffsetT(0)
+	if mask.Testempty != nil {
+		testemptyOffset = rcv.Testempty(nil).ProjectTo(builder, mask.Testempty)
+	}
+	testarrayofboolsOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(52)); o != 0 && mask.Testarrayofbools {
+		testarrayofboolsOffset = builder.SpliceVector(&rcv._tab, o, 1, 1)
+	}
+	testarrayofstring2Offset := flatbuffers.UOffsetT(0)
+	if flatbuffers.UOffsetT(rcv._tab.Offset(60)) != 0 && mask.Testarrayofstring2 {
+		testarrayofstring2Length := rcv.Testarrayofstring2Length()
+		testarrayofstring2Offsets := make([]flatbuffers.UOffsetT, testarrayofstring2Length)
+		for j := 0; j < testarrayofstring2Length; j++ {
+			testarrayofstring2Offsets[j] = builder.CreateByteString(rcv.Testarrayofstring2(j))
+		}
+		MonsterStartTestarrayofstring2Vector(builder, testarrayofstring2Length)
+		for j := testarrayofstring2Length - 1; j >= 0; j-- {
+			builder.PrependUOffsetT(testarrayofstring2Offsets[j])
+		}
+		testarrayofstring2Offset = builder.EndVector(testarrayofstring2Length)
+	}
+	testarrayofsortedstructOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(62)); o != 0 && mask.Testarrayofsortedstruct {
+		testarrayofsortedstructOffset = builder.SpliceVector(&rcv._tab, o, 8, 4)
+	}
+	flexOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(64)); o != 0 && mask.Flex {
+		flexOffset = builder.SpliceVector(&rcv._tab, o, 1, 1)
+	}
+	test5Offset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(66)); o != 0 && mask.Test5 {
+		test5Offset = builder.SpliceVector(&rcv._tab, o, 4, 2)
+	}
+	vectorOfLongsOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(68)); o != 0 && mask.VectorOfLongs {
+		vectorOfLongsOffset = builder.SpliceVector(&rcv._tab, o, 8, 8)
+	}
+	vectorOfDoublesOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(70)); o != 0 && mask.VectorOfDoubles {
+		vectorOfDoublesOffset = builder.SpliceVector(&rcv._tab, o, 8, 8)
+	}
+	parentNamespaceTestOffset := flatbuffers.UOffsetT(0)
+	if mask.ParentNamespaceTest != nil {
+		parentNamespaceTestOffset = rcv.ParentNamespaceTest(nil).ProjectTo(builder, mask.ParentNamespaceTest)
+	}
+	vectorOfReferrablesOffset := flatbuffers.UOffsetT(0)
+	if flatbuffers.UOffsetT(rcv._tab.Offset(74)) != 0 && mask.VectorOfReferrables != nil {
+		vectorOfReferrablesLength := rcv.VectorOfReferrablesLength()
+		vectorOfReferrablesOffsets := make([]flatbuffers.UOffsetT, vectorOfReferrablesLength)
+		for j := 0; j < vectorOfReferrablesLength; j++ {
+			x := Referrable{}
+			rcv.VectorOfReferrables(&x, j)
+			vectorOfReferrablesOffsets[j] = x.ProjectTo(builder, mask.VectorOfReferrables)
+		}
+		MonsterStartVectorOfReferrablesVector(builder, vectorOfReferrablesLength)
+		for j := vectorOfReferrablesLength - 1; j >= 0; j-- {
+			builder.PrependUOffsetT(vectorOfReferrablesOffsets[j])
+		}
+		vectorOfReferrablesOffset = builder.EndVector(vectorOfReferrablesLength)
+	}
+	vectorOfWeakReferencesOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(78)); o != 0 && mask.VectorOfWeakReferences {
+		vectorOfWeakReferencesOffset = builder.SpliceVector(&rcv._tab, o, 8, 8)
+	}
+	vectorOfStrongReferrablesOffset := flatbuffers.UOffsetT(0)
+	if flatbuffers.UOffsetT(rcv._tab.Offset(80)) != 0 && mask.VectorOfStrongReferrables != nil {
+		vectorOfStrongReferrablesLength := rcv.VectorOfStrongReferrablesLength()
+		vectorOfStrongReferrablesOffsets := make([]flatbuffers.UOffsetT, vectorOfStrongReferrablesLength)
+		for j := 0; j < vectorOfStrongReferrablesLength; j++ {
+			x := Referrable{}
+			rcv.VectorOfStrongReferrables(&x, j)
+			vectorOfStrongReferrablesOffsets[j] = x.ProjectTo(builder, mask.VectorOfStrongReferrables)
+		}
+		MonsterStartVectorOfStrongReferrablesVector(builder, vectorOfStrongReferrablesLength)
+		for j := vectorOfStrongReferrablesLength - 1; j >= 0; j-- {
+			builder.PrependUOffsetT(vectorOfStrongReferrablesOffsets[j])
+		}
+		vectorOfStrongReferrablesOffset = builder.EndVector(vectorOfStrongReferrablesLength)
+	}
+	vectorOfCoOwningReferencesOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(84)); o != 0 && mask.VectorOfCoOwningReferences {
+		vectorOfCoOwningReferencesOffset = builder.SpliceVector(&rcv._tab, o, 8, 8)
+	}
+	vectorOfNonOwningReferencesOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(88)); o != 0 && mask.VectorOfNonOwningReferences {
+		vectorOfNonOwningReferencesOffset = builder.SpliceVector(&rcv._tab, o, 8, 8)
+	}
+	anyUniqueOffset := flatbuffers.UOffsetT(0)
+	anyUniqueTable := flatbuffers.Table{}
+	if mask.AnyUnique && rcv.AnyUnique(&anyUniqueTable) {
+		anyUniqueOffset = rcv.AnyUniqueType().Splice(builder, anyUniqueTable)
+	}
+	anyAmbiguousOffset := flatbuffers.UOffsetT(0)
+	anyAmbiguousTable := flatbuffers.Table{}
+	if mask.AnyAmbiguous && rcv.AnyAmbiguous(&anyAmbiguousTable) {
+		anyAmbiguousOffset = rcv.AnyAmbiguousType().Splice(builder, anyAmbiguousTable)
+	}
+	vectorOfEnumsOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(98)); o != 0 && mask.VectorOfEnums {
+		vectorOfEnumsOffset = builder.SpliceVector(&rcv._tab, o, 1, 1)
+	}
+	testrequirednestedflatbufferOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(102)); o != 0 && mask.Testrequirednestedflatbuffer {
+		testrequirednestedflatbufferOffset = builder.SpliceVector(&rcv._tab, o, 1, 8)
+	}
+	scalarKeySortedTablesOffset := flatbuffers.UOffsetT(0)
+	if flatbuffers.UOffsetT(rcv._tab.Offset(104)) != 0 && mask.ScalarKeySortedTables != nil {
+		scalarKeySortedTablesLength := rcv.ScalarKeySortedTablesLength()
+		scalarKeySortedTablesOffsets := make([]flatbuffers.UOffsetT, scalarKeySortedTablesLength)
+		for j := 0; j < scalarKeySortedTablesLength; j++ {
+			x := Stat{}
+			rcv.ScalarKeySortedTables(&x, j)
+			scalarKeySortedTablesOffsets[j] = x.ProjectTo(builder, mask.ScalarKeySortedTables)
+		}
+		MonsterStartScalarKeySortedTablesVector(builder, scalarKeySortedTablesLength)
+		for j := scalarKeySortedTablesLength - 1; j >= 0; j-- {
+			builder.PrependUOffsetT(scalarKeySortedTablesOffsets[j])
+		}
+		scalarKeySortedTablesOffset = builder.EndVector(scalarKeySortedTablesLength)
+	}
+	MonsterStart(builder)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(4)); o != 0 && mask.Pos {
+		MonsterAddPos(builder, builder.SpliceStruct(&rcv._tab, o, 32, 8))
+	}
+	if mask.Mana {
+		MonsterAddMana(builder, rcv.Mana())
+	}
+	if mask.Hp {
+		MonsterAddHp(builder, rcv.Hp())
+	}
+	MonsterAddName(builder, nameOffset)
+	MonsterAddInventory(builder, inventoryOffset)
+	if mask.Color {
+		MonsterAddColor(builder, rcv.Color())
+	}
+	if mask.Test {
+		MonsterAddTestType(builder, rcv.TestType())
+	}
+	MonsterAddTest(builder, testOffset)
+	MonsterAddTest4(builder, test4Offset)
+	MonsterAddTestarrayofstring(builder, testarrayofstringOffset)
+	MonsterAddTestarrayoftables(builder, testarrayoftablesOffset)
+	MonsterAddEnemy(builder, enemyOffset)
+	MonsterAddTestnestedflatbuffer(builder, testnestedflatbufferOffset)
+	MonsterAddTestempty(builder, testemptyOffset)
+	if mask.Testbool {
+		MonsterAddTestbool(builder, rcv.Testbool())
+	}
+	if mask.Testhashs32Fnv1 {
+		MonsterAddTesthashs32Fnv1(builder, rcv.Testhashs32Fnv1())
+	}
+	if mask.Testhashu32Fnv1 {
+		MonsterAddTesthashu32Fnv1(builder, rcv.Testhashu32Fnv1())
+	}
+	if mask.Testhashs64Fnv1 {
+		MonsterAddTesthashs64Fnv1(builder, rcv.Testhashs64Fnv1())
+	}
+	if mask.Testhashu64Fnv1 {
+		MonsterAddTesthashu64Fnv1(builder, rcv.Testhashu64Fnv1())
+	}
+	if mask.Testhashs32Fnv1a {
+		MonsterAddTesthashs32Fnv1a(builder, rcv.Testhashs32Fnv1a())
+	}
+	if mask.Testhashu32Fnv1a {
+		MonsterAddTesthashu32Fnv1a(builder, rcv.Testhashu32Fnv1a())
+	}
+	if mask.Testhashs64Fnv1a {
+		MonsterAddTesthashs64Fnv1a(builder, rcv.Testhashs64Fnv1a())
+	}
+	if mask.Testhashu64Fnv1a {
+		MonsterAddTesthashu64Fnv1a(builder, rcv.Testhashu64Fnv1a())
+	}
+	MonsterAddTestarrayofbools(builder, testarrayofboolsOffset)
+	if mask.Testf {
+		MonsterAddTestf(builder, rcv.Testf())
+	}
+	if mask.Testf2 {
+		MonsterAddTestf2(builder, rcv.Testf2())
+	}
+	if mask.Testf3 {
+		MonsterAddTestf3(builder, rcv.Testf3())
+	}
+	MonsterAddTestarrayofstring2(builder, testarrayofstring2Offset)
+	MonsterAddTestarrayofsortedstruct(builder, testarrayofsortedstructOffset)
+	MonsterAddFlex(builder, flexOffset)
+	MonsterAddTest5(builder, test5Offset)
+	MonsterAddVectorOfLongs(builder, vectorOfLongsOffset)
+	MonsterAddVectorOfDoubles(builder, vectorOfDoublesOffset)
+	MonsterAddParentNamespaceTest(builder, parentNamespaceTestOffset)
+	MonsterAddVectorOfReferrables(builder, vectorOfReferrablesOffset)
+	if mask.SingleWeakReference {
+		MonsterAddSingleWeakReference(builder, rcv.SingleWeakReference())
+	}
+	MonsterAddVectorOfWeakReferences(builder, vectorOfWeakReferencesOffset)
+	MonsterAddVectorOfStrongReferrables(builder, vectorOfStrongReferrablesOffset)
+	if mask.CoOwningReference {
+		MonsterAddCoOwningReference(builder, rcv.CoOwningReference())
+	}
+	MonsterAddVectorOfCoOwningReferences(builder, vectorOfCoOwningReferencesOffset)
+	if mask.NonOwningReference {
+		MonsterAddNonOwningReference(builder, rcv.NonOwningReference())
+	}
+	MonsterAddVectorOfNonOwningReferences(builder, vectorOfNonOwningReferencesOffset)
+	if mask.AnyUnique {
+		MonsterAddAnyUniqueType(builder, rcv.AnyUniqueType())
+	}
+	MonsterAddAnyUnique(builder, anyUniqueOffset)
+	if mask.AnyAmbiguous {
+		MonsterAddAnyAmbiguousType(builder, rcv.AnyAmbiguousType())
+	}
+	MonsterAddAnyAmbiguous(builder, anyAmbiguousOffset)
+	MonsterAddVectorOfEnums(builder, vectorOfEnumsOffset)
+	if mask.SignedEnum {
+		MonsterAddSignedEnum(builder, rcv.SignedEnum())
+	}
+	MonsterAddTestrequirednestedflatbuffer(builder, testrequirednestedflatbufferOffset)
+	MonsterAddScalarKeySortedTables(builder, scalarKeySortedTablesOffset)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(106)); o != 0 && mask.NativeInline {
+		MonsterAddNativeInline(builder, builder.SpliceStruct(&rcv._tab, o, 4, 2))
+	}
+	if mask.LongEnumNonEnumDefault {
+		MonsterAddLongEnumNonEnumDefault(builder, rcv.LongEnumNonEnumDefault())
+	}
+	if mask.LongEnumNormalDefault {
+		MonsterAddLongEnumNormalDefault(builder, rcv.LongEnumNormalDefault())
+	}
+	if mask.NanDefault {
+		MonsterAddNanDefault(builder, rcv.NanDefault())
+	}
+	if mask.InfDefault {
+		MonsterAddInfDefault(builder, rcv.InfDefault())
+	}
+	if mask.PositiveInfDefault {
+		MonsterAddPositiveInfDefault(builder, rcv.PositiveInfDefault())
+	}
+	if mask.InfinityDefault {
+		MonsterAddInfinityDefault(builder, rcv.InfinityDefault())
+	}
+	if mask.PositiveInfinityDefault {
+		MonsterAddPositiveInfinityDefault(builder, rcv.PositiveInfinityDefault())
+	}
+	if mask.NegativeInfDefault {
+		MonsterAddNegativeInfDefault(builder, rcv.NegativeInfDefault())
+	}
+	if mask.NegativeInfinityDefault {
+		MonsterAddNegativeInfinityDefault(builder, rcv.NegativeInfinityDefault())
+	}
+	if mask.DoubleInfDefault {
+		MonsterAddDoubleInfDefault(builder, rcv.DoubleInfDefault())
+	}
+	return MonsterEnd(builder)
+}
+
+func (t *MonsterT) SetTesthashs32Fnv1FromString(s string) {
+	t.Testhashs32Fnv1 = int32(flathash.Fnv1Hash32(s))
+}
+
+func (t *MonsterT) SetTesthashu32Fnv1FromString(s string) {
+	t.Testhashu32Fnv1 = uint32(flathash.Fnv1Hash32(s))
+}
+
+func (t *MonsterT) SetTesthashs64Fnv1FromString(s string) {
+	t.Testhashs64Fnv1 = int64(flathash.Fnv1Hash64(s))
+}
+
+func (t *MonsterT) SetTesthashu64Fnv1FromString(s string) {
+	t.Testhashu64Fnv1 = uint64(flathash.Fnv1Hash64(s))
+}
+
+func (t *MonsterT) SetTesthashs32Fnv1aFromString(s string) {
+	t.Testhashs32Fnv1a = int32(flathash.Fnv1aHash32(s))
+}
+
+func (t *MonsterT) SetTesthashu32Fnv1aFromString(s string) {
+	t.Testhashu32Fnv1a = uint32(flathash.Fnv1aHash32(s))
+}
+
+func (t *MonsterT) SetTesthashs64Fnv1aFromString(s string) {
+	t.Testhashs64Fnv1a = int64(flathash.Fnv1aHash64(s))
+}
+
+func (t *MonsterT) SetTesthashu64Fnv1aFromString(s string) {
+	t.Testhashu64Fnv1a = uint64(flathash.Fnv1aHash64(s))
+}
+
+func (t *MonsterT) SetSingleWeakReferenceFromString(s string) {
+	t.SingleWeakReference = uint64(flathash.Fnv1aHash64(s))
+}
+
+func (t *MonsterT) SetVectorOfWeakReferencesFromStrings(s []string) {
+	t.VectorOfWeakReferences = make([]uint64, len(s))
+	for j := range s {
+		t.VectorOfWeakReferences[j] = uint64(flathash.Fnv1aHash64(s[j]))
+	}
+}
+
+func (t *MonsterT) SetCoOwningReferenceFromString(s string) {
+	t.CoOwningReference = uint64(flathash.Fnv1aHash64(s))
+}
+
+func (t *MonsterT) SetVectorOfCoOwningReferencesFromStrings(s []string) {
+	t.VectorOfCoOwningReferences = make([]uint64, len(s))
+	for j := range s {
+		t.VectorOfCoOwningReferences[j] = uint64(flathash.Fnv1aHash64(s[j]))
 	}
-	MonsterAddAnyAmbiguous(builder, anyAmbiguousOffset)
-	MonsterAddVectorOfEnums(builder, vectorOfEnumsOffset)
-	MonsterAddSignedEnum(builder, t.SignedEnum)
-	MonsterAddTestrequirednestedflatbuffer(builder, testrequirednestedflatbufferOffset)
-	MonsterAddScalarKeySortedTables(builder, scalarKeySortedTablesOffset)
-	nativeInlineOffset := t.NativeInline.Pack(builder)
-	MonsterAddNativeInline(builder, nativeInlineOffset)
-	MonsterAddLongEnumNonEnumDefault(builder, t.LongEnumNonEnumDefault)
-	MonsterAddLongEnumNormalDefault(builder, t.LongEnumNormalDefault)
-	MonsterAddNanDefault(builder, t.NanDefault)
-	MonsterAddInfDefault(builder, t.InfDefault)
-	MonsterAddPositiveInfDefault(builder, t.PositiveInfDefault)
-	MonsterAddInfinityDefault(builder, t.InfinityDefault)
-	MonsterAddPositiveInfinityDefault(builder, t.PositiveInfinityDefault)
-	MonsterAddNegativeInfDefault(builder, t.NegativeInfDefault)
-	MonsterAddNegativeInfinityDefault(builder, t.NegativeInfinityDefault)
-	MonsterAddDoubleInfDefault(builder, t.DoubleInfDefault)
-	return MonsterEnd(builder)
 }
 
-func (rcv *Monster) UnPackTo(t *MonsterT) {
-	t.Pos = rcv.Pos(nil).UnPack()
-	t.Mana = rcv.Mana()
-	t.Hp = rcv.Hp()
-	t.Name = string(rcv.Name())
-	t.Inventory = rcv.InventoryBytes()
-	t.Color = rcv.Color()
-	testTable := flatbuffers.Table{}
-	if rcv.Test(&testTable) {
-		t.Test = rcv.TestType().UnPack(testTable)
+func (t *MonsterT) SetNonOwningReferenceFromString(s string) {
+	t.NonOwningReference = uint64(flathash.Fnv1aHash64(s))
+}
+
+func (t *MonsterT) SetVectorOfNonOwningReferencesFromStrings(s []string) {
+	t.VectorOfNonOwningReferences = make([]uint64, len(s))
+	for j := range s {
+		t.VectorOfNonOwningReferences[j] = uint64(flathash.Fnv1aHash64(s[j]))
 	}
-	test4Length := rcv.Test4Length()
-	t.Test4 = make([]*TestT, test4Length)
-	for j := 0; j < test4Length; j++ {
-		x := Test{}
-		rcv.Test4(&x, j)
-		t.Test4[j] = x.UnPack()
+}
+
+// RandomMonsterT returns a MonsterT with random fields from r. Required
+// fields are always set.
+func RandomMonsterT(r *flatbuffers.Random) *MonsterT {
+	r.Enter()
+	defer r.Leave()
+	t := &MonsterT{}
+	if r.Optional() {
+		t.Pos = RandomVec3T(r)
+	}
+	t.Mana = r.Int16()
+	t.Hp = r.Int16()
+	t.Name = r.Text()
+	if r.Optional() {
+		t.Inventory = make([]byte, r.Length())
+		for j := range t.Inventory {
+			t.Inventory[j] = r.Byte()
+		}
 	}
-	testarrayofstringLength := rcv.TestarrayofstringLength()
-	t.Testarrayofstring = make([]string, testarrayofstringLength)
-	for j := 0; j < testarrayofstringLength; j++ {
-		t.Testarrayofstring[j] = string(rcv.Testarrayofstring(j))
+	t.Color = RandomColor(r)
+	t.Test = RandomAnyT(r)
+	if r.Optional() {
+		t.Test4 = make([]*TestT, r.Length())
+		for j := range t.Test4 {
+			t.Test4[j] = RandomTestT(r)
+		}
 	}
-	testarrayoftablesLength := rcv.TestarrayoftablesLength()
-	t.Testarrayoftables = make([]*MonsterT, testarrayoftablesLength)
-	for j := 0; j < testarrayoftablesLength; j++ {
-		x := Monster{}
-		rcv.Testarrayoftables(&x, j)
-		t.Testarrayoftables[j] = x.UnPack()
+	if r.Optional() {
+		t.Testarrayofstring = make([]string, r.Length())
+		for j := range t.Testarrayofstring {
+			t.Testarrayofstring[j] = r.Text()
+		}
 	}
-	t.Enemy = rcv.Enemy(nil).UnPack()
-	t.Testnestedflatbuffer = rcv.TestnestedflatbufferBytes()
-	t.Testempty = rcv.Testempty(nil).UnPack()
-	t.Testbool = rcv.Testbool()
-	t.Testhashs32Fnv1 = rcv.Testhashs32Fnv1()
-	t.Testhashu32Fnv1 = rcv.Testhashu32Fnv1()
-	t.Testhashs64Fnv1 = rcv.Testhashs64Fnv1()
-	t.Testhashu64Fnv1 = rcv.Testhashu64Fnv1()
-	t.Testhashs32Fnv1a = rcv.Testhashs32Fnv1a()
-	t.Testhashu32Fnv1a = rcv.Testhashu32Fnv1a()
-	t.Testhashs64Fnv1a = rcv.Testhashs64Fnv1a()
-	t.Testhashu64Fnv1a = rcv.Testhashu64Fnv1a()
-	testarrayofboolsLength := rcv.TestarrayofboolsLength()
-	t.Testarrayofbools = make([]bool, testarrayofboolsLength)
-	for j := 0; j < testarrayofboolsLength; j++ {
-		t.Testarrayofbools[j] = rcv.Testarrayofbools(j)
+	if r.Child() {
+		t.Testarrayoftables = make([]*MonsterT, r.Length())
+		for j := range t.Testarrayoftables {
+			t.Testarrayoftables[j] = RandomMonsterT(r)
+		}
 	}
-	t.Testf = rcv.Testf()
-	t.Testf2 = rcv.Testf2()
-	t.Testf3 = rcv.Testf3()
-	testarrayofstring2Length := rcv.Testarrayofstring2Length()
-	t.Testarrayofstring2 = make([]string, testarrayofstring2Length)
-	for j := 0; j < testarrayofstring2Length; j++ {
-		t.Testarrayofstring2[j] = string(rcv.Testarrayofstring2(j))
+	if r.Child() {
+		t.Enemy = RandomMonsterT(r)
+	}
+	if r.Child() {
+		t.Testnestedflatbuffer = RandomMonsterT(r)
+	}
+	if r.Child() {
+		t.Testempty = RandomStatT(r)
+	}
+	t.Testbool = r.Bool()
+	t.Testhashs32Fnv1 = r.Int32()
+	t.Testhashu32Fnv1 = r.Uint32()
+	t.Testhashs64Fnv1 = r.Int64()
+	t.Testhashu64Fnv1 = r.Uint64()
+	t.Testhashs32Fnv1a = r.Int32()
+	t.Testhashu32Fnv1a = r.Uint32()
+	t.Testhashs64Fnv1a = r.Int64()
+	t.Testhashu64Fnv1a = r.Uint64()
+	if r.Optional() {
+		t.Testarrayofbools = make([]bool, r.Length())
+		for j := range t.Testarrayofbools {
+			t.Testarrayofbools[j] = r.Bool()
+		}
 	}
-	testarrayofsortedstructLength := rcv.TestarrayofsortedstructLength()
-	t.Testarrayofsortedstruct = make([]*AbilityT, testarrayofsortedstructLength)
-	for j := 0; j < testarrayofsortedstructLength; j++ {
-		x := Ability{}
-		rcv.Testarrayofsortedstruct(&x, j)
-		t.Testarrayofsortedstruct[j] = x.UnPack()
+	t.Testf = r.Float32()
+	t.Testf2 = r.Float32()
+	t.Testf3 = r.Float32()
+	if r.Optional() {
+		t.Testarrayofstring2 = make([]string, r.Length())
+		for j := range t.Testarrayofstring2 {
+			t.Testarrayofstring2[j] = r.Text()
+		}
 	}
-	t.Flex = rcv.FlexBytes()
-	test5Length := rcv.Test5Length()
-	t.Test5 = make([]*TestT, test5Length)
-	for j := 0; j < test5Length; j++ {
-		x := Test{}
-		rcv.Test5(&x, j)
-		t.Test5[j] = x.UnPack()
+	if r.Optional() {
+		t.Testarrayofsortedstruct = make([]*AbilityT, r.Length())
+		for j := range t.Testarrayofsortedstruct {
+			t.Testarrayofsortedstruct[j] = RandomAbilityT(r)
+		}
 	}
-	vectorOfLongsLength := rcv.VectorOfLongsLength()
-	t.VectorOfLongs = make([]int64, vectorOfLongsLength)
-	for j := 0; j < vectorOfLongsLength; j++ {
-		t.VectorOfLongs[j] = rcv.VectorOfLongs(j)
+	if r.Optional() {
+		t.Flex = make([]byte, r.Length())
+		for j := range t.Flex {
+			t.Flex[j] = r.Byte()
+		}
 	}
-	vectorOfDoublesLength := rcv.VectorOfDoublesLength()
-	t.VectorOfDoubles = make([]float64, vectorOfDoublesLength)
-	for j := 0; j < vectorOfDoublesLength; j++ {
-		t.VectorOfDoubles[j] = rcv.VectorOfDoubles(j)
+	if r.Optional() {
+		t.Test5 = make([]*TestT, r.Length())
+		for j := range t.Test5 {
+			t.Test5[j] = RandomTestT(r)
+		}
 	}
-	t.ParentNamespaceTest = rcv.ParentNamespaceTest(nil).UnPack()
-	vectorOfReferrablesLength := rcv.VectorOfReferrablesLength()
-	t.VectorOfReferrables = make([]*ReferrableT, vectorOfReferrablesLength)
-	for j := 0; j < vectorOfReferrablesLength; j++ {
-		x := Referrable{}
-		rcv.VectorOfReferrables(&x, j)
-		t.VectorOfReferrables[j] = x.UnPack()
+	if r.Optional() {
+		t.VectorOfLongs = make([]int64, r.Length())
+		for j := range t.VectorOfLongs {
+			t.VectorOfLongs[j] = r.Int64()
+		}
 	}
-	t.SingleWeakReference = rcv.SingleWeakReference()
-	vectorOfWeakReferencesLength := rcv.VectorOfWeakReferencesLength()
-	t.VectorOfWeakReferences = make([]uint64, vectorOfWeakReferencesLength)
-	for j := 0; j < vectorOfWeakReferencesLength; j++ {
-		t.VectorOfWeakReferences[j] = rcv.VectorOfWeakReferences(j)
+	if r.Optional() {
+		t.VectorOfDoubles = make([]float64, r.Length())
+		for j := range t.VectorOfDoubles {
+			t.VectorOfDoubles[j] = r.Float64()
+		}
 	}
-	vectorOfStrongReferrablesLength := rcv.VectorOfStrongReferrablesLength()
-	t.VectorOfStrongReferrables = make([]*ReferrableT, vectorOfStrongReferrablesLength)
-	for j := 0; j < vectorOfStrongReferrablesLength; j++ {
-		x := Referrable{}
-		rcv.VectorOfStrongReferrables(&x, j)
-		t.VectorOfStrongReferrables[j] = x.UnPack()
+	if r.Child() {
+		t.ParentNamespaceTest = MyGame.RandomInParentNamespaceT(r)
 	}
-	t.CoOwningReference = rcv.CoOwningReference()
-	vectorOfCoOwningReferencesLength := rcv.VectorOfCoOwningReferencesLength()
-	t.VectorOfCoOwningReferences = make([]uint64, vectorOfCoOwningReferencesLength)
-	for j := 0; j < vectorOfCoOwningReferencesLength; j++ {
-		t.VectorOfCoOwningReferences[j] = rcv.VectorOfCoOwningReferences(j)
+	if r.Child() {
+		t.VectorOfReferrables = make([]*ReferrableT, r.Length())
+		for j := range t.VectorOfReferrables {
+			t.VectorOfReferrables[j] = RandomReferrableT(r)
+		}
 	}
-	t.NonOwningReference = rcv.NonOwningReference()
-	vectorOfNonOwningReferencesLength := rcv.VectorOfNonOwningReferencesLength()
-	t.VectorOfNonOwningReferences = make([]uint64, vectorOfNonOwningReferencesLength)
-	for j := 0; j < vectorOfNonOwningReferencesLength; j++ {
-		t.VectorOfNonOwningReferences[j] = rcv.VectorOfNonOwningReferences(j)
+	t.SingleWeakReference = r.Uint64()
+	if r.Optional() {
+		t.VectorOfWeakReferences = make([]uint64, r.Length())
+		for j := range t.VectorOfWeakReferences {
+			t.VectorOfWeakReferences[j] = r.Uint64()
+		}
 	}
-	anyUniqueTable := flatbuffers.Table{}
-	if rcv.AnyUnique(&anyUniqueTable) {
-		t.AnyUnique = rcv.AnyUniqueType().UnPack(anyUniqueTable)
+	if r.Child() {
+		t.VectorOfStrongReferrables = make([]*ReferrableT, r.Length())
+		for j := range t.VectorOfStrongReferrables {
+			t.VectorOfStrongReferrables[j] = RandomReferrableT(r)
+		}
 	}
-	anyAmbiguousTable := flatbuffers.Table{}
-	if rcv.AnyAmbiguous(&anyAmbiguousTable) {
-		t.AnyAmbiguous = rcv.AnyAmbiguousType().UnPack(anyAmbiguousTable)
+	t.CoOwningReference = r.Uint64()
+	if r.Optional() {
+		t.VectorOfCoOwningReferences = make([]uint64, r.Length())
+		for j := range t.VectorOfCoOwningReferences {
+			t.VectorOfCoOwningReferences[j] = r.Uint64()
+		}
 	}
-	vectorOfEnumsLength := rcv.VectorOfEnumsLength()
-	t.VectorOfEnums = make([]Color, vectorOfEnumsLength)
-	for j := 0; j < vectorOfEnumsLength; j++ {
-		t.VectorOfEnums[j] = rcv.VectorOfEnums(j)
+	t.NonOwningReference = r.Uint64()
+	if r.Optional() {
+		t.VectorOfNonOwningReferences = make([]uint64, r.Length())
+		for j := range t.VectorOfNonOwningReferences {
+			t.VectorOfNonOwningReferences[j] = r.Uint64()
+		}
 	}
-	t.SignedEnum = rcv.SignedEnum()
-	t.Testrequirednestedflatbuffer = rcv.TestrequirednestedflatbufferBytes()
-	scalarKeySortedTablesLength := rcv.ScalarKeySortedTablesLength()
-	t.ScalarKeySortedTables = make([]*StatT, scalarKeySortedTablesLength)
-	for j := 0; j < scalarKeySortedTablesLength; j++ {
-		x := Stat{}
-		rcv.ScalarKeySortedTables(&x, j)
-		t.ScalarKeySortedTables[j] = x.UnPack()
+	t.AnyUnique = RandomAnyUniqueAliasesT(r)
+	t.AnyAmbiguous = RandomAnyAmbiguousAliasesT(r)
+	if r.Optional() {
+		t.VectorOfEnums = make([]Color, r.Length())
+		for j := range t.VectorOfEnums {
+			t.VectorOfEnums[j] = RandomColor(r)
+		}
 	}
-	t.NativeInline = rcv.NativeInline(nil).UnPack()
-	t.LongEnumNonEnumDefault = rcv.LongEnumNonEnumDefault()
-	t.LongEnumNormalDefault = rcv.LongEnumNormalDefault()
-	t.NanDefault = rcv.NanDefault()
-	t.InfDefault = rcv.InfDefault()
-	t.PositiveInfDefault = rcv.PositiveInfDefault()
-	t.InfinityDefault = rcv.InfinityDefault()
-	t.PositiveInfinityDefault = rcv.PositiveInfinityDefault()
-	t.NegativeInfDefault = rcv.NegativeInfDefault()
-	t.NegativeInfinityDefault = rcv.NegativeInfinityDefault()
-	t.DoubleInfDefault = rcv.DoubleInfDefault()
-}
-
-func (rcv *Monster) UnPack() *MonsterT {
-	if rcv == nil {
-		return nil
+	t.SignedEnum = RandomRace(r)
+	if r.Child() {
+		t.Testrequirednestedflatbuffer = RandomMonsterT(r)
 	}
-	t := &MonsterT{}
-	rcv.UnPackTo(t)
+	if r.Child() {
+		t.ScalarKeySortedTables = make([]*StatT, r.Length())
+		for j := range t.ScalarKeySortedTables {
+			t.ScalarKeySortedTables[j] = RandomStatT(r)
+		}
+	}
+	if r.Optional() {
+		t.NativeInline = RandomTestT(r)
+	}
+	t.LongEnumNonEnumDefault = RandomLongEnum(r)
+	t.LongEnumNormalDefault = RandomLongEnum(r)
+	t.NanDefault = r.Float32()
+	t.InfDefault = r.Float32()
+	t.PositiveInfDefault = r.Float32()
+	t.InfinityDefault = r.Float32()
+	t.PositiveInfinityDefault = r.Float32()
+	t.NegativeInfDefault = r.Float32()
+	t.NegativeInfinityDefault = r.Float32()
+	t.DoubleInfDefault = r.Float64()
 	return t
 }
 
@@ -509,6 +1858,13 @@ type Monster struct {
 
 const MonsterIdentifier = "MONS"
 
+// MonsterRootType describes Monster buffers to a flatbuffers.Registry.
+var MonsterRootType = flatbuffers.RootType{
+	Name:       "MyGame.Example.Monster",
+	Identifier: MonsterIdentifier,
+	New:        func() flatbuffers.FlatBuffer { return &Monster{} },
+}
+
 func GetRootAsMonster(buf []byte, offset flatbuffers.UOffsetT) *Monster {
 	n := flatbuffers.GetUOffsetT(buf[offset:])
 	x := &Monster{}
@@ -595,12 +1951,16 @@ func (rcv *Monster) Name() []byte {
 	return nil
 }
 
+// MonsterKey returns the key of the Monster at off in buf, for
+// Builder.CreateVectorOfSortedTablesByKey.
+func MonsterKey(off flatbuffers.UOffsetT, buf []byte) flatbuffers.Key {
+	obj := Monster{}
+	obj.Init(buf, flatbuffers.UOffsetT(len(buf))-off)
+	return flatbuffers.StringKey(obj.Name())
+}
+
 func MonsterKeyCompare(o1, o2 flatbuffers.UOffsetT, buf []byte) bool {
-	obj1 := &Monster{}
-	obj2 := &Monster{}
-	obj1.Init(buf, flatbuffers.UOffsetT(len(buf))-o1)
-	obj2.Init(buf, flatbuffers.UOffsetT(len(buf))-o2)
-	return string(obj1.Name()) < string(obj2.Name())
+	return MonsterKey(o1, buf).Compare(MonsterKey(o2, buf)) < 0
 }
 
 func (rcv *Monster) LookupByKey(key string, vectorLocation flatbuffers.UOffsetT, buf []byte) bool {
@@ -801,6 +2161,14 @@ func (rcv *Monster) TestnestedflatbufferBytes() []byte {
 	return nil
 }
 
+func (rcv *Monster) TestnestedflatbufferNestedRoot() *Monster {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(30))
+	if o != 0 {
+		return GetRootAsMonster(rcv._tab.Bytes, rcv._tab.Vector(o))
+	}
+	return nil
+}
+
 func (rcv *Monster) MutateTestnestedflatbuffer(j int, n byte) bool {
 	o := flatbuffers.UOffsetT(rcv._tab.Offset(30))
 	if o != 0 {
@@ -1432,6 +2800,14 @@ func (rcv *Monster) TestrequirednestedflatbufferBytes() []byte {
 	return nil
 }
 
+func (rcv *Monster) TestrequirednestedflatbufferNestedRoot() *Monster {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(102))
+	if o != 0 {
+		return GetRootAsMonster(rcv._tab.Bytes, rcv._tab.Vector(o))
+	}
+	return nil
+}
+
 func (rcv *Monster) MutateTestrequirednestedflatbuffer(j int, n byte) bool {
 	o := flatbuffers.UOffsetT(rcv._tab.Offset(102))
 	if o != 0 {
@@ -1660,6 +3036,9 @@ func MonsterAddTestnestedflatbuffer(builder *flatbuffers.Builder, testnestedflat
 func MonsterStartTestnestedflatbufferVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
 	return builder.StartVector(1, numElems, 1)
 }
+func MonsterMakeTestnestedflatbufferVector(builder *flatbuffers.Builder, nested *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.CreateNestedFlatBuffer(nested)
+}
 func MonsterAddTestempty(builder *flatbuffers.Builder, testempty flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(14, flatbuffers.UOffsetT(testempty), 0)
 }
@@ -1669,27 +3048,51 @@ func MonsterAddTestbool(builder *flatbuffers.Builder, testbool bool) {
 func MonsterAddTesthashs32Fnv1(builder *flatbuffers.Builder, testhashs32Fnv1 int32) {
 	builder.PrependInt32Slot(16, testhashs32Fnv1, 0)
 }
+func MonsterAddTesthashs32Fnv1FromString(builder *flatbuffers.Builder, testhashs32Fnv1 string) {
+	MonsterAddTesthashs32Fnv1(builder, int32(flathash.Fnv1Hash32(testhashs32Fnv1)))
+}
 func MonsterAddTesthashu32Fnv1(builder *flatbuffers.Builder, testhashu32Fnv1 uint32) {
 	builder.PrependUint32Slot(17, testhashu32Fnv1, 0)
 }
+func MonsterAddTesthashu32Fnv1FromString(builder *flatbuffers.Builder, testhashu32Fnv1 string) {
+	MonsterAddTesthashu32Fnv1(builder, uint32(flathash.Fnv1Hash32(testhashu32Fnv1)))
+}
 func MonsterAddTesthashs64Fnv1(builder *flatbuffers.Builder, testhashs64Fnv1 int64) {
 	builder.PrependInt64Slot(18, testhashs64Fnv1, 0)
 }
+func MonsterAddTesthashs64Fnv1FromString(builder *flatbuffers.Builder, testhashs64Fnv1 string) {
+	MonsterAddTesthashs64Fnv1(builder, int64(flathash.Fnv1Hash64(testhashs64Fnv1)))
+}
 func MonsterAddTesthashu64Fnv1(builder *flatbuffers.Builder, testhashu64Fnv1 uint64) {
 	builder.PrependUint64Slot(19, testhashu64Fnv1, 0)
 }
+func MonsterAddTesthashu64Fnv1FromString(builder *flatbuffers.Builder, testhashu64Fnv1 string) {
+	MonsterAddTesthashu64Fnv1(builder, uint64(flathash.Fnv1Hash64(testhashu64Fnv1)))
+}
 func MonsterAddTesthashs32Fnv1a(builder *flatbuffers.Builder, testhashs32Fnv1a int32) {
 	builder.PrependInt32Slot(20, testhashs32Fnv1a, 0)
 }
+func MonsterAddTesthashs32Fnv1aFromString(builder *flatbuffers.Builder, testhashs32Fnv1a string) {
+	MonsterAddTesthashs32Fnv1a(builder, int32(flathash.Fnv1aHash32(testhashs32Fnv1a)))
+}
 func MonsterAddTesthashu32Fnv1a(builder *flatbuffers.Builder, testhashu32Fnv1a uint32) {
 	builder.PrependUint32Slot(21, testhashu32Fnv1a, 0)
 }
+func MonsterAddTesthashu32Fnv1aFromString(builder *flatbuffers.Builder, testhashu32Fnv1a string) {
+	MonsterAddTesthashu32Fnv1a(builder, uint32(flathash.Fnv1aHash32(testhashu32Fnv1a)))
+}
 func MonsterAddTesthashs64Fnv1a(builder *flatbuffers.Builder, testhashs64Fnv1a int64) {
 	builder.PrependInt64Slot(22, testhashs64Fnv1a, 0)
 }
+func MonsterAddTesthashs64Fnv1aFromString(builder *flatbuffers.Builder, testhashs64Fnv1a string) {
+	MonsterAddTesthashs64Fnv1a(builder, int64(flathash.Fnv1aHash64(testhashs64Fnv1a)))
+}
 func MonsterAddTesthashu64Fnv1a(builder *flatbuffers.Builder, testhashu64Fnv1a uint64) {
 	builder.PrependUint64Slot(23, testhashu64Fnv1a, 0)
 }
+func MonsterAddTesthashu64Fnv1aFromString(builder *flatbuffers.Builder, testhashu64Fnv1a string) {
+	MonsterAddTesthashu64Fnv1a(builder, uint64(flathash.Fnv1aHash64(testhashu64Fnv1a)))
+}
 func MonsterAddTestarrayofbools(builder *flatbuffers.Builder, testarrayofbools flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(24, flatbuffers.UOffsetT(testarrayofbools), 0)
 }
@@ -1753,6 +3156,9 @@ func MonsterStartVectorOfReferrablesVector(builder *flatbuffers.Builder, numElem
 func MonsterAddSingleWeakReference(builder *flatbuffers.Builder, singleWeakReference uint64) {
 	builder.PrependUint64Slot(36, singleWeakReference, 0)
 }
+func MonsterAddSingleWeakReferenceFromString(builder *flatbuffers.Builder, singleWeakReference string) {
+	MonsterAddSingleWeakReference(builder, uint64(flathash.Fnv1aHash64(singleWeakReference)))
+}
 func MonsterAddVectorOfWeakReferences(builder *flatbuffers.Builder, vectorOfWeakReferences flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(37, flatbuffers.UOffsetT(vectorOfWeakReferences), 0)
 }
@@ -1768,6 +3174,9 @@ func MonsterStartVectorOfStrongReferrablesVector(builder *flatbuffers.Builder, n
 func MonsterAddCoOwningReference(builder *flatbuffers.Builder, coOwningReference uint64) {
 	builder.PrependUint64Slot(39, coOwningReference, 0)
 }
+func MonsterAddCoOwningReferenceFromString(builder *flatbuffers.Builder, coOwningReference string) {
+	MonsterAddCoOwningReference(builder, uint64(flathash.Fnv1aHash64(coOwningReference)))
+}
 func MonsterAddVectorOfCoOwningReferences(builder *flatbuffers.Builder, vectorOfCoOwningReferences flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(40, flatbuffers.UOffsetT(vectorOfCoOwningReferences), 0)
 }
@@ -1777,6 +3186,9 @@ func MonsterStartVectorOfCoOwningReferencesVector(builder *flatbuffers.Builder,
 func MonsterAddNonOwningReference(builder *flatbuffers.Builder, nonOwningReference uint64) {
 	builder.PrependUint64Slot(41, nonOwningReference, 0)
 }
+func MonsterAddNonOwningReferenceFromString(builder *flatbuffers.Builder, nonOwningReference string) {
+	MonsterAddNonOwningReference(builder, uint64(flathash.Fnv1aHash64(nonOwningReference)))
+}
 func MonsterAddVectorOfNonOwningReferences(builder *flatbuffers.Builder, vectorOfNonOwningReferences flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(42, flatbuffers.UOffsetT(vectorOfNonOwningReferences), 0)
 }
@@ -1810,6 +3222,9 @@ func MonsterAddTestrequirednestedflatbuffer(builder *flatbuffers.Builder, testre
 func MonsterStartTestrequirednestedflatbufferVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
 	return builder.StartVector(1, numElems, 1)
 }
+func MonsterMakeTestrequirednestedflatbufferVector(builder *flatbuffers.Builder, nested *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.CreateNestedFlatBuffer(nested)
+}
 func MonsterAddScalarKeySortedTables(builder *flatbuffers.Builder, scalarKeySortedTables flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(50, flatbuffers.UOffsetT(scalarKeySortedTables), 0)
 }
@@ -1850,5 +3265,270 @@ func MonsterAddDoubleInfDefault(builder *flatbuffers.Builder, doubleInfDefault f
 	builder.PrependFloat64Slot(61, doubleInfDefault, float64(math.Inf(1)))
 }
 func MonsterEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	builder.Required(3, "MyGame.Example.Monster.name")
 	return builder.EndObject()
 }
+
+// MonsterBuilder builds a Monster with chainable Add methods. It panics
+// if a field is added after Finish, which checks the required fields.
+type MonsterBuilder struct {
+	table flatbuffers.TableBuilder
+}
+
+// NewMonsterBuilder starts a Monster in builder.
+func NewMonsterBuilder(builder *flatbuffers.Builder) *MonsterBuilder {
+	rcv := &MonsterBuilder{}
+	rcv.table.Start(builder, "MyGame.Example.Monster", 62)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddPos(pos flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddPos(rcv.table.Field(0), pos)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddMana(mana int16) *MonsterBuilder {
+	MonsterAddMana(rcv.table.Field(1), mana)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddHp(hp int16) *MonsterBuilder {
+	MonsterAddHp(rcv.table.Field(2), hp)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddName(name flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddName(rcv.table.Field(3), name)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddInventory(inventory flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddInventory(rcv.table.Field(5), inventory)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddColor(color Color) *MonsterBuilder {
+	MonsterAddColor(rcv.table.Field(6), color)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTestType(testType Any) *MonsterBuilder {
+	MonsterAddTestType(rcv.table.Field(7), testType)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTest(test flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddTest(rcv.table.Field(8), test)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTest4(test4 flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddTest4(rcv.table.Field(9), test4)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTestarrayofstring(testarrayofstring flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddTestarrayofstring(rcv.table.Field(10), testarrayofstring)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTestarrayoftables(testarrayoftables flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddTestarrayoftables(rcv.table.Field(11), testarrayoftables)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddEnemy(enemy flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddEnemy(rcv.table.Field(12), enemy)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTestnestedflatbuffer(testnestedflatbuffer flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddTestnestedflatbuffer(rcv.table.Field(13), testnestedflatbuffer)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTestempty(testempty flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddTestempty(rcv.table.Field(14), testempty)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTestbool(testbool bool) *MonsterBuilder {
+	MonsterAddTestbool(rcv.table.Field(15), testbool)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTesthashs32Fnv1(testhashs32Fnv1 int32) *MonsterBuilder {
+	MonsterAddTesthashs32Fnv1(rcv.table.Field(16), testhashs32Fnv1)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTesthashu32Fnv1(testhashu32Fnv1 uint32) *MonsterBuilder {
+	MonsterAddTesthashu32Fnv1(rcv.table.Field(17), testhashu32Fnv1)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTesthashs64Fnv1(testhashs64Fnv1 int64) *MonsterBuilder {
+	MonsterAddTesthashs64Fnv1(rcv.table.Field(18), testhashs64Fnv1)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTesthashu64Fnv1(testhashu64Fnv1 uint64) *MonsterBuilder {
+	MonsterAddTesthashu64Fnv1(rcv.table.Field(19), testhashu64Fnv1)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTesthashs32Fnv1a(testhashs32Fnv1a int32) *MonsterBuilder {
+	MonsterAddTesthashs32Fnv1a(rcv.table.Field(20), testhashs32Fnv1a)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTesthashu32Fnv1a(testhashu32Fnv1a uint32) *MonsterBuilder {
+	MonsterAddTesthashu32Fnv1a(rcv.table.Field(21), testhashu32Fnv1a)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTesthashs64Fnv1a(testhashs64Fnv1a int64) *MonsterBuilder {
+	MonsterAddTesthashs64Fnv1a(rcv.table.Field(22), testhashs64Fnv1a)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTesthashu64Fnv1a(testhashu64Fnv1a uint64) *MonsterBuilder {
+	MonsterAddTesthashu64Fnv1a(rcv.table.Field(23), testhashu64Fnv1a)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTestarrayofbools(testarrayofbools flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddTestarrayofbools(rcv.table.Field(24), testarrayofbools)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTestf(testf float32) *MonsterBuilder {
+	MonsterAddTestf(rcv.table.Field(25), testf)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTestf2(testf2 float32) *MonsterBuilder {
+	MonsterAddTestf2(rcv.table.Field(26), testf2)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTestf3(testf3 float32) *MonsterBuilder {
+	MonsterAddTestf3(rcv.table.Field(27), testf3)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTestarrayofstring2(testarrayofstring2 flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddTestarrayofstring2(rcv.table.Field(28), testarrayofstring2)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTestarrayofsortedstruct(testarrayofsortedstruct flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddTestarrayofsortedstruct(rcv.table.Field(29), testarrayofsortedstruct)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddFlex(flex flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddFlex(rcv.table.Field(30), flex)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTest5(test5 flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddTest5(rcv.table.Field(31), test5)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddVectorOfLongs(vectorOfLongs flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddVectorOfLongs(rcv.table.Field(32), vectorOfLongs)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddVectorOfDoubles(vectorOfDoubles flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddVectorOfDoubles(rcv.table.Field(33), vectorOfDoubles)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddParentNamespaceTest(parentNamespaceTest flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddParentNamespaceTest(rcv.table.Field(34), parentNamespaceTest)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddVectorOfReferrables(vectorOfReferrables flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddVectorOfReferrables(rcv.table.Field(35), vectorOfReferrables)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddSingleWeakReference(singleWeakReference uint64) *MonsterBuilder {
+	MonsterAddSingleWeakReference(rcv.table.Field(36), singleWeakReference)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddVectorOfWeakReferences(vectorOfWeakReferences flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddVectorOfWeakReferences(rcv.table.Field(37), vectorOfWeakReferences)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddVectorOfStrongReferrables(vectorOfStrongReferrables flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddVectorOfStrongReferrables(rcv.table.Field(38), vectorOfStrongReferrables)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddCoOwningReference(coOwningReference uint64) *MonsterBuilder {
+	MonsterAddCoOwningReference(rcv.table.Field(39), coOwningReference)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddVectorOfCoOwningReferences(vectorOfCoOwningReferences flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddVectorOfCoOwningReferences(rcv.table.Field(40), vectorOfCoOwningReferences)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddNonOwningReference(nonOwningReference uint64) *MonsterBuilder {
+	MonsterAddNonOwningReference(rcv.table.Field(41), nonOwningReference)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddVectorOfNonOwningReferences(vectorOfNonOwningReferences flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddVectorOfNonOwningReferences(rcv.table.Field(42), vectorOfNonOwningReferences)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddAnyUniqueType(anyUniqueType AnyUniqueAliases) *MonsterBuilder {
+	MonsterAddAnyUniqueType(rcv.table.Field(43), anyUniqueType)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddAnyUnique(anyUnique flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddAnyUnique(rcv.table.Field(44), anyUnique)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddAnyAmbiguousType(anyAmbiguousType AnyAmbiguousAliases) *MonsterBuilder {
+	MonsterAddAnyAmbiguousType(rcv.table.Field(45), anyAmbiguousType)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddAnyAmbiguous(anyAmbiguous flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddAnyAmbiguous(rcv.table.Field(46), anyAmbiguous)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddVectorOfEnums(vectorOfEnums flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddVectorOfEnums(rcv.table.Field(47), vectorOfEnums)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddSignedEnum(signedEnum Race) *MonsterBuilder {
+	MonsterAddSignedEnum(rcv.table.Field(48), signedEnum)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddTestrequirednestedflatbuffer(testrequirednestedflatbuffer flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddTestrequirednestedflatbuffer(rcv.table.Field(49), testrequirednestedflatbuffer)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddScalarKeySortedTables(scalarKeySortedTables flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddScalarKeySortedTables(rcv.table.Field(50), scalarKeySortedTables)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddNativeInline(nativeInline flatbuffers.UOffsetT) *MonsterBuilder {
+	MonsterAddNativeInline(rcv.table.Field(51), nativeInline)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddLongEnumNonEnumDefault(longEnumNonEnumDefault LongEnum) *MonsterBuilder {
+	MonsterAddLongEnumNonEnumDefault(rcv.table.Field(52), longEnumNonEnumDefault)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddLongEnumNormalDefault(longEnumNormalDefault LongEnum) *MonsterBuilder {
+	MonsterAddLongEnumNormalDefault(rcv.table.Field(53), longEnumNormalDefault)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddNanDefault(nanDefault float32) *MonsterBuilder {
+	MonsterAddNanDefault(rcv.table.Field(54), nanDefault)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddInfDefault(infDefault float32) *MonsterBuilder {
+	MonsterAddInfDefault(rcv.table.Field(55), infDefault)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddPositiveInfDefault(positiveInfDefault float32) *MonsterBuilder {
+	MonsterAddPositiveInfDefault(rcv.table.Field(56), positiveInfDefault)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddInfinityDefault(infinityDefault float32) *MonsterBuilder {
+	MonsterAddInfinityDefault(rcv.table.Field(57), infinityDefault)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddPositiveInfinityDefault(positiveInfinityDefault float32) *MonsterBuilder {
+	MonsterAddPositiveInfinityDefault(rcv.table.Field(58), positiveInfinityDefault)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddNegativeInfDefault(negativeInfDefault float32) *MonsterBuilder {
+	MonsterAddNegativeInfDefault(rcv.table.Field(59), negativeInfDefault)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddNegativeInfinityDefault(negativeInfinityDefault float32) *MonsterBuilder {
+	MonsterAddNegativeInfinityDefault(rcv.table.Field(60), negativeInfinityDefault)
+	return rcv
+}
+func (rcv *MonsterBuilder) AddDoubleInfDefault(doubleInfDefault float64) *MonsterBuilder {
+	MonsterAddDoubleInfDefault(rcv.table.Field(61), doubleInfDefault)
+	return rcv
+}
+
+// Finish ends the Monster and returns its offset, and an error
+// wrapping flatbuffers.ErrRequiredField if a required field was not added.
+func (rcv *MonsterBuilder) Finish() (flatbuffers.UOffsetT, error) {
+	rcv.table.Required(3, "MyGame.Example.Monster.name")
+	return MonsterEnd(rcv.table.End()), rcv.table.Err()
+}
@@ -300,3 +300,360 @@ var _MonsterStorage_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 }
+
+// MonsterMessage is a Monster sent by the typed stubs: a *MonsterT or a
+// MonsterBuffer.
+type MonsterMessage interface {
+	monsterBuilder() *flatbuffers.Builder
+}
+
+// MonsterBuffer is a builder finished with a Monster root table.
+type MonsterBuffer struct {
+	builder *flatbuffers.Builder
+}
+
+// NewMonsterBuffer wraps a builder that has been finished with a Monster root
+// table. The typed stubs check the root before sending it, as they do the
+// Monster they receive in a response.
+func NewMonsterBuffer(builder *flatbuffers.Builder) MonsterBuffer {
+	return MonsterBuffer{builder}
+}
+
+func (b MonsterBuffer) monsterBuilder() *flatbuffers.Builder {
+	return b.builder
+}
+
+func (t *MonsterT) monsterBuilder() *flatbuffers.Builder {
+	if t == nil {
+		return nil
+	}
+	b := flatbuffers.NewBuilder(0)
+	FinishMonsterBuffer(b, t.Pack(b))
+	return b
+}
+
+// checkMonster checks that rcv, the root table of a buffer, has the file
+// identifier and the required fields of a Monster.
+func checkMonster(rcv *Monster) error {
+	tab := rcv.Table()
+	if len(tab.Bytes) < flatbuffers.SizeUOffsetT+len(MonsterIdentifier) ||
+		!MonsterBufferHasIdentifier(tab.Bytes) {
+		return status.Errorf(codes.Internal, "%v: want %q", flatbuffers.ErrIdentifierMismatch, MonsterIdentifier)
+	}
+	if tab.Offset(10) == 0 {
+		return status.Errorf(codes.Internal, "%v: MyGame.Example.Monster.name", flatbuffers.ErrRequiredField)
+	}
+	return nil
+}
+
+// checkedMonsterBuilder returns the builder of m, once checked to be finished
+// with a Monster root table.
+func checkedMonsterBuilder(m MonsterMessage) (*flatbuffers.Builder, error) {
+	var b *flatbuffers.Builder
+	if m != nil {
+		b = m.monsterBuilder()
+	}
+	if b == nil {
+		return nil, status.Error(codes.Internal, "no Monster to send")
+	}
+	buf, err := flatbuffers.FlatbuffersCodec{}.Marshal(b)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := checkMonster(GetRootAsMonster(buf, 0)); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// StatMessage is a Stat sent by the typed stubs: a *StatT or a
+// StatBuffer.
+type StatMessage interface {
+	statBuilder() *flatbuffers.Builder
+}
+
+// StatBuffer is a builder finished with a Stat root table.
+type StatBuffer struct {
+	builder *flatbuffers.Builder
+}
+
+// NewStatBuffer wraps a builder that has been finished with a Stat root
+// table. The typed stubs check the root before sending it, as they do the
+// Stat they receive in a response.
+func NewStatBuffer(builder *flatbuffers.Builder) StatBuffer {
+	return StatBuffer{builder}
+}
+
+func (b StatBuffer) statBuilder() *flatbuffers.Builder {
+	return b.builder
+}
+
+func (t *StatT) statBuilder() *flatbuffers.Builder {
+	if t == nil {
+		return nil
+	}
+	b := flatbuffers.NewBuilder(0)
+	FinishStatBuffer(b, t.Pack(b))
+	return b
+}
+
+// checkStat checks that rcv, the root table of a buffer, has the file
+// identifier and the required fields of a Stat.
+func checkStat(rcv *Stat) error {
+	return nil
+}
+
+// checkedStatBuilder returns the builder of m, once checked to be finished
+// with a Stat root table.
+func checkedStatBuilder(m StatMessage) (*flatbuffers.Builder, error) {
+	var b *flatbuffers.Builder
+	if m != nil {
+		b = m.statBuilder()
+	}
+	if b == nil {
+		return nil, status.Error(codes.Internal, "no Stat to send")
+	}
+	buf, err := flatbuffers.FlatbuffersCodec{}.Marshal(b)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := checkStat(GetRootAsStat(buf, 0)); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Typed client API for MonsterStorage service
+type MonsterStorageTypedClient interface {
+	Store(ctx context.Context, in MonsterMessage,
+		opts ...grpc.CallOption) (*Stat, error)
+	Retrieve(ctx context.Context, in StatMessage,
+		opts ...grpc.CallOption) (MonsterStorage_RetrieveTypedClient, error)
+	GetMaxHitPoint(ctx context.Context,
+		opts ...grpc.CallOption) (MonsterStorage_GetMaxHitPointTypedClient, error)
+	GetMinMaxHitPoints(ctx context.Context,
+		opts ...grpc.CallOption) (MonsterStorage_GetMinMaxHitPointsTypedClient, error)
+}
+
+type monsterStorageTypedClient struct {
+	client MonsterStorageClient
+}
+
+func NewMonsterStorageTypedClient(cc grpc.ClientConnInterface) MonsterStorageTypedClient {
+	return &monsterStorageTypedClient{NewMonsterStorageClient(cc)}
+}
+
+func (c *monsterStorageTypedClient) Store(ctx context.Context, in MonsterMessage,
+	opts ...grpc.CallOption) (*Stat, error) {
+	b, err := checkedMonsterBuilder(in)
+	if err != nil {
+		return nil, err
+	}
+	out, err := c.client.Store(ctx, b, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStat(out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monsterStorageTypedClient) Retrieve(ctx context.Context, in StatMessage,
+	opts ...grpc.CallOption) (MonsterStorage_RetrieveTypedClient, error) {
+	b, err := checkedStatBuilder(in)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := c.client.Retrieve(ctx, b, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &monsterStorageRetrieveTypedClient{stream}, nil
+}
+
+type MonsterStorage_RetrieveTypedClient interface {
+	Recv() (*Monster, error)
+	grpc.ClientStream
+}
+
+type monsterStorageRetrieveTypedClient struct {
+	MonsterStorage_RetrieveClient
+}
+
+func (x *monsterStorageRetrieveTypedClient) Recv() (*Monster, error) {
+	m, err := x.MonsterStorage_RetrieveClient.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMonster(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *monsterStorageTypedClient) GetMaxHitPoint(ctx context.Context,
+	opts ...grpc.CallOption) (MonsterStorage_GetMaxHitPointTypedClient, error) {
+	stream, err := c.client.GetMaxHitPoint(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &monsterStorageGetMaxHitPointTypedClient{stream}, nil
+}
+
+type MonsterStorage_GetMaxHitPointTypedClient interface {
+	Send(MonsterMessage) error
+	CloseAndRecv() (*Stat, error)
+	grpc.ClientStream
+}
+
+type monsterStorageGetMaxHitPointTypedClient struct {
+	MonsterStorage_GetMaxHitPointClient
+}
+
+func (x *monsterStorageGetMaxHitPointTypedClient) Send(m MonsterMessage) error {
+	b, err := checkedMonsterBuilder(m)
+	if err != nil {
+		return err
+	}
+	return x.MonsterStorage_GetMaxHitPointClient.Send(b)
+}
+
+func (x *monsterStorageGetMaxHitPointTypedClient) CloseAndRecv() (*Stat, error) {
+	m, err := x.MonsterStorage_GetMaxHitPointClient.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStat(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *monsterStorageTypedClient) GetMinMaxHitPoints(ctx context.Context,
+	opts ...grpc.CallOption) (MonsterStorage_GetMinMaxHitPointsTypedClient, error) {
+	stream, err := c.client.GetMinMaxHitPoints(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &monsterStorageGetMinMaxHitPointsTypedClient{stream}, nil
+}
+
+type MonsterStorage_GetMinMaxHitPointsTypedClient interface {
+	Send(MonsterMessage) error
+	Recv() (*Stat, error)
+	grpc.ClientStream
+}
+
+type monsterStorageGetMinMaxHitPointsTypedClient struct {
+	MonsterStorage_GetMinMaxHitPointsClient
+}
+
+func (x *monsterStorageGetMinMaxHitPointsTypedClient) Send(m MonsterMessage) error {
+	b, err := checkedMonsterBuilder(m)
+	if err != nil {
+		return err
+	}
+	return x.MonsterStorage_GetMinMaxHitPointsClient.Send(b)
+}
+
+func (x *monsterStorageGetMinMaxHitPointsTypedClient) Recv() (*Stat, error) {
+	m, err := x.MonsterStorage_GetMinMaxHitPointsClient.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStat(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Typed server API for MonsterStorage service
+type MonsterStorageTypedServer interface {
+	Store(context.Context, *Monster) (StatMessage, error)
+	Retrieve(*Stat, MonsterStorage_RetrieveTypedServer) error
+	GetMaxHitPoint(MonsterStorage_GetMaxHitPointTypedServer) error
+	GetMinMaxHitPoints(MonsterStorage_GetMinMaxHitPointsTypedServer) error
+}
+
+type monsterStorageTypedServer struct {
+	UnimplementedMonsterStorageServer
+	srv MonsterStorageTypedServer
+}
+
+func RegisterMonsterStorageTypedServer(s grpc.ServiceRegistrar, srv MonsterStorageTypedServer) {
+	RegisterMonsterStorageServer(s, &monsterStorageTypedServer{srv: srv})
+}
+
+func (s *monsterStorageTypedServer) Store(ctx context.Context, in *Monster) (*flatbuffers.Builder, error) {
+	out, err := s.srv.Store(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return checkedStatBuilder(out)
+}
+
+func (s *monsterStorageTypedServer) Retrieve(in *Stat, stream MonsterStorage_RetrieveServer) error {
+	return s.srv.Retrieve(in, &monsterStorageRetrieveTypedServer{stream})
+}
+
+type MonsterStorage_RetrieveTypedServer interface {
+	Send(MonsterMessage) error
+	grpc.ServerStream
+}
+
+type monsterStorageRetrieveTypedServer struct {
+	MonsterStorage_RetrieveServer
+}
+
+func (x *monsterStorageRetrieveTypedServer) Send(m MonsterMessage) error {
+	b, err := checkedMonsterBuilder(m)
+	if err != nil {
+		return err
+	}
+	return x.MonsterStorage_RetrieveServer.Send(b)
+}
+
+func (s *monsterStorageTypedServer) GetMaxHitPoint(stream MonsterStorage_GetMaxHitPointServer) error {
+	return s.srv.GetMaxHitPoint(&monsterStorageGetMaxHitPointTypedServer{stream})
+}
+
+type MonsterStorage_GetMaxHitPointTypedServer interface {
+	Recv() (*Monster, error)
+	SendAndClose(StatMessage) error
+	grpc.ServerStream
+}
+
+type monsterStorageGetMaxHitPointTypedServer struct {
+	MonsterStorage_GetMaxHitPointServer
+}
+
+func (x *monsterStorageGetMaxHitPointTypedServer) SendAndClose(m StatMessage) error {
+	b, err := checkedStatBuilder(m)
+	if err != nil {
+		return err
+	}
+	return x.MonsterStorage_GetMaxHitPointServer.SendAndClose(b)
+}
+
+func (s *monsterStorageTypedServer) GetMinMaxHitPoints(stream MonsterStorage_GetMinMaxHitPointsServer) error {
+	return s.srv.GetMinMaxHitPoints(&monsterStorageGetMinMaxHitPointsTypedServer{stream})
+}
+
+type MonsterStorage_GetMinMaxHitPointsTypedServer interface {
+	Send(StatMessage) error
+	Recv() (*Monster, error)
+	grpc.ServerStream
+}
+
+type monsterStorageGetMinMaxHitPointsTypedServer struct {
+	MonsterStorage_GetMinMaxHitPointsServer
+}
+
+func (x *monsterStorageGetMinMaxHitPointsTypedServer) Send(m StatMessage) error {
+	b, err := checkedStatBuilder(m)
+	if err != nil {
+		return err
+	}
+	return x.MonsterStorage_GetMinMaxHitPointsServer.Send(b)
+}
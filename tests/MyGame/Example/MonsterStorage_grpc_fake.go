@@ -0,0 +1,388 @@
+//Generated by gRPC Go plugin
+//If you make any local changes, they will be lost
+//source: monster_test
+
+package Example
+
+import (
+	context "context"
+	"io"
+	"sync"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// FakeMonsterStorageServer is a MonsterStorageServer for tests.
+// It records the requests of every call, and answers with the responses
+// scripted by its Returns methods: each call takes the next one, and the
+// last one is kept for the calls after it.
+type FakeMonsterStorageServer struct {
+	UnimplementedMonsterStorageServer
+
+	mu sync.Mutex
+	storeCalls     []*Monster
+	storeResponses []fakeMonsterStorageResponse
+	retrieveCalls     []*Stat
+	retrieveResponses []fakeMonsterStorageResponse
+	getMaxHitPointCalls     [][]*Monster
+	getMaxHitPointResponses []fakeMonsterStorageResponse
+	getMinMaxHitPointsCalls     [][]*Monster
+	getMinMaxHitPointsResponses []fakeMonsterStorageResponse
+}
+
+type fakeMonsterStorageResponse struct {
+	out []*flatbuffers.Builder
+	err error
+}
+
+// next returns the response of a call to method, or an error if none was
+// scripted.
+func (f *FakeMonsterStorageServer) next(responses *[]fakeMonsterStorageResponse, method string) (fakeMonsterStorageResponse, error) {
+	if len(*responses) == 0 {
+		return fakeMonsterStorageResponse{}, status.Errorf(codes.Unimplemented, "no response scripted for %s", method)
+	}
+	r := (*responses)[0]
+	if len(*responses) > 1 {
+		*responses = (*responses)[1:]
+	}
+	return r, nil
+}
+
+// StoreReturns scripts the response or the error of a call.
+func (f *FakeMonsterStorageServer) StoreReturns(out *flatbuffers.Builder, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.storeResponses = append(f.storeResponses, fakeMonsterStorageResponse{[]*flatbuffers.Builder{out}, err})
+}
+
+// StoreCalls returns the request of each call.
+func (f *FakeMonsterStorageServer) StoreCalls() []*Monster {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*Monster(nil), f.storeCalls...)
+}
+
+func (f *FakeMonsterStorageServer) Store(ctx context.Context, in *Monster) (*flatbuffers.Builder, error) {
+	f.mu.Lock()
+	f.storeCalls = append(f.storeCalls, in)
+	r, err := f.next(&f.storeResponses, "Store")
+	f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return r.out[0], r.err
+}
+
+// RetrieveReturns scripts the responses that a call streams, and the
+// error it ends with.
+func (f *FakeMonsterStorageServer) RetrieveReturns(out []*flatbuffers.Builder, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.retrieveResponses = append(f.retrieveResponses, fakeMonsterStorageResponse{out, err})
+}
+
+// RetrieveCalls returns the request of each call.
+func (f *FakeMonsterStorageServer) RetrieveCalls() []*Stat {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*Stat(nil), f.retrieveCalls...)
+}
+
+func (f *FakeMonsterStorageServer) Retrieve(in *Stat, stream MonsterStorage_RetrieveServer) error {
+	f.mu.Lock()
+	f.retrieveCalls = append(f.retrieveCalls, in)
+	r, err := f.next(&f.retrieveResponses, "Retrieve")
+	f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	for _, m := range r.out {
+		if err := stream.Send(m); err != nil {
+			return err
+		}
+	}
+	return r.err
+}
+
+// GetMaxHitPointReturns scripts the response or the error of a call.
+func (f *FakeMonsterStorageServer) GetMaxHitPointReturns(out *flatbuffers.Builder, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getMaxHitPointResponses = append(f.getMaxHitPointResponses, fakeMonsterStorageResponse{[]*flatbuffers.Builder{out}, err})
+}
+
+// GetMaxHitPointCalls returns the requests streamed by each call.
+func (f *FakeMonsterStorageServer) GetMaxHitPointCalls() [][]*Monster {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	calls := make([][]*Monster, len(f.getMaxHitPointCalls))
+	for i, c := range f.getMaxHitPointCalls {
+		calls[i] = append([]*Monster(nil), c...)
+	}
+	return calls
+}
+
+func (f *FakeMonsterStorageServer) GetMaxHitPoint(stream MonsterStorage_GetMaxHitPointServer) error {
+	f.mu.Lock()
+	call := len(f.getMaxHitPointCalls)
+	f.getMaxHitPointCalls = append(f.getMaxHitPointCalls, nil)
+	r, err := f.next(&f.getMaxHitPointResponses, "GetMaxHitPoint")
+	f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	for {
+		m, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		f.mu.Lock()
+		f.getMaxHitPointCalls[call] = append(f.getMaxHitPointCalls[call], m)
+		f.mu.Unlock()
+	}
+	if r.err != nil {
+		return r.err
+	}
+	return stream.SendAndClose(r.out[0])
+}
+
+// GetMinMaxHitPointsReturns scripts the responses that a call streams, and the
+// error it ends with.
+func (f *FakeMonsterStorageServer) GetMinMaxHitPointsReturns(out []*flatbuffers.Builder, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getMinMaxHitPointsResponses = append(f.getMinMaxHitPointsResponses, fakeMonsterStorageResponse{out, err})
+}
+
+// GetMinMaxHitPointsCalls returns the requests streamed by each call.
+func (f *FakeMonsterStorageServer) GetMinMaxHitPointsCalls() [][]*Monster {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	calls := make([][]*Monster, len(f.getMinMaxHitPointsCalls))
+	for i, c := range f.getMinMaxHitPointsCalls {
+		calls[i] = append([]*Monster(nil), c...)
+	}
+	return calls
+}
+
+func (f *FakeMonsterStorageServer) GetMinMaxHitPoints(stream MonsterStorage_GetMinMaxHitPointsServer) error {
+	f.mu.Lock()
+	call := len(f.getMinMaxHitPointsCalls)
+	f.getMinMaxHitPointsCalls = append(f.getMinMaxHitPointsCalls, nil)
+	r, err := f.next(&f.getMinMaxHitPointsResponses, "GetMinMaxHitPoints")
+	f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	// Each request is answered with the next response, and the rest are sent
+	// once the client is done.
+	sent := 0
+	for {
+		m, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		f.mu.Lock()
+		f.getMinMaxHitPointsCalls[call] = append(f.getMinMaxHitPointsCalls[call], m)
+		f.mu.Unlock()
+		if sent < len(r.out) {
+			if err := stream.Send(r.out[sent]); err != nil {
+				return err
+			}
+			sent++
+		}
+	}
+	for ; sent < len(r.out); sent++ {
+		if err := stream.Send(r.out[sent]); err != nil {
+			return err
+		}
+	}
+	return r.err
+}
+
+type fakeMonsterStorageClient struct {
+	srv MonsterStorageServer
+}
+
+// NewFakeMonsterStorageClient returns a client that calls srv in memory, without
+// gRPC. srv is typically a *FakeMonsterStorageServer.
+func NewFakeMonsterStorageClient(srv MonsterStorageServer) MonsterStorageClient {
+	return &fakeMonsterStorageClient{srv}
+}
+
+func (c *fakeMonsterStorageClient) Store(ctx context.Context, in *flatbuffers.Builder,
+	opts ...grpc.CallOption) (*Stat, error) {
+	m := new(Monster)
+	if err := fakeMonsterStorageCopy(in, m); err != nil {
+		return nil, err
+	}
+	out, err := c.srv.Store(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	r := new(Stat)
+	if err := fakeMonsterStorageCopy(out, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *fakeMonsterStorageClient) Retrieve(ctx context.Context, in *flatbuffers.Builder,
+	opts ...grpc.CallOption) (MonsterStorage_RetrieveClient, error) {
+	m := new(Stat)
+	if err := fakeMonsterStorageCopy(in, m); err != nil {
+		return nil, err
+	}
+	stream := newFakeMonsterStorageStream(ctx)
+	go func() {
+		stream.finish(c.srv.Retrieve(m, &monsterStorageRetrieveServer{fakeMonsterStorageServerStream{stream}}))
+	}()
+	return &monsterStorageRetrieveClient{fakeMonsterStorageClientStream{stream}}, nil
+}
+
+func (c *fakeMonsterStorageClient) GetMaxHitPoint(ctx context.Context,
+	opts ...grpc.CallOption) (MonsterStorage_GetMaxHitPointClient, error) {
+	stream := newFakeMonsterStorageStream(ctx)
+	go func() {
+		stream.finish(c.srv.GetMaxHitPoint(&monsterStorageGetMaxHitPointServer{fakeMonsterStorageServerStream{stream}}))
+	}()
+	return &monsterStorageGetMaxHitPointClient{fakeMonsterStorageClientStream{stream}}, nil
+}
+
+func (c *fakeMonsterStorageClient) GetMinMaxHitPoints(ctx context.Context,
+	opts ...grpc.CallOption) (MonsterStorage_GetMinMaxHitPointsClient, error) {
+	stream := newFakeMonsterStorageStream(ctx)
+	go func() {
+		stream.finish(c.srv.GetMinMaxHitPoints(&monsterStorageGetMinMaxHitPointsServer{fakeMonsterStorageServerStream{stream}}))
+	}()
+	return &monsterStorageGetMinMaxHitPointsClient{fakeMonsterStorageClientStream{stream}}, nil
+}
+
+// fakeMonsterStorageStream carries a streaming call between the fake client
+// and a server, in memory.
+type fakeMonsterStorageStream struct {
+	ctx       context.Context
+	requests  chan []byte
+	responses chan []byte
+	closeSend sync.Once
+	// done is closed when the server method has returned err.
+	done chan struct{}
+	err  error
+}
+
+func newFakeMonsterStorageStream(ctx context.Context) *fakeMonsterStorageStream {
+	return &fakeMonsterStorageStream{
+		ctx:       ctx,
+		requests:  make(chan []byte),
+		responses: make(chan []byte),
+		done:      make(chan struct{}),
+	}
+}
+
+func (s *fakeMonsterStorageStream) finish(err error) {
+	s.err = err
+	close(s.done)
+}
+
+// fakeMonsterStorageCopy passes in to out as a copy, like a transport would.
+func fakeMonsterStorageCopy(in, out interface{}) error {
+	buf, err := flatbuffers.FlatbuffersCodec{}.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return flatbuffers.FlatbuffersCodec{}.Unmarshal(append([]byte(nil), buf...), out)
+}
+
+func fakeMonsterStorageMarshal(m interface{}) ([]byte, error) {
+	buf, err := flatbuffers.FlatbuffersCodec{}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), buf...), nil
+}
+
+type fakeMonsterStorageClientStream struct {
+	*fakeMonsterStorageStream
+}
+
+func (s fakeMonsterStorageClientStream) Header() (metadata.MD, error) { return nil, nil }
+func (s fakeMonsterStorageClientStream) Trailer() metadata.MD         { return nil }
+func (s fakeMonsterStorageClientStream) Context() context.Context     { return s.ctx }
+
+func (s fakeMonsterStorageClientStream) CloseSend() error {
+	s.closeSend.Do(func() { close(s.requests) })
+	return nil
+}
+
+func (s fakeMonsterStorageClientStream) SendMsg(m interface{}) error {
+	buf, err := fakeMonsterStorageMarshal(m)
+	if err != nil {
+		return err
+	}
+	select {
+	case s.requests <- buf:
+		return nil
+	case <-s.done:
+		return io.EOF
+	case <-s.ctx.Done():
+		return status.FromContextError(s.ctx.Err()).Err()
+	}
+}
+
+func (s fakeMonsterStorageClientStream) RecvMsg(m interface{}) error {
+	select {
+	case buf := <-s.responses:
+		return flatbuffers.FlatbuffersCodec{}.Unmarshal(buf, m)
+	case <-s.done:
+		if s.err != nil {
+			return s.err
+		}
+		return io.EOF
+	case <-s.ctx.Done():
+		return status.FromContextError(s.ctx.Err()).Err()
+	}
+}
+
+type fakeMonsterStorageServerStream struct {
+	*fakeMonsterStorageStream
+}
+
+func (s fakeMonsterStorageServerStream) SetHeader(metadata.MD) error  { return nil }
+func (s fakeMonsterStorageServerStream) SendHeader(metadata.MD) error { return nil }
+func (s fakeMonsterStorageServerStream) SetTrailer(metadata.MD)       {}
+func (s fakeMonsterStorageServerStream) Context() context.Context     { return s.ctx }
+
+func (s fakeMonsterStorageServerStream) SendMsg(m interface{}) error {
+	buf, err := fakeMonsterStorageMarshal(m)
+	if err != nil {
+		return err
+	}
+	select {
+	case s.responses <- buf:
+		return nil
+	case <-s.ctx.Done():
+		return status.FromContextError(s.ctx.Err()).Err()
+	}
+}
+
+func (s fakeMonsterStorageServerStream) RecvMsg(m interface{}) error {
+	select {
+	case buf, ok := <-s.requests:
+		if !ok {
+			return io.EOF
+		}
+		return flatbuffers.FlatbuffersCodec{}.Unmarshal(buf, m)
+	case <-s.ctx.Done():
+		return status.FromContextError(s.ctx.Err()).Err()
+	}
+}
@@ -2,7 +2,11 @@
 
 package Example
 
-import "strconv"
+import (
+	"errors"
+	flatbuffers "github.com/google/flatbuffers/go"
+	"strconv"
+)
 
 type Race int8
 
@@ -33,3 +37,56 @@ func (v Race) String() string {
 	}
 	return "Race(" + strconv.FormatInt(int64(v), 10) + ")"
 }
+
+// RaceValues returns the values of Race, in declaration order.
+func RaceValues() []Race {
+	return []Race{
+		RaceNone,
+		RaceHuman,
+		RaceDwarf,
+		RaceElf,
+	}
+}
+
+// IsValid reports whether v is a value of Race.
+func (v Race) IsValid() bool {
+	_, ok := EnumNamesRace[v]
+	return ok
+}
+
+// MarshalText formats v like flatc's JSON output: as the name of a value, or
+// as a number.
+func (v Race) MarshalText() ([]byte, error) {
+	if s, ok := EnumNamesRace[v]; ok {
+		return []byte(s), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalText parses text like ParseRace.
+func (v *Race) UnmarshalText(text []byte) error {
+	x, err := ParseRace(string(text))
+	if err != nil {
+		return err
+	}
+	*v = x
+	return nil
+}
+
+// ParseRace parses the name or the number of a Race value.
+func ParseRace(s string) (Race, error) {
+	if v, ok := EnumValuesRace[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 8)
+	if err != nil {
+		return 0, errors.New("invalid Race: " + strconv.Quote(s))
+	}
+	return Race(n), nil
+}
+
+// RandomRace returns one of the values of Race from r.
+func RandomRace(r *flatbuffers.Random) Race {
+	values := RaceValues()
+	return values[r.Intn(len(values))]
+}
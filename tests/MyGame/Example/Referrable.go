@@ -4,6 +4,7 @@ package Example
 
 import (
 	flatbuffers "github.com/google/flatbuffers/go"
+	flathash "github.com/google/flatbuffers/go/flathash"
 )
 
 type ReferrableT struct {
@@ -19,7 +20,15 @@ func (t *ReferrableT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return ReferrableEnd(builder)
 }
 
+// UnPackTo unpacks rcv into t, reusing the vectors and objects t already holds,
+// which must not be shared with other values.
 func (rcv *Referrable) UnPackTo(t *ReferrableT) {
+	rcv.UnPackToInterned(t, nil)
+}
+
+// UnPackToInterned is UnPackTo taking the strings it allocates from interner,
+// which may be nil.
+func (rcv *Referrable) UnPackToInterned(t *ReferrableT, interner *flatbuffers.Interner) {
 	t.Id = rcv.Id()
 }
 
@@ -32,6 +41,86 @@ func (rcv *Referrable) UnPack() *ReferrableT {
 	return t
 }
 
+// Reset sets t to the zero ReferrableT, except that its vectors keep
+// their capacity for UnPackTo, which sets those its table lacks to nil.
+// A vector that was not nil is packed as empty until then.
+func (t *ReferrableT) Reset() {
+	*t = ReferrableT{}
+}
+
+// Splice writes a copy of the Referrable and the data it references to
+// builder, and returns its offset, like Pack without unpacking it.
+func (rcv *Referrable) Splice(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	ReferrableStart(builder)
+	ReferrableAddId(builder, rcv.Id())
+	return ReferrableEnd(builder)
+}
+
+// ReferrableMask selects fields of a Referrable for UnPackWithMask and ProjectTo.
+// A table, or a vector of tables, is selected by a mask of the fields of its
+// tables. All selects every field, including those of nested tables, and a
+// nil mask selects none.
+type ReferrableMask struct {
+	All bool
+	Id bool
+}
+
+// UnPackWithMask unpacks the fields of rcv selected by mask into a new
+// ReferrableT, leaving the others zero.
+func (rcv *Referrable) UnPackWithMask(mask *ReferrableMask) *ReferrableT {
+	if rcv == nil {
+		return nil
+	}
+	if mask == nil {
+		mask = &ReferrableMask{}
+	}
+	if mask.All {
+		return rcv.UnPack()
+	}
+	t := &ReferrableT{}
+	if mask.Id {
+		t.Id = rcv.Id()
+	}
+	return t
+}
+
+// ProjectTo writes a copy of the fields of the Referrable selected by mask, and
+// the data they reference, to builder, and returns its offset. Dropping a
+// required field records an error in builder.
+func (rcv *Referrable) ProjectTo(builder *flatbuffers.Builder, mask *ReferrableMask) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	if mask == nil {
+		mask = &ReferrableMask{}
+	}
+	if mask.All {
+		return rcv.Splice(builder)
+	}
+	ReferrableStart(builder)
+	if mask.Id {
+		ReferrableAddId(builder, rcv.Id())
+	}
+	return ReferrableEnd(builder)
+}
+
+func (t *ReferrableT) SetIdFromString(s string) {
+	t.Id = uint64(flathash.Fnv1aHash64(s))
+}
+
+// RandomReferrableT returns a ReferrableT with random fields from r. Required
+// fields are always set.
+func RandomReferrableT(r *flatbuffers.Random) *ReferrableT {
+	r.Enter()
+	defer r.Leave()
+	t := &ReferrableT{}
+	t.Id = r.Uint64()
+	return t
+}
+
 type Referrable struct {
 	_tab flatbuffers.Table
 }
@@ -79,12 +168,16 @@ func (rcv *Referrable) MutateId(n uint64) bool {
 	return rcv._tab.MutateUint64Slot(4, n)
 }
 
+// ReferrableKey returns the key of the Referrable at off in buf, for
+// Builder.CreateVectorOfSortedTablesByKey.
+func ReferrableKey(off flatbuffers.UOffsetT, buf []byte) flatbuffers.Key {
+	obj := Referrable{}
+	obj.Init(buf, flatbuffers.UOffsetT(len(buf))-off)
+	return flatbuffers.Uint64Key(uint64(obj.Id()))
+}
+
 func ReferrableKeyCompare(o1, o2 flatbuffers.UOffsetT, buf []byte) bool {
-	obj1 := &Referrable{}
-	obj2 := &Referrable{}
-	obj1.Init(buf, flatbuffers.UOffsetT(len(buf))-o1)
-	obj2.Init(buf, flatbuffers.UOffsetT(len(buf))-o2)
-	return obj1.Id() < obj2.Id()
+	return ReferrableKey(o1, buf).Compare(ReferrableKey(o2, buf)) < 0
 }
 
 func (rcv *Referrable) LookupByKey(key uint64, vectorLocation flatbuffers.UOffsetT, buf []byte) bool {
@@ -122,6 +215,32 @@ func ReferrableStart(builder *flatbuffers.Builder) {
 func ReferrableAddId(builder *flatbuffers.Builder, id uint64) {
 	builder.PrependUint64Slot(0, id, 0)
 }
+func ReferrableAddIdFromString(builder *flatbuffers.Builder, id string) {
+	ReferrableAddId(builder, uint64(flathash.Fnv1aHash64(id)))
+}
 func ReferrableEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
+
+// ReferrableBuilder builds a Referrable with chainable Add methods. It panics
+// if a field is added after Finish, which checks the required fields.
+type ReferrableBuilder struct {
+	table flatbuffers.TableBuilder
+}
+
+// NewReferrableBuilder starts a Referrable in builder.
+func NewReferrableBuilder(builder *flatbuffers.Builder) *ReferrableBuilder {
+	rcv := &ReferrableBuilder{}
+	rcv.table.Start(builder, "MyGame.Example.Referrable", 1)
+	return rcv
+}
+func (rcv *ReferrableBuilder) AddId(id uint64) *ReferrableBuilder {
+	ReferrableAddId(rcv.table.Field(0), id)
+	return rcv
+}
+
+// Finish ends the Referrable and returns its offset, and an error
+// wrapping flatbuffers.ErrRequiredField if a required field was not added.
+func (rcv *ReferrableBuilder) Finish() (flatbuffers.UOffsetT, error) {
+	return ReferrableEnd(rcv.table.End()), rcv.table.Err()
+}
@@ -27,8 +27,18 @@ func (t *StatT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return StatEnd(builder)
 }
 
+// UnPackTo unpacks rcv into t, reusing the vectors and objects t already holds,
+// which must not be shared with other values.
 func (rcv *Stat) UnPackTo(t *StatT) {
-	t.Id = string(rcv.Id())
+	rcv.UnPackToInterned(t, nil)
+}
+
+// UnPackToInterned is UnPackTo taking the strings it allocates from interner,
+// which may be nil.
+func (rcv *Stat) UnPackToInterned(t *StatT, interner *flatbuffers.Interner) {
+	if x := rcv.Id(); string(x) != t.Id {
+		t.Id = interner.Intern(x)
+	}
 	t.Val = rcv.Val()
 	t.Count = rcv.Count()
 }
@@ -42,6 +52,106 @@ func (rcv *Stat) UnPack() *StatT {
 	return t
 }
 
+// Reset sets t to the zero StatT, except that its vectors keep
+// their capacity for UnPackTo, which sets those its table lacks to nil.
+// A vector that was not nil is packed as empty until then.
+func (t *StatT) Reset() {
+	*t = StatT{}
+}
+
+// Splice writes a copy of the Stat and the data it references to
+// builder, and returns its offset, like Pack without unpacking it.
+func (rcv *Stat) Splice(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	idOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(4)); o != 0 {
+		idOffset = builder.CreateByteString(rcv._tab.ByteVector(o + rcv._tab.Pos))
+	}
+	StatStart(builder)
+	StatAddId(builder, idOffset)
+	StatAddVal(builder, rcv.Val())
+	StatAddCount(builder, rcv.Count())
+	return StatEnd(builder)
+}
+
+// StatMask selects fields of a Stat for UnPackWithMask and ProjectTo.
+// A table, or a vector of tables, is selected by a mask of the fields of its
+// tables. All selects every field, including those of nested tables, and a
+// nil mask selects none.
+type StatMask struct {
+	All bool
+	Id bool
+	Val bool
+	Count bool
+}
+
+// UnPackWithMask unpacks the fields of rcv selected by mask into a new
+// StatT, leaving the others zero.
+func (rcv *Stat) UnPackWithMask(mask *StatMask) *StatT {
+	if rcv == nil {
+		return nil
+	}
+	if mask == nil {
+		mask = &StatMask{}
+	}
+	if mask.All {
+		return rcv.UnPack()
+	}
+	t := &StatT{}
+	if mask.Id {
+		t.Id = string(rcv.Id())
+	}
+	if mask.Val {
+		t.Val = rcv.Val()
+	}
+	if mask.Count {
+		t.Count = rcv.Count()
+	}
+	return t
+}
+
+// ProjectTo writes a copy of the fields of the Stat selected by mask, and
+// the data they reference, to builder, and returns its offset. Dropping a
+// required field records an error in builder.
+func (rcv *Stat) ProjectTo(builder *flatbuffers.Builder, mask *StatMask) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	if mask == nil {
+		mask = &StatMask{}
+	}
+	if mask.All {
+		return rcv.Splice(builder)
+	}
+	idOffset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(4)); o != 0 && mask.Id {
+		idOffset = builder.CreateByteString(rcv._tab.ByteVector(o + rcv._tab.Pos))
+	}
+	StatStart(builder)
+	StatAddId(builder, idOffset)
+	if mask.Val {
+		StatAddVal(builder, rcv.Val())
+	}
+	if mask.Count {
+		StatAddCount(builder, rcv.Count())
+	}
+	return StatEnd(builder)
+}
+
+// RandomStatT returns a StatT with random fields from r. Required
+// fields are always set.
+func RandomStatT(r *flatbuffers.Random) *StatT {
+	r.Enter()
+	defer r.Leave()
+	t := &StatT{}
+	t.Id = r.Text()
+	t.Val = r.Int64()
+	t.Count = r.Uint16()
+	return t
+}
+
 type Stat struct {
 	_tab flatbuffers.Table
 }
@@ -109,12 +219,16 @@ func (rcv *Stat) MutateCount(n uint16) bool {
 	return rcv._tab.MutateUint16Slot(8, n)
 }
 
+// StatKey returns the key of the Stat at off in buf, for
+// Builder.CreateVectorOfSortedTablesByKey.
+func StatKey(off flatbuffers.UOffsetT, buf []byte) flatbuffers.Key {
+	obj := Stat{}
+	obj.Init(buf, flatbuffers.UOffsetT(len(buf))-off)
+	return flatbuffers.Uint64Key(uint64(obj.Count()))
+}
+
 func StatKeyCompare(o1, o2 flatbuffers.UOffsetT, buf []byte) bool {
-	obj1 := &Stat{}
-	obj2 := &Stat{}
-	obj1.Init(buf, flatbuffers.UOffsetT(len(buf))-o1)
-	obj2.Init(buf, flatbuffers.UOffsetT(len(buf))-o2)
-	return obj1.Count() < obj2.Count()
+	return StatKey(o1, buf).Compare(StatKey(o2, buf)) < 0
 }
 
 func (rcv *Stat) LookupByKey(key uint16, vectorLocation flatbuffers.UOffsetT, buf []byte) bool {
@@ -161,3 +275,34 @@ func StatAddCount(builder *flatbuffers.Builder, count uint16) {
 func StatEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
+
+// StatBuilder builds a Stat with chainable Add methods. It panics
+// if a field is added after Finish, which checks the required fields.
+type StatBuilder struct {
+	table flatbuffers.TableBuilder
+}
+
+// NewStatBuilder starts a Stat in builder.
+func NewStatBuilder(builder *flatbuffers.Builder) *StatBuilder {
+	rcv := &StatBuilder{}
+	rcv.table.Start(builder, "MyGame.Example.Stat", 3)
+	return rcv
+}
+func (rcv *StatBuilder) AddId(id flatbuffers.UOffsetT) *StatBuilder {
+	StatAddId(rcv.table.Field(0), id)
+	return rcv
+}
+func (rcv *StatBuilder) AddVal(val int64) *StatBuilder {
+	StatAddVal(rcv.table.Field(1), val)
+	return rcv
+}
+func (rcv *StatBuilder) AddCount(count uint16) *StatBuilder {
+	StatAddCount(rcv.table.Field(2), count)
+	return rcv
+}
+
+// Finish ends the Stat and returns its offset, and an error
+// wrapping flatbuffers.ErrRequiredField if a required field was not added.
+func (rcv *StatBuilder) Finish() (flatbuffers.UOffsetT, error) {
+	return StatEnd(rcv.table.End()), rcv.table.Err()
+}
@@ -19,9 +19,18 @@ func (t *StructOfStructsT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffse
 	return CreateStructOfStructs(builder, t.A.Id, t.A.Distance, t.B.A, t.B.B, t.C.Id, t.C.Distance)
 }
 func (rcv *StructOfStructs) UnPackTo(t *StructOfStructsT) {
-	t.A = rcv.A(nil).UnPack()
-	t.B = rcv.B(nil).UnPack()
-	t.C = rcv.C(nil).UnPack()
+	if t.A == nil {
+		t.A = &AbilityT{}
+	}
+	rcv.A(nil).UnPackTo(t.A)
+	if t.B == nil {
+		t.B = &TestT{}
+	}
+	rcv.B(nil).UnPackTo(t.B)
+	if t.C == nil {
+		t.C = &AbilityT{}
+	}
+	rcv.C(nil).UnPackTo(t.C)
 }
 
 func (rcv *StructOfStructs) UnPack() *StructOfStructsT {
@@ -33,6 +42,33 @@ func (rcv *StructOfStructs) UnPack() *StructOfStructsT {
 	return t
 }
 
+// Reset sets t to the zero StructOfStructsT, resetting its nested structs.
+func (t *StructOfStructsT) Reset() {
+	*t = StructOfStructsT{
+		A: t.A,
+		B: t.B,
+		C: t.C,
+	}
+	if t.A != nil {
+		t.A.Reset()
+	}
+	if t.B != nil {
+		t.B.Reset()
+	}
+	if t.C != nil {
+		t.C.Reset()
+	}
+}
+
+// RandomStructOfStructsT returns a StructOfStructsT with random fields from r.
+func RandomStructOfStructsT(r *flatbuffers.Random) *StructOfStructsT {
+	return &StructOfStructsT{
+		A: RandomAbilityT(r),
+		B: RandomTestT(r),
+		C: RandomAbilityT(r),
+	}
+}
+
 type StructOfStructs struct {
 	_tab flatbuffers.Struct
 }
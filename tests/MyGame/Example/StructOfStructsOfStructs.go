@@ -17,7 +17,10 @@ func (t *StructOfStructsOfStructsT) Pack(builder *flatbuffers.Builder) flatbuffe
 	return CreateStructOfStructsOfStructs(builder, t.A.A.Id, t.A.A.Distance, t.A.B.A, t.A.B.B, t.A.C.Id, t.A.C.Distance)
 }
 func (rcv *StructOfStructsOfStructs) UnPackTo(t *StructOfStructsOfStructsT) {
-	t.A = rcv.A(nil).UnPack()
+	if t.A == nil {
+		t.A = &StructOfStructsT{}
+	}
+	rcv.A(nil).UnPackTo(t.A)
 }
 
 func (rcv *StructOfStructsOfStructs) UnPack() *StructOfStructsOfStructsT {
@@ -29,6 +32,23 @@ func (rcv *StructOfStructsOfStructs) UnPack() *StructOfStructsOfStructsT {
 	return t
 }
 
+// Reset sets t to the zero StructOfStructsOfStructsT, resetting its nested structs.
+func (t *StructOfStructsOfStructsT) Reset() {
+	*t = StructOfStructsOfStructsT{
+		A: t.A,
+	}
+	if t.A != nil {
+		t.A.Reset()
+	}
+}
+
+// RandomStructOfStructsOfStructsT returns a StructOfStructsOfStructsT with random fields from r.
+func RandomStructOfStructsOfStructsT(r *flatbuffers.Random) *StructOfStructsOfStructsT {
+	return &StructOfStructsOfStructsT{
+		A: RandomStructOfStructsT(r),
+	}
+}
+
 type StructOfStructsOfStructs struct {
 	_tab flatbuffers.Struct
 }
@@ -31,6 +31,19 @@ func (rcv *Test) UnPack() *TestT {
 	return t
 }
 
+// Reset sets t to the zero TestT, resetting its nested structs.
+func (t *TestT) Reset() {
+	*t = TestT{}
+}
+
+// RandomTestT returns a TestT with random fields from r.
+func RandomTestT(r *flatbuffers.Random) *TestT {
+	return &TestT{
+		A: r.Int16(),
+		B: r.Int8(),
+	}
+}
+
 type Test struct {
 	_tab flatbuffers.Struct
 }
@@ -19,7 +19,15 @@ func (t *TestSimpleTableWithEnumT) Pack(builder *flatbuffers.Builder) flatbuffer
 	return TestSimpleTableWithEnumEnd(builder)
 }
 
+// UnPackTo unpacks rcv into t, reusing the vectors and objects t already holds,
+// which must not be shared with other values.
 func (rcv *TestSimpleTableWithEnum) UnPackTo(t *TestSimpleTableWithEnumT) {
+	rcv.UnPackToInterned(t, nil)
+}
+
+// UnPackToInterned is UnPackTo taking the strings it allocates from interner,
+// which may be nil.
+func (rcv *TestSimpleTableWithEnum) UnPackToInterned(t *TestSimpleTableWithEnumT, interner *flatbuffers.Interner) {
 	t.Color = rcv.Color()
 }
 
@@ -32,6 +40,82 @@ func (rcv *TestSimpleTableWithEnum) UnPack() *TestSimpleTableWithEnumT {
 	return t
 }
 
+// Reset sets t to the zero TestSimpleTableWithEnumT, except that its vectors keep
+// their capacity for UnPackTo, which sets those its table lacks to nil.
+// A vector that was not nil is packed as empty until then.
+func (t *TestSimpleTableWithEnumT) Reset() {
+	*t = TestSimpleTableWithEnumT{}
+}
+
+// Splice writes a copy of the TestSimpleTableWithEnum and the data it references to
+// builder, and returns its offset, like Pack without unpacking it.
+func (rcv *TestSimpleTableWithEnum) Splice(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	TestSimpleTableWithEnumStart(builder)
+	TestSimpleTableWithEnumAddColor(builder, rcv.Color())
+	return TestSimpleTableWithEnumEnd(builder)
+}
+
+// TestSimpleTableWithEnumMask selects fields of a TestSimpleTableWithEnum for UnPackWithMask and ProjectTo.
+// A table, or a vector of tables, is selected by a mask of the fields of its
+// tables. All selects every field, including those of nested tables, and a
+// nil mask selects none.
+type TestSimpleTableWithEnumMask struct {
+	All bool
+	Color bool
+}
+
+// UnPackWithMask unpacks the fields of rcv selected by mask into a new
+// TestSimpleTableWithEnumT, leaving the others zero.
+func (rcv *TestSimpleTableWithEnum) UnPackWithMask(mask *TestSimpleTableWithEnumMask) *TestSimpleTableWithEnumT {
+	if rcv == nil {
+		return nil
+	}
+	if mask == nil {
+		mask = &TestSimpleTableWithEnumMask{}
+	}
+	if mask.All {
+		return rcv.UnPack()
+	}
+	t := &TestSimpleTableWithEnumT{}
+	if mask.Color {
+		t.Color = rcv.Color()
+	}
+	return t
+}
+
+// ProjectTo writes a copy of the fields of the TestSimpleTableWithEnum selected by mask, and
+// the data they reference, to builder, and returns its offset. Dropping a
+// required field records an error in builder.
+func (rcv *TestSimpleTableWithEnum) ProjectTo(builder *flatbuffers.Builder, mask *TestSimpleTableWithEnumMask) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	if mask == nil {
+		mask = &TestSimpleTableWithEnumMask{}
+	}
+	if mask.All {
+		return rcv.Splice(builder)
+	}
+	TestSimpleTableWithEnumStart(builder)
+	if mask.Color {
+		TestSimpleTableWithEnumAddColor(builder, rcv.Color())
+	}
+	return TestSimpleTableWithEnumEnd(builder)
+}
+
+// RandomTestSimpleTableWithEnumT returns a TestSimpleTableWithEnumT with random fields from r. Required
+// fields are always set.
+func RandomTestSimpleTableWithEnumT(r *flatbuffers.Random) *TestSimpleTableWithEnumT {
+	r.Enter()
+	defer r.Leave()
+	t := &TestSimpleTableWithEnumT{}
+	t.Color = RandomColor(r)
+	return t
+}
+
 type TestSimpleTableWithEnum struct {
 	_tab flatbuffers.Table
 }
@@ -88,3 +172,26 @@ func TestSimpleTableWithEnumAddColor(builder *flatbuffers.Builder, color Color)
 func TestSimpleTableWithEnumEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
+
+// TestSimpleTableWithEnumBuilder builds a TestSimpleTableWithEnum with chainable Add methods. It panics
+// if a field is added after Finish, which checks the required fields.
+type TestSimpleTableWithEnumBuilder struct {
+	table flatbuffers.TableBuilder
+}
+
+// NewTestSimpleTableWithEnumBuilder starts a TestSimpleTableWithEnum in builder.
+func NewTestSimpleTableWithEnumBuilder(builder *flatbuffers.Builder) *TestSimpleTableWithEnumBuilder {
+	rcv := &TestSimpleTableWithEnumBuilder{}
+	rcv.table.Start(builder, "MyGame.Example.TestSimpleTableWithEnum", 1)
+	return rcv
+}
+func (rcv *TestSimpleTableWithEnumBuilder) AddColor(color Color) *TestSimpleTableWithEnumBuilder {
+	TestSimpleTableWithEnumAddColor(rcv.table.Field(0), color)
+	return rcv
+}
+
+// Finish ends the TestSimpleTableWithEnum and returns its offset, and an error
+// wrapping flatbuffers.ErrRequiredField if a required field was not added.
+func (rcv *TestSimpleTableWithEnumBuilder) Finish() (flatbuffers.UOffsetT, error) {
+	return TestSimpleTableWithEnumEnd(rcv.table.End()), rcv.table.Err()
+}
@@ -59,7 +59,15 @@ func (t *TypeAliasesT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return TypeAliasesEnd(builder)
 }
 
+// UnPackTo unpacks rcv into t, reusing the vectors and objects t already holds,
+// which must not be shared with other values.
 func (rcv *TypeAliases) UnPackTo(t *TypeAliasesT) {
+	rcv.UnPackToInterned(t, nil)
+}
+
+// UnPackToInterned is UnPackTo taking the strings it allocates from interner,
+// which may be nil.
+func (rcv *TypeAliases) UnPackToInterned(t *TypeAliasesT, interner *flatbuffers.Interner) {
 	t.I8 = rcv.I8()
 	t.U8 = rcv.U8()
 	t.I16 = rcv.I16()
@@ -71,12 +79,24 @@ func (rcv *TypeAliases) UnPackTo(t *TypeAliasesT) {
 	t.F32 = rcv.F32()
 	t.F64 = rcv.F64()
 	v8Length := rcv.V8Length()
-	t.V8 = make([]int8, v8Length)
+	if rcv._tab.Offset(24) == 0 {
+		t.V8 = nil
+	} else if t.V8 == nil || cap(t.V8) < v8Length {
+		t.V8 = make([]int8, v8Length)
+	} else {
+		t.V8 = t.V8[:v8Length]
+	}
 	for j := 0; j < v8Length; j++ {
 		t.V8[j] = rcv.V8(j)
 	}
 	vf64Length := rcv.Vf64Length()
-	t.Vf64 = make([]float64, vf64Length)
+	if rcv._tab.Offset(26) == 0 {
+		t.Vf64 = nil
+	} else if t.Vf64 == nil || cap(t.Vf64) < vf64Length {
+		t.Vf64 = make([]float64, vf64Length)
+	} else {
+		t.Vf64 = t.Vf64[:vf64Length]
+	}
 	for j := 0; j < vf64Length; j++ {
 		t.Vf64[j] = rcv.Vf64(j)
 	}
@@ -91,6 +111,214 @@ func (rcv *TypeAliases) UnPack() *TypeAliasesT {
 	return t
 }
 
+// Reset sets t to the zero TypeAliasesT, except that its vectors keep
+// their capacity for UnPackTo, which sets those its table lacks to nil.
+// A vector that was not nil is packed as empty until then.
+func (t *TypeAliasesT) Reset() {
+	*t = TypeAliasesT{
+		V8: t.V8[:0],
+		Vf64: t.Vf64[:0],
+	}
+}
+
+// Splice writes a copy of the TypeAliases and the data it references to
+// builder, and returns its offset, like Pack without unpacking it.
+func (rcv *TypeAliases) Splice(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	v8Offset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(24)); o != 0 {
+		v8Offset = builder.SpliceVector(&rcv._tab, o, 1, 1)
+	}
+	vf64Offset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(26)); o != 0 {
+		vf64Offset = builder.SpliceVector(&rcv._tab, o, 8, 8)
+	}
+	TypeAliasesStart(builder)
+	TypeAliasesAddI8(builder, rcv.I8())
+	TypeAliasesAddU8(builder, rcv.U8())
+	TypeAliasesAddI16(builder, rcv.I16())
+	TypeAliasesAddU16(builder, rcv.U16())
+	TypeAliasesAddI32(builder, rcv.I32())
+	TypeAliasesAddU32(builder, rcv.U32())
+	TypeAliasesAddI64(builder, rcv.I64())
+	TypeAliasesAddU64(builder, rcv.U64())
+	TypeAliasesAddF32(builder, rcv.F32())
+	TypeAliasesAddF64(builder, rcv.F64())
+	TypeAliasesAddV8(builder, v8Offset)
+	TypeAliasesAddVf64(builder, vf64Offset)
+	return TypeAliasesEnd(builder)
+}
+
+// TypeAliasesMask selects fields of a TypeAliases for UnPackWithMask and ProjectTo.
+// A table, or a vector of tables, is selected by a mask of the fields of its
+// tables. All selects every field, including those of nested tables, and a
+// nil mask selects none.
+type TypeAliasesMask struct {
+	All bool
+	I8 bool
+	U8 bool
+	I16 bool
+	U16 bool
+	I32 bool
+	U32 bool
+	I64 bool
+	U64 bool
+	F32 bool
+	F64 bool
+	V8 bool
+	Vf64 bool
+}
+
+// UnPackWithMask unpacks the fields of rcv selected by mask into a new
+// TypeAliasesT, leaving the others zero.
+func (rcv *TypeAliases) UnPackWithMask(mask *TypeAliasesMask) *TypeAliasesT {
+	if rcv == nil {
+		return nil
+	}
+	if mask == nil {
+		mask = &TypeAliasesMask{}
+	}
+	if mask.All {
+		return rcv.UnPack()
+	}
+	t := &TypeAliasesT{}
+	if mask.I8 {
+		t.I8 = rcv.I8()
+	}
+	if mask.U8 {
+		t.U8 = rcv.U8()
+	}
+	if mask.I16 {
+		t.I16 = rcv.I16()
+	}
+	if mask.U16 {
+		t.U16 = rcv.U16()
+	}
+	if mask.I32 {
+		t.I32 = rcv.I32()
+	}
+	if mask.U32 {
+		t.U32 = rcv.U32()
+	}
+	if mask.I64 {
+		t.I64 = rcv.I64()
+	}
+	if mask.U64 {
+		t.U64 = rcv.U64()
+	}
+	if mask.F32 {
+		t.F32 = rcv.F32()
+	}
+	if mask.F64 {
+		t.F64 = rcv.F64()
+	}
+	if mask.V8 {
+		v8Length := rcv.V8Length()
+		t.V8 = make([]int8, v8Length)
+		for j := 0; j < v8Length; j++ {
+			t.V8[j] = rcv.V8(j)
+		}
+	}
+	if mask.Vf64 {
+		vf64Length := rcv.Vf64Length()
+		t.Vf64 = make([]float64, vf64Length)
+		for j := 0; j < vf64Length; j++ {
+			t.Vf64[j] = rcv.Vf64(j)
+		}
+	}
+	return t
+}
+
+// ProjectTo writes a copy of the fields of the TypeAliases selected by mask, and
+// the data they reference, to builder, and returns its offset. Dropping a
+// required field records an error in builder.
+func (rcv *TypeAliases) ProjectTo(builder *flatbuffers.Builder, mask *TypeAliasesMask) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	if mask == nil {
+		mask = &TypeAliasesMask{}
+	}
+	if mask.All {
+		return rcv.Splice(builder)
+	}
+	v8Offset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(24)); o != 0 && mask.V8 {
+		v8Offset = builder.SpliceVector(&rcv._tab, o, 1, 1)
+	}
+	vf64Offset := flatbuffers.UOffsetT(0)
+	if o := flatbuffers.UOffsetT(rcv._tab.Offset(26)); o != 0 && mask.Vf64 {
+		vf64Offset = builder.SpliceVector(&rcv._tab, o, 8, 8)
+	}
+	TypeAliasesStart(builder)
+	if mask.I8 {
+		TypeAliasesAddI8(builder, rcv.I8())
+	}
+	if mask.U8 {
+		TypeAliasesAddU8(builder, rcv.U8())
+	}
+	if mask.I16 {
+		TypeAliasesAddI16(builder, rcv.I16())
+	}
+	if mask.U16 {
+		TypeAliasesAddU16(builder, rcv.U16())
+	}
+	if mask.I32 {
+		TypeAliasesAddI32(builder, rcv.I32())
+	}
+	if mask.U32 {
+		TypeAliasesAddU32(builder, rcv.U32())
+	}
+	if mask.I64 {
+		TypeAliasesAddI64(builder, rcv.I64())
+	}
+	if mask.U64 {
+		TypeAliasesAddU64(builder, rcv.U64())
+	}
+	if mask.F32 {
+		TypeAliasesAddF32(builder, rcv.F32())
+	}
+	if mask.F64 {
+		TypeAliasesAddF64(builder, rcv.F64())
+	}
+	TypeAliasesAddV8(builder, v8Offset)
+	TypeAliasesAddVf64(builder, vf64Offset)
+	return TypeAliasesEnd(builder)
+}
+
+// RandomTypeAliasesT returns a TypeAliasesT with random fields from r. Required
+// fields are always set.
+func RandomTypeAliasesT(r *flatbuffers.Random) *TypeAliasesT {
+	r.Enter()
+	defer r.Leave()
+	t := &TypeAliasesT{}
+	t.I8 = r.Int8()
+	t.U8 = r.Byte()
+	t.I16 = r.Int16()
+	t.U16 = r.Uint16()
+	t.I32 = r.Int32()
+	t.U32 = r.Uint32()
+	t.I64 = r.Int64()
+	t.U64 = r.Uint64()
+	t.F32 = r.Float32()
+	t.F64 = r.Float64()
+	if r.Optional() {
+		t.V8 = make([]int8, r.Length())
+		for j := range t.V8 {
+			t.V8[j] = r.Int8()
+		}
+	}
+	if r.Optional() {
+		t.Vf64 = make([]float64, r.Length())
+		for j := range t.Vf64 {
+			t.Vf64[j] = r.Float64()
+		}
+	}
+	return t
+}
+
 type TypeAliases struct {
 	_tab flatbuffers.Table
 }
@@ -346,3 +574,70 @@ func TypeAliasesStartVf64Vector(builder *flatbuffers.Builder, numElems int) flat
 func TypeAliasesEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
+
+// TypeAliasesBuilder builds a TypeAliases with chainable Add methods. It panics
+// if a field is added after Finish, which checks the required fields.
+type TypeAliasesBuilder struct {
+	table flatbuffers.TableBuilder
+}
+
+// NewTypeAliasesBuilder starts a TypeAliases in builder.
+func NewTypeAliasesBuilder(builder *flatbuffers.Builder) *TypeAliasesBuilder {
+	rcv := &TypeAliasesBuilder{}
+	rcv.table.Start(builder, "MyGame.Example.TypeAliases", 12)
+	return rcv
+}
+func (rcv *TypeAliasesBuilder) AddI8(i8 int8) *TypeAliasesBuilder {
+	TypeAliasesAddI8(rcv.table.Field(0), i8)
+	return rcv
+}
+func (rcv *TypeAliasesBuilder) AddU8(u8 byte) *TypeAliasesBuilder {
+	TypeAliasesAddU8(rcv.table.Field(1), u8)
+	return rcv
+}
+func (rcv *TypeAliasesBuilder) AddI16(i16 int16) *TypeAliasesBuilder {
+	TypeAliasesAddI16(rcv.table.Field(2), i16)
+	return rcv
+}
+func (rcv *TypeAliasesBuilder) AddU16(u16 uint16) *TypeAliasesBuilder {
+	TypeAliasesAddU16(rcv.table.Field(3), u16)
+	return rcv
+}
+func (rcv *TypeAliasesBuilder) AddI32(i32 int32) *TypeAliasesBuilder {
+	TypeAliasesAddI32(rcv.table.Field(4), i32)
+	return rcv
+}
+func (rcv *TypeAliasesBuilder) AddU32(u32 uint32) *TypeAliasesBuilder {
+	TypeAliasesAddU32(rcv.table.Field(5), u32)
+	return rcv
+}
+func (rcv *TypeAliasesBuilder) AddI64(i64 int64) *TypeAliasesBuilder {
+	TypeAliasesAddI64(rcv.table.Field(6), i64)
+	return rcv
+}
+func (rcv *TypeAliasesBuilder) AddU64(u64 uint64) *TypeAliasesBuilder {
+	TypeAliasesAddU64(rcv.table.Field(7), u64)
+	return rcv
+}
+func (rcv *TypeAliasesBuilder) AddF32(f32 float32) *TypeAliasesBuilder {
+	TypeAliasesAddF32(rcv.table.Field(8), f32)
+	return rcv
+}
+func (rcv *TypeAliasesBuilder) AddF64(f64 float64) *TypeAliasesBuilder {
+	TypeAliasesAddF64(rcv.table.Field(9), f64)
+	return rcv
+}
+func (rcv *TypeAliasesBuilder) AddV8(v8 flatbuffers.UOffsetT) *TypeAliasesBuilder {
+	TypeAliasesAddV8(rcv.table.Field(10), v8)
+	return rcv
+}
+func (rcv *TypeAliasesBuilder) AddVf64(vf64 flatbuffers.UOffsetT) *TypeAliasesBuilder {
+	TypeAliasesAddVf64(rcv.table.Field(11), vf64)
+	return rcv
+}
+
+// Finish ends the TypeAliases and returns its offset, and an error
+// wrapping flatbuffers.ErrRequiredField if a required field was not added.
+func (rcv *TypeAliasesBuilder) Finish() (flatbuffers.UOffsetT, error) {
+	return TypeAliasesEnd(rcv.table.End()), rcv.table.Err()
+}
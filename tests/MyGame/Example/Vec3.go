@@ -27,7 +27,10 @@ func (rcv *Vec3) UnPackTo(t *Vec3T) {
 	t.Z = rcv.Z()
 	t.Test1 = rcv.Test1()
 	t.Test2 = rcv.Test2()
-	t.Test3 = rcv.Test3(nil).UnPack()
+	if t.Test3 == nil {
+		t.Test3 = &TestT{}
+	}
+	rcv.Test3(nil).UnPackTo(t.Test3)
 }
 
 func (rcv *Vec3) UnPack() *Vec3T {
@@ -39,6 +42,28 @@ func (rcv *Vec3) UnPack() *Vec3T {
 	return t
 }
 
+// Reset sets t to the zero Vec3T, resetting its nested structs.
+func (t *Vec3T) Reset() {
+	*t = Vec3T{
+		Test3: t.Test3,
+	}
+	if t.Test3 != nil {
+		t.Test3.Reset()
+	}
+}
+
+// RandomVec3T returns a Vec3T with random fields from r.
+func RandomVec3T(r *flatbuffers.Random) *Vec3T {
+	return &Vec3T{
+		X: r.Float32(),
+		Y: r.Float32(),
+		Z: r.Float32(),
+		Test1: r.Float64(),
+		Test2: RandomColor(r),
+		Test3: RandomTestT(r),
+	}
+}
+
 type Vec3 struct {
 	_tab flatbuffers.Struct
 }
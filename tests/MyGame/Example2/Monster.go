@@ -17,7 +17,15 @@ func (t *MonsterT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return MonsterEnd(builder)
 }
 
+// UnPackTo unpacks rcv into t, reusing the vectors and objects t already holds,
+// which must not be shared with other values.
 func (rcv *Monster) UnPackTo(t *MonsterT) {
+	rcv.UnPackToInterned(t, nil)
+}
+
+// UnPackToInterned is UnPackTo taking the strings it allocates from interner,
+// which may be nil.
+func (rcv *Monster) UnPackToInterned(t *MonsterT, interner *flatbuffers.Interner) {
 }
 
 func (rcv *Monster) UnPack() *MonsterT {
@@ -29,6 +37,73 @@ func (rcv *Monster) UnPack() *MonsterT {
 	return t
 }
 
+// Reset sets t to the zero MonsterT, except that its vectors keep
+// their capacity for UnPackTo, which sets those its table lacks to nil.
+// A vector that was not nil is packed as empty until then.
+func (t *MonsterT) Reset() {
+	*t = MonsterT{}
+}
+
+// Splice writes a copy of the Monster and the data it references to
+// builder, and returns its offset, like Pack without unpacking it.
+func (rcv *Monster) Splice(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	MonsterStart(builder)
+	return MonsterEnd(builder)
+}
+
+// MonsterMask selects fields of a Monster for UnPackWithMask and ProjectTo.
+// A table, or a vector of tables, is selected by a mask of the fields of its
+// tables. All selects every field, including those of nested tables, and a
+// nil mask selects none.
+type MonsterMask struct {
+	All bool
+}
+
+// UnPackWithMask unpacks the fields of rcv selected by mask into a new
+// MonsterT, leaving the others zero.
+func (rcv *Monster) UnPackWithMask(mask *MonsterMask) *MonsterT {
+	if rcv == nil {
+		return nil
+	}
+	if mask == nil {
+		mask = &MonsterMask{}
+	}
+	if mask.All {
+		return rcv.UnPack()
+	}
+	t := &MonsterT{}
+	return t
+}
+
+// ProjectTo writes a copy of the fields of the Monster selected by mask, and
+// the data they reference, to builder, and returns its offset. Dropping a
+// required field records an error in builder.
+func (rcv *Monster) ProjectTo(builder *flatbuffers.Builder, mask *MonsterMask) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	if mask == nil {
+		mask = &MonsterMask{}
+	}
+	if mask.All {
+		return rcv.Splice(builder)
+	}
+	MonsterStart(builder)
+	return MonsterEnd(builder)
+}
+
+// RandomMonsterT returns a MonsterT with random fields from r. Required
+// fields are always set.
+func RandomMonsterT(r *flatbuffers.Random) *MonsterT {
+	r.Enter()
+	defer r.Leave()
+	t := &MonsterT{}
+	return t
+}
+
 type Monster struct {
 	_tab flatbuffers.Table
 }
@@ -70,3 +145,22 @@ func MonsterStart(builder *flatbuffers.Builder) {
 func MonsterEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
+
+// MonsterBuilder builds a Monster with chainable Add methods. It panics
+// if a field is added after Finish, which checks the required fields.
+type MonsterBuilder struct {
+	table flatbuffers.TableBuilder
+}
+
+// NewMonsterBuilder starts a Monster in builder.
+func NewMonsterBuilder(builder *flatbuffers.Builder) *MonsterBuilder {
+	rcv := &MonsterBuilder{}
+	rcv.table.Start(builder, "MyGame.Example2.Monster", 0)
+	return rcv
+}
+
+// Finish ends the Monster and returns its offset, and an error
+// wrapping flatbuffers.ErrRequiredField if a required field was not added.
+func (rcv *MonsterBuilder) Finish() (flatbuffers.UOffsetT, error) {
+	return MonsterEnd(rcv.table.End()), rcv.table.Err()
+}
@@ -17,7 +17,15 @@ func (t *InParentNamespaceT) Pack(builder *flatbuffers.Builder) flatbuffers.UOff
 	return InParentNamespaceEnd(builder)
 }
 
+// UnPackTo unpacks rcv into t, reusing the vectors and objects t already holds,
+// which must not be shared with other values.
 func (rcv *InParentNamespace) UnPackTo(t *InParentNamespaceT) {
+	rcv.UnPackToInterned(t, nil)
+}
+
+// UnPackToInterned is UnPackTo taking the strings it allocates from interner,
+// which may be nil.
+func (rcv *InParentNamespace) UnPackToInterned(t *InParentNamespaceT, interner *flatbuffers.Interner) {
 }
 
 func (rcv *InParentNamespace) UnPack() *InParentNamespaceT {
@@ -29,6 +37,73 @@ func (rcv *InParentNamespace) UnPack() *InParentNamespaceT {
 	return t
 }
 
+// Reset sets t to the zero InParentNamespaceT, except that its vectors keep
+// their capacity for UnPackTo, which sets those its table lacks to nil.
+// A vector that was not nil is packed as empty until then.
+func (t *InParentNamespaceT) Reset() {
+	*t = InParentNamespaceT{}
+}
+
+// Splice writes a copy of the InParentNamespace and the data it references to
+// builder, and returns its offset, like Pack without unpacking it.
+func (rcv *InParentNamespace) Splice(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	InParentNamespaceStart(builder)
+	return InParentNamespaceEnd(builder)
+}
+
+// InParentNamespaceMask selects fields of a InParentNamespace for UnPackWithMask and ProjectTo.
+// A table, or a vector of tables, is selected by a mask of the fields of its
+// tables. All selects every field, including those of nested tables, and a
+// nil mask selects none.
+type InParentNamespaceMask struct {
+	All bool
+}
+
+// UnPackWithMask unpacks the fields of rcv selected by mask into a new
+// InParentNamespaceT, leaving the others zero.
+func (rcv *InParentNamespace) UnPackWithMask(mask *InParentNamespaceMask) *InParentNamespaceT {
+	if rcv == nil {
+		return nil
+	}
+	if mask == nil {
+		mask = &InParentNamespaceMask{}
+	}
+	if mask.All {
+		return rcv.UnPack()
+	}
+	t := &InParentNamespaceT{}
+	return t
+}
+
+// ProjectTo writes a copy of the fields of the InParentNamespace selected by mask, and
+// the data they reference, to builder, and returns its offset. Dropping a
+// required field records an error in builder.
+func (rcv *InParentNamespace) ProjectTo(builder *flatbuffers.Builder, mask *InParentNamespaceMask) flatbuffers.UOffsetT {
+	if rcv == nil {
+		return 0
+	}
+	if mask == nil {
+		mask = &InParentNamespaceMask{}
+	}
+	if mask.All {
+		return rcv.Splice(builder)
+	}
+	InParentNamespaceStart(builder)
+	return InParentNamespaceEnd(builder)
+}
+
+// RandomInParentNamespaceT returns a InParentNamespaceT with random fields from r. Required
+// fields are always set.
+func RandomInParentNamespaceT(r *flatbuffers.Random) *InParentNamespaceT {
+	r.Enter()
+	defer r.Leave()
+	t := &InParentNamespaceT{}
+	return t
+}
+
 type InParentNamespace struct {
 	_tab flatbuffers.Table
 }
@@ -70,3 +145,22 @@ func InParentNamespaceStart(builder *flatbuffers.Builder) {
 func InParentNamespaceEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
+
+// InParentNamespaceBuilder builds a InParentNamespace with chainable Add methods. It panics
+// if a field is added after Finish, which checks the required fields.
+type InParentNamespaceBuilder struct {
+	table flatbuffers.TableBuilder
+}
+
+// NewInParentNamespaceBuilder starts a InParentNamespace in builder.
+func NewInParentNamespaceBuilder(builder *flatbuffers.Builder) *InParentNamespaceBuilder {
+	rcv := &InParentNamespaceBuilder{}
+	rcv.table.Start(builder, "MyGame.InParentNamespace", 0)
+	return rcv
+}
+
+// Finish ends the InParentNamespace and returns its offset, and an error
+// wrapping flatbuffers.ErrRequiredField if a required field was not added.
+func (rcv *InParentNamespaceBuilder) Finish() (flatbuffers.UOffsetT, error) {
+	return InParentNamespaceEnd(rcv.table.End()), rcv.table.Err()
+}
@@ -0,0 +1,25 @@
+//go:build flatbuffers_checked
+// +build flatbuffers_checked
+
+package main
+
+import (
+	"errors"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// TestCheckedByDefault checks that the flatbuffers_checked tag makes the
+// Builders from NewBuilder checked ones.
+func TestCheckedByDefault(t *testing.T) {
+	foreign := flatbuffers.NewBuilder(0).CreateString("Pig")
+	b := flatbuffers.NewBuilder(0)
+	b.CreateString("Mushroom")
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, flatbuffers.ErrInvalidOffset) {
+			t.Errorf("got %v, want a panic with ErrInvalidOffset", err)
+		}
+	}()
+	b.CreateVectorOfTables([]flatbuffers.UOffsetT{foreign})
+}
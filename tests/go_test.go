@@ -21,19 +21,43 @@ import (
 	example "MyGame/Example" // refers to generated code
 	pizza "Pizza"
 	"encoding/json"
+	"errors"
 	optional_scalars "optional_scalars" // refers to generated code
 	order "order"
+	typed "typed/MyGame/Example" // generated with --go-typed-offsets
 
 	"bytes"
+	"context"
 	"flag"
 	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/rpc"
 	"os"
+	"path/filepath"
 	"reflect"
+	"runtime"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"testing/quick"
 
 	flatbuffers "github.com/google/flatbuffers/go"
+	"github.com/google/flatbuffers/go/annotator"
+	"github.com/google/flatbuffers/go/flatdiff"
+	"github.com/google/flatbuffers/go/flathash"
+	"github.com/google/flatbuffers/go/flathttp"
+	"github.com/google/flatbuffers/go/flatlog"
+	"github.com/google/flatbuffers/go/flatrpc"
+	"github.com/google/flatbuffers/go/gogen"
+	"github.com/google/flatbuffers/go/idl"
+	"github.com/google/flatbuffers/go/reflection"
 )
 
 var (
@@ -169,6 +193,30 @@ func TestAll(t *testing.T) {
 	CheckObjectAPI(monsterDataCpp, 0, false, t.Fatalf)
 	CheckFileIdentifier(monsterDataCpp, 0, false, t.Fatalf)
 
+	// Verify that Go hashes strings to the same values as flatc:
+	CheckHashedFields(monsterDataCpp, t.Fatalf)
+
+	// Verify that Go annotates buffers the same way as flatc --annotate:
+	CheckAnnotatedBinary(filepath.Dir(cppData), t.Fatalf)
+
+	// Verify that buffers are compared by value, not by layout:
+	CheckStructuralDiff(filepath.Dir(cppData), monsterDataCpp, t, t.Fatalf)
+
+	// Verify that Go code generated from a binary schema matches flatc's:
+	CheckGoGenerator(filepath.Dir(cppData), t.Fatalf)
+
+	// Verify that Go parses .fbs schemas into the same binary schema as flatc:
+	CheckSchemaParser(filepath.Dir(cppData), t.Fatalf)
+
+	// Verify that the gRPC codec reports bad messages as errors:
+	CheckCodec(monsterDataCpp, t.Fatalf)
+
+	// Verify the generated net/http client and handler of MonsterStorage:
+	CheckHTTPService(t.Fatalf)
+
+	// Verify MonsterStorage calls over net/rpc with the FlatBuffers codecs:
+	CheckNetRPC(t.Fatalf)
+
 	// Verify that vtables are deduplicated when written:
 	CheckVtableDeduplication(t.Fatalf)
 
@@ -181,6 +229,22 @@ func TestAll(t *testing.T) {
 	// Verify the enum values maps
 	CheckEnumValues(t.Fatalf)
 
+	// Verify enum validation, text marshaling and bit_flags sets
+	CheckEnumHelpers(t.Fatalf)
+
+	// Verify that tables ended without their required fields are reported
+	CheckRequiredFields(t.Fatalf)
+
+	// Verify that a Registry identifies and opens buffers of several types
+	CheckRegistry(monsterDataCpp, filepath.Dir(cppData), t.Fatalf)
+
+	// Verify that memory-mapped buffers can be read but not mutated
+	CheckMapFile(monsterDataCpp, t.Fatalf)
+
+	// Verify that record logs are read back by number, in order and after a
+	// crash
+	CheckRecordLog(t.Fatalf)
+
 	// Verify that the Go code used in FlatBuffers documentation passes
 	// some sanity checks:
 	CheckDocExample(generated, off, t.Fatalf)
@@ -203,6 +267,37 @@ func TestAll(t *testing.T) {
 	// Check that getting vector element by key works
 	CheckByKey(t.Fatalf)
 
+	// Check that tables are sorted by extracted keys, stably and without
+	// allocating
+	CheckSortedTablesByKey(t.Fatalf)
+
+	// Check that rolling back to a mark abandons a partially built subtree
+	CheckBuilderRollback(t.Fatalf)
+
+	// Check that finished buffers are spliced into a parent Builder
+	CheckSplice(monsterDataCpp, t.Fatalf)
+
+	// Check that a checked Builder rejects offsets it cannot refer to
+	CheckCheckedBuilder(monsterDataCpp, t.Fatalf)
+
+	// Check the generated builder types of tables
+	CheckTableBuilder(t.Fatalf)
+
+	// Check that code generated with typed offsets builds the same buffers
+	CheckTypedOffsets(monsterDataCpp, t.Fatalf)
+
+	// Check that UnPackTo reuses the objects it unpacks into
+	CheckUnPackReuse(monsterDataCpp, t.Fatalf)
+
+	// Check that field masks select the fields to unpack and project
+	CheckFieldMask(monsterDataCpp, t.Fatalf)
+
+	// Check that the generated Random functions make valid objects
+	CheckRandomObjects(filepath.Dir(cppData), t.Fatalf)
+
+	// Check typed access to nested_flatbuffer fields
+	CheckNestedFlatBuffer(t.Fatalf)
+
 	// If the filename of the FlatBuffers file generated by the Java test
 	// is given, check that Go code can read it, and that Go code
 	// generates an identical buffer when used to create the example data:
@@ -1457,8 +1552,9 @@ func CheckGeneratedBuild(sizePrefix, fileIdentifier bool, fail func(string, ...i
 
 // CheckTableAccessors checks that the table accessors work as expected.
 func CheckTableAccessors(fail func(string, ...interface{})) {
-	// test struct accessor
-	b := flatbuffers.NewBuilder(0)
+	// test struct accessor, finished as the root by an unchecked Builder, as
+	// checked ones only accept tables there
+	b := flatbuffers.NewUncheckedBuilder(0)
 	pos := example.CreateVec3(b, 1.0, 2.0, 3.0, 3.0, 4, 5, 6)
 	b.Finish(pos)
 	vec3Bytes := b.FinishedBytes()
@@ -1751,6 +1847,385 @@ func CheckEnumValues(fail func(string, ...interface{})) {
 	}
 }
 
+// CheckEnumHelpers checks the validation, text marshaling and flag methods
+// on generated enum types.
+func CheckEnumHelpers(fail func(string, ...interface{})) {
+	if got := example.Color(3).String(); got != "Red|Green" {
+		fail("Color(3).String: %q != %q", got, "Red|Green")
+	}
+	if got := example.Color(16).String(); got != "Color(16)" {
+		fail("Color(16).String: %q != %q", got, "Color(16)")
+	}
+	if !example.Color(11).IsValid() || example.Color(16).IsValid() {
+		fail("Color.IsValid is wrong")
+	}
+	if !example.RaceElf.IsValid() || example.Race(42).IsValid() {
+		fail("Race.IsValid is wrong")
+	}
+	want := []example.Race{example.RaceNone, example.RaceHuman, example.RaceDwarf, example.RaceElf}
+	if got := example.RaceValues(); !reflect.DeepEqual(got, want) {
+		fail("RaceValues: %v != %v", got, want)
+	}
+
+	var c example.Color
+	c.Set(example.ColorRed | example.ColorBlue)
+	c.Clear(example.ColorRed)
+	if c != example.ColorBlue || !c.Has(example.ColorBlue) || c.Has(example.ColorRed|example.ColorBlue) {
+		fail("Color set operations are wrong: %v", c)
+	}
+
+	// Flag combinations are written with spaces, as by flatc's JSON output.
+	text, err := (example.ColorRed | example.ColorGreen).MarshalText()
+	if err != nil || string(text) != "Red Green" {
+		fail("Color.MarshalText: %q, %v", text, err)
+	}
+	for s, want := range map[string]example.Color{
+		"Red Green": example.ColorRed | example.ColorGreen,
+		"Red|Blue":  example.ColorRed | example.ColorBlue,
+		"Green 8":   example.ColorGreen | example.ColorBlue,
+		"0":         0,
+	} {
+		if got, err := example.ParseColor(s); err != nil || got != want {
+			fail("ParseColor(%q) = %v, %v", s, got, err)
+		}
+	}
+	for _, s := range []string{"", "Purple", "Red 256"} {
+		if _, err := example.ParseColor(s); err == nil {
+			fail("ParseColor(%q) succeeded", s)
+		}
+	}
+	if r, err := example.ParseRace("-1"); err != nil || r != example.RaceNone {
+		fail("ParseRace: %v, %v", r, err)
+	}
+
+	// encoding/json uses the text marshaling of the object API fields.
+	m := example.MonsterT{Name: "orc", Color: example.ColorRed | example.ColorBlue, LongEnumNonEnumDefault: example.LongEnumLongBig}
+	buf, err := json.Marshal(&m)
+	if err != nil {
+		fail("%v", err)
+	}
+	if !bytes.Contains(buf, []byte(`"color":"Red Blue"`)) || !bytes.Contains(buf, []byte(`"long_enum_non_enum_default":"LongBig"`)) {
+		fail("unexpected JSON: %s", buf)
+	}
+	var got example.MonsterT
+	if err := json.Unmarshal(buf, &got); err != nil || got.Color != m.Color || got.LongEnumNonEnumDefault != m.LongEnumNonEnumDefault {
+		fail("JSON round trip: %v, %v", got.Color, err)
+	}
+
+	// Enum fields and vectors of enums were written as a number and a base64
+	// string before enums had MarshalText; they are now written as names.
+	m = example.MonsterT{Color: example.ColorBlue, VectorOfEnums: []example.Color{example.ColorRed, example.ColorBlue}, SignedEnum: example.Race(42)}
+	buf, err = json.Marshal(&m)
+	if err != nil {
+		fail("%v", err)
+	}
+	for _, want := range []string{`"color":"Blue"`, `"vector_of_enums":["Red","Blue"]`, `"signed_enum":"42"`} {
+		if !bytes.Contains(buf, []byte(want)) {
+			fail("JSON %s does not contain %s", buf, want)
+		}
+	}
+	// The old base64 vectors can still be read, and so can numbers in
+	// strings, but not the old numbers.
+	got = example.MonsterT{}
+	if err := json.Unmarshal([]byte(`{"color":"8","vector_of_enums":"AQg="}`), &got); err != nil ||
+		got.Color != m.Color || !reflect.DeepEqual(got.VectorOfEnums, m.VectorOfEnums) {
+		fail("decoding the old JSON: %v, %v, %v", got.Color, got.VectorOfEnums, err)
+	}
+	if err := json.Unmarshal([]byte(`{"color":8}`), &got); err == nil {
+		fail("decoded a color written as a number")
+	}
+}
+
+// CheckRequiredFields checks that the generated End functions record an error
+// on the Builder for a missing required field, and that Pack writes required
+// strings even if they are empty.
+func CheckRequiredFields(fail func(string, ...interface{})) {
+	b := flatbuffers.NewBuilder(0)
+	example.MonsterStart(b)
+	example.MonsterAddHp(b, 10)
+	b.Finish(example.MonsterEnd(b))
+	err := b.Err()
+	if !errors.Is(err, flatbuffers.ErrRequiredField) || !strings.Contains(err.Error(), "MyGame.Example.Monster.name") {
+		fail("expected a missing name error, got %v", err)
+	}
+	if _, err := (flatbuffers.FlatbuffersCodec{}).Marshal(b); !errors.Is(err, flatbuffers.ErrRequiredField) {
+		fail("expected Marshal to fail with the Builder's error, got %v", err)
+	}
+
+	// The error is kept by a Builder embedding the buffer.
+	outer := flatbuffers.NewBuilder(0)
+	nested := example.MonsterMakeTestnestedflatbufferVector(outer, b)
+	name := outer.CreateString("outer")
+	example.MonsterStart(outer)
+	example.MonsterAddName(outer, name)
+	example.MonsterAddTestnestedflatbuffer(outer, nested)
+	outer.Finish(example.MonsterEnd(outer))
+	if !errors.Is(outer.Err(), flatbuffers.ErrRequiredField) {
+		fail("expected the nested buffer's error, got %v", outer.Err())
+	}
+
+	b.Reset()
+	if b.Err() != nil {
+		fail("Reset did not clear the error")
+	}
+	name = b.CreateString("orc")
+	example.MonsterStart(b)
+	example.MonsterAddName(b, name)
+	b.Finish(example.MonsterEnd(b))
+	if b.Err() != nil {
+		fail("unexpected error: %v", b.Err())
+	}
+
+	b.Reset()
+	b.Finish((&example.MonsterT{}).Pack(b))
+	if b.Err() != nil {
+		fail("Pack of an empty name: %v", b.Err())
+	}
+	m := example.GetRootAsMonster(b.FinishedBytes(), 0)
+	if tab := m.Table(); tab.Offset(10) == 0 || len(m.Name()) != 0 {
+		fail("Pack did not write the empty name")
+	}
+}
+
+// CheckRegistry checks that a Registry dispatches buffers by their file
+// identifier, with and without a size prefix.
+func CheckRegistry(monster []byte, testDir string, fail func(string, ...interface{})) {
+	bfbs, err := os.ReadFile(filepath.Join(testDir, "monster_test.bfbs"))
+	if err != nil {
+		fail("%v", err)
+	}
+	var r flatbuffers.Registry
+	if err := r.Register(example.MonsterRootType); err != nil {
+		fail("%v", err)
+	}
+	if err := r.Register(reflection.SchemaRootType); err != nil {
+		fail("%v", err)
+	}
+	if err := r.Register(example.MonsterRootType); err == nil {
+		fail("registered Monster twice")
+	}
+	if t, ok := r.Lookup("reflection.Schema"); !ok || t.Identifier != "BFBS" {
+		fail("Lookup(reflection.Schema) = %v, %v", t, ok)
+	}
+
+	b := flatbuffers.NewBuilder(0)
+	example.FinishSizePrefixedMonsterBuffer(b, (&example.MonsterT{Name: "prefixed"}).Pack(b))
+	prefixed := b.FinishedBytes()
+
+	for _, buf := range [][]byte{monster, bfbs, prefixed} {
+		fb, err := r.Open(buf)
+		if err != nil {
+			fail("Open: %v", err)
+		}
+		switch fb := fb.(type) {
+		case *example.Monster:
+			if name := string(fb.Name()); name != "MyMonster" && name != "prefixed" {
+				fail("opened the wrong Monster: %q", name)
+			}
+		case *reflection.Schema:
+			if string(fb.FileIdent()) != "MONS" {
+				fail("opened the wrong Schema: %q", fb.FileIdent())
+			}
+		default:
+			fail("Open returned a %T", fb)
+		}
+	}
+	if t, ok := r.Identify(prefixed); !ok || t.Name != "MyGame.Example.Monster" {
+		fail("Identify of a size prefixed Monster = %v, %v", t.Name, ok)
+	}
+
+	b.Reset()
+	b.Finish((&example.StatT{Id: "no identifier"}).Pack(b))
+	if _, err := r.Open(b.FinishedBytes()); !errors.Is(err, flatbuffers.ErrUnknownIdentifier) {
+		fail("expected ErrUnknownIdentifier, got %v", err)
+	}
+	if _, err := r.Open([]byte{1, 2}); !errors.Is(err, flatbuffers.ErrBufferTooShort) {
+		fail("expected ErrBufferTooShort, got %v", err)
+	}
+	corrupt := append([]byte(nil), monster...)
+	flatbuffers.WriteUOffsetT(corrupt, flatbuffers.UOffsetT(len(corrupt)))
+	if _, err := r.Open(corrupt); !errors.Is(err, flatbuffers.ErrInvalidRootOffset) {
+		fail("expected ErrInvalidRootOffset, got %v", err)
+	}
+}
+
+// CheckMapFile checks that a buffer mapped by MapFile can be read like any
+// other, and that mutating it fails instead of faulting.
+func CheckMapFile(monster []byte, fail func(string, ...interface{})) {
+	f, err := os.CreateTemp("", "monster*.mon")
+	if err != nil {
+		fail("%v", err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(monster); err != nil {
+		fail("%v", err)
+	}
+	if err := f.Close(); err != nil {
+		fail("%v", err)
+	}
+
+	mf, err := flatbuffers.MapFile(f.Name())
+	if err != nil {
+		fail("MapFile: %v", err)
+	}
+	if err := mf.Advise(flatbuffers.AdviseRandom); err != nil {
+		fail("Advise: %v", err)
+	}
+	if !bytes.Equal(mf.Bytes(), monster) {
+		fail("mapped bytes differ from the file")
+	}
+	m := example.GetRootAsMonster(mf.Bytes(), 0)
+	if got := string(m.Name()); got != "MyMonster" {
+		fail("Name() = %q", got)
+	}
+	switch runtime.GOOS {
+	case "darwin", "dragonfly", "freebsd", "linux", "netbsd", "openbsd":
+		if m.MutateHp(1) || m.MutateInventory(0, 9) {
+			fail("mutated a mapped buffer")
+		}
+		if m.Hp() != 80 || m.Inventory(0) != 0 {
+			fail("mapped buffer changed: hp %d, inventory %d", m.Hp(), m.Inventory(0))
+		}
+	}
+	if err := mf.Close(); err != nil {
+		fail("Close: %v", err)
+	}
+	if err := mf.Close(); err != nil {
+		fail("second Close: %v", err)
+	}
+
+	// A copy of the buffer is writable again.
+	m = example.GetRootAsMonster(append([]byte(nil), monster...), 0)
+	if !m.MutateHp(1) || m.Hp() != 1 {
+		fail("could not mutate a heap buffer")
+	}
+}
+
+// CheckRecordLog checks that the records of a log are read back through its
+// index, or by scanning a log that was not closed, whose torn last record is
+// dropped.
+func CheckRecordLog(fail func(string, ...interface{})) {
+	dir, err := os.MkdirTemp("", "flatlog")
+	if err != nil {
+		fail("%v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "monsters.log")
+
+	b := flatbuffers.NewBuilder(0)
+	monster := func(name string) []byte {
+		b.Reset()
+		example.FinishMonsterBuffer(b, (&example.MonsterT{Name: name, Hp: int16(len(name))}).Pack(b))
+		return b.FinishedBytes()
+	}
+	check := func(names ...string) {
+		r, err := flatlog.Open(path)
+		if err != nil {
+			fail("Open: %v", err)
+		}
+		defer r.Close()
+		if r.Len() != len(names) {
+			fail("Len() = %d, want %d", r.Len(), len(names))
+		}
+		var m example.Monster
+		for i := len(names) - 1; i >= 0; i-- {
+			if err := r.Root(i, &m); err != nil {
+				fail("Root(%d): %v", i, err)
+			}
+			if string(m.Name()) != names[i] {
+				fail("record %d is %q, want %q", i, m.Name(), names[i])
+			}
+		}
+		it := r.Records()
+		for it.Next() {
+			it.Root(&m)
+			if string(m.Name()) != names[it.Index()] || int(m.Hp()) != len(names[it.Index()]) {
+				fail("iterated record %d is %q", it.Index(), m.Name())
+			}
+		}
+		if it.Err() != nil || it.Index() != len(names)-1 {
+			fail("iteration stopped at %d: %v", it.Index(), it.Err())
+		}
+		if _, err := r.Record(len(names)); err == nil {
+			fail("read a record past the end")
+		}
+	}
+
+	w, err := flatlog.Create(path)
+	if err != nil {
+		fail("Create: %v", err)
+	}
+	for i, name := range []string{"a", "bb", "ccc"} {
+		if n, err := w.Append(monster(name)); err != nil || n != i {
+			fail("Append(%q) = %d, %v", name, n, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		fail("Close: %v", err)
+	}
+	check("a", "bb", "ccc")
+
+	// Reopening drops the index, which is written again on Close.
+	if w, err = flatlog.OpenWriter(path); err != nil {
+		fail("OpenWriter: %v", err)
+	}
+	if n, err := w.Append(monster("dddd")); err != nil || n != 3 {
+		fail("Append after reopening = %d, %v", n, err)
+	}
+	if err := w.Close(); err != nil {
+		fail("Close: %v", err)
+	}
+	check("a", "bb", "ccc", "dddd")
+
+	// A writer that crashed leaves no index, and maybe a torn record.
+	if w, err = flatlog.OpenWriter(path); err != nil {
+		fail("OpenWriter: %v", err)
+	}
+	w.Append(monster("eeeee"))
+	w.Append(monster("torn"))
+	if err := w.Sync(); err != nil {
+		fail("Sync: %v", err)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		fail("%v", err)
+	}
+	if err := os.Truncate(path, fi.Size()-3); err != nil {
+		fail("%v", err)
+	}
+	check("a", "bb", "ccc", "dddd", "eeeee")
+
+	if w, err = flatlog.OpenWriter(path); err != nil {
+		fail("OpenWriter after a crash: %v", err)
+	}
+	if w.Len() != 5 {
+		fail("recovered %d records, want 5", w.Len())
+	}
+	w.Append(monster("f"))
+	if err := w.Close(); err != nil {
+		fail("Close: %v", err)
+	}
+	check("a", "bb", "ccc", "dddd", "eeeee", "f")
+
+	// A corrupt record is reported by a closed log.
+	data, err := os.ReadFile(path)
+	if err != nil {
+		fail("%v", err)
+	}
+	i := bytes.Index(data, []byte("bb"))
+	data[i] = 'x'
+	r, err := flatlog.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		fail("NewReader: %v", err)
+	}
+	if _, err := r.Record(1); !errors.Is(err, flatlog.ErrCorrupt) {
+		fail("expected ErrCorrupt, got %v", err)
+	}
+	if _, err := flatlog.NewReader(bytes.NewReader(monster("x")), 8); !errors.Is(err, flatlog.ErrNotLog) {
+		fail("expected ErrNotLog, got %v", err)
+	}
+}
+
 // CheckDocExample checks that the code given in FlatBuffers documentation
 // is syntactically correct.
 func CheckDocExample(buf []byte, off flatbuffers.UOffsetT, fail func(string, ...interface{})) {
@@ -1913,7 +2388,8 @@ func CheckByteEquality(a, b []byte, fail func(string, ...interface{})) {
 
 // CheckMutateMethods checks all mutate methods one by one
 func CheckMutateMethods(fail func(string, ...interface{})) {
-	b := flatbuffers.NewBuilder(0)
+	// The Builder is unchecked, as the table holds raw offsets.
+	b := flatbuffers.NewUncheckedBuilder(0)
 	b.StartObject(15)
 	b.PrependBoolSlot(0, true, false)
 	b.PrependByteSlot(1, 1, 0)
@@ -2418,93 +2894,959 @@ func CheckByKey(fail func(string, ...interface{})) {
 	expectEq("Mana Count", mpStat.Count(), uint16(0))
 }
 
-// BenchmarkVtableDeduplication measures the speed of vtable deduplication
-// by creating prePop vtables, then populating b.N objects with a
-// different single vtable.
-//
-// When b.N is large (as in long benchmarks), memory usage may be high.
-func BenchmarkVtableDeduplication(b *testing.B) {
-	prePop := 10
-	builder := flatbuffers.NewBuilder(0)
-
-	// pre-populate some vtables:
-	for i := 0; i < prePop; i++ {
-		builder.StartObject(i)
-		for j := 0; j < i; j++ {
-			builder.PrependInt16Slot(j, int16(j), 0)
+// CheckSortedTablesByKey checks that CreateVectorOfSortedTablesByKey sorts
+// stably by the generated key functions, and that duplicate keys are
+// reported by CreateVectorOfUniqueSortedTables.
+func CheckSortedTablesByKey(fail func(string, ...interface{})) {
+	keys := []flatbuffers.Key{
+		flatbuffers.Int64Key(math.MinInt64), flatbuffers.Int64Key(-1),
+		flatbuffers.Int64Key(0), flatbuffers.Int64Key(math.MaxInt64),
+	}
+	floats := []flatbuffers.Key{
+		flatbuffers.Float64Key(math.Inf(-1)), flatbuffers.Float64Key(-2.5),
+		flatbuffers.Float64Key(0), flatbuffers.Float64Key(1e-300),
+		flatbuffers.Float64Key(math.Inf(1)),
+	}
+	for _, ks := range [][]flatbuffers.Key{keys, floats} {
+		for i := 1; i < len(ks); i++ {
+			if ks[i-1].Compare(ks[i]) >= 0 || ks[i].Compare(ks[i-1]) <= 0 {
+				fail("keys %d and %d are out of order", i-1, i)
+			}
 		}
-		builder.EndObject()
 	}
 
-	// benchmark deduplication of a new vtable:
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		lim := prePop
+	b := flatbuffers.NewBuilder(1 << 20)
+	rng := rand.New(rand.NewSource(42))
+	const n = 1000
+	offsets := make([]flatbuffers.UOffsetT, n)
+	for i := range offsets {
+		stat := &example.StatT{Id: strconv.Itoa(i), Count: uint16(rng.Intn(50))}
+		offsets[i] = stat.Pack(b)
+	}
+	vec := b.CreateVectorOfSortedTablesByKey(offsets, example.StatKey)
+	name := b.CreateString("Stats")
+	example.MonsterStart(b)
+	example.MonsterAddName(b, name)
+	example.MonsterAddScalarKeySortedTables(b, vec)
+	b.Finish(example.MonsterEnd(b))
+	if b.Err() != nil {
+		fail("unexpected error: %v", b.Err())
+	}
 
-		builder.StartObject(lim)
-		for j := 0; j < lim; j++ {
-			builder.PrependInt16Slot(j, int16(j), 0)
+	monster := example.GetRootAsMonster(b.FinishedBytes(), 0)
+	var prev, stat example.Stat
+	for i := 0; i < monster.ScalarKeySortedTablesLength(); i++ {
+		monster.ScalarKeySortedTables(&stat, i)
+		if i > 0 {
+			id, _ := strconv.Atoi(string(stat.Id()))
+			prevID, _ := strconv.Atoi(string(prev.Id()))
+			if stat.Count() < prev.Count() {
+				fail("stat %d is out of order", i)
+			}
+			if stat.Count() == prev.Count() && id < prevID {
+				fail("stats %d and %d with equal keys were reordered", prevID, id)
+			}
 		}
-		builder.EndObject()
+		prev = stat
+	}
+	if !monster.ScalarKeySortedTablesByKey(&stat, 7) || stat.Count() != 7 {
+		fail("ScalarKeySortedTablesByKey(7) not found")
 	}
-}
 
-// BenchmarkParseGold measures the speed of parsing the 'gold' data
-// used throughout this test suite.
-func BenchmarkParseGold(b *testing.B) {
-	buf, offset := CheckGeneratedBuild(false, false, b.Fatalf)
-	monster := example.GetRootAsMonster(buf, offset)
+	allocs := testing.AllocsPerRun(10, func() {
+		b.CreateVectorOfSortedTablesByKey(offsets, example.StatKey)
+	})
+	if allocs != 0 {
+		fail("CreateVectorOfSortedTablesByKey allocated %v times", allocs)
+	}
 
-	// use these to prevent allocations:
-	reuse_pos := example.Vec3{}
-	reuse_test3 := example.Test{}
-	reuse_table2 := flatbuffers.Table{}
-	reuse_monster2 := example.Monster{}
-	reuse_test4_0 := example.Test{}
-	reuse_test4_1 := example.Test{}
+	b.Reset()
+	names := []string{"Pig", "Slime", "Pig"}
+	monsters := make([]flatbuffers.UOffsetT, len(names))
+	for i, name := range names {
+		monsters[i] = (&example.MonsterT{Name: name}).Pack(b)
+	}
+	b.CreateVectorOfUniqueSortedTables(monsters, example.MonsterKey)
+	if err := b.Err(); !errors.Is(err, flatbuffers.ErrDuplicateKey) || !strings.Contains(err.Error(), `"Pig"`) {
+		fail("expected a duplicate Pig, got %v", err)
+	}
+}
 
-	b.SetBytes(int64(len(buf[offset:])))
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		monster.Hp()
-		monster.Mana()
-		name := monster.Name()
-		_ = name[0]
-		_ = name[len(name)-1]
+// CheckBuilderRollback checks that a buffer whose builder rolled back a
+// half-built child is the same as one built without it.
+func CheckBuilderRollback(fail func(string, ...interface{})) {
+	build := func(b *flatbuffers.Builder, boss string, abandon func(*flatbuffers.Builder)) []byte {
+		children := make([]flatbuffers.UOffsetT, 0, 3)
+		for _, name := range []string{"Pig", "Slime"} {
+			nameOff := b.CreateSharedString(name)
+			example.MonsterStart(b)
+			example.MonsterAddName(b, nameOff)
+			children = append(children, example.MonsterEnd(b))
+		}
+		if abandon != nil {
+			mark := b.Mark()
+			abandon(b)
+			b.Rollback(mark)
+		}
+		nameOff := b.CreateSharedString("Mushroom")
+		example.MonsterStart(b)
+		example.MonsterAddName(b, nameOff)
+		example.MonsterAddHp(b, 20)
+		children = append(children, example.MonsterEnd(b))
+
+		vec := b.CreateVectorOfTables(children)
+		nameOff = b.CreateSharedString(boss)
+		example.MonsterStart(b)
+		example.MonsterAddName(b, nameOff)
+		example.MonsterAddTestarrayoftables(b, vec)
+		b.Finish(example.MonsterEnd(b))
+		if b.Err() != nil {
+			fail("unexpected error: %v", b.Err())
+		}
+		return b.FinishedBytes()
+	}
 
-		monster.Pos(&reuse_pos)
-		reuse_pos.X()
-		reuse_pos.Y()
-		reuse_pos.Z()
-		reuse_pos.Test1()
-		reuse_pos.Test2()
-		reuse_pos.Test3(&reuse_test3)
-		reuse_test3.A()
-		reuse_test3.B()
-		monster.TestType()
-		monster.Test(&reuse_table2)
-		reuse_monster2.Init(reuse_table2.Bytes, reuse_table2.Pos)
-		name2 := reuse_monster2.Name()
-		_ = name2[0]
-		_ = name2[len(name2)-1]
-		monster.InventoryLength()
-		l := monster.InventoryLength()
-		for i := 0; i < l; i++ {
-			monster.Inventory(i)
+	abandons := map[string]func(*flatbuffers.Builder){
+		// A child ended without its required name, with a new vtable and
+		// a string shared with the next one.
+		"ended": func(b *flatbuffers.Builder) {
+			b.CreateSharedString("Mushroom")
+			b.CreateString("abandoned")
+			example.MonsterStart(b)
+			example.MonsterAddTestf(b, 1.5)
+			example.MonsterAddTesthashu64Fnv1(b, 64)
+			example.MonsterEnd(b)
+		},
+		// A child still being built, and a larger alignment.
+		"nested": func(b *flatbuffers.Builder) {
+			example.MonsterStartInventoryVector(b, 1)
+			b.PrependByte(1)
+			b.EndVector(1)
+			example.MonsterStart(b)
+			example.MonsterAddTesthashu64Fnv1(b, 64)
+		},
+	}
+	// Bosses with names of different lengths need different padding.
+	for _, boss := range []string{"Boss", "Big Boss"} {
+		want := build(flatbuffers.NewBuilder(0), boss, nil)
+		for what, abandon := range abandons {
+			if got := build(flatbuffers.NewBuilder(0), boss, abandon); !bytes.Equal(got, want) {
+				fail("rolling back a %s child changed the buffer of %s", what, boss)
+			}
 		}
-		monster.Test4Length()
-		monster.Test4(&reuse_test4_0, 0)
-		monster.Test4(&reuse_test4_1, 1)
+	}
 
-		reuse_test4_0.A()
-		reuse_test4_0.B()
-		reuse_test4_1.A()
-		reuse_test4_1.B()
+	// A mark from before Reset cannot be rolled back to, even once the
+	// Builder has written past it again.
+	b := flatbuffers.NewBuilder(0)
+	b.CreateString("before")
+	mark := b.Mark()
+	b.Reset()
+	b.CreateString("written after Reset")
+	func() {
+		defer func() {
+			if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "before Reset") {
+				fail("Rollback to a mark taken before Reset: got %v, want a panic", r)
+			}
+		}()
+		b.Rollback(mark)
+	}()
+}
 
-		monster.TestarrayofstringLength()
-		str0 := monster.Testarrayofstring(0)
-		_ = str0[0]
+// CheckSplice checks that buffers finished by other Builders, some of them in
+// parallel, are copied into a parent as ordinary child tables that share its
+// vtables.
+func CheckSplice(monster []byte, fail func(string, ...interface{})) {
+	names := []string{"Pig", "Slime", "Mushroom"}
+	child := func(i int) *example.MonsterT {
+		return &example.MonsterT{Name: names[i], Hp: int16(10 * (i + 1)), Inventory: []byte{byte(i)}}
+	}
+	children := make([][]byte, len(names))
+	var wg sync.WaitGroup
+	for i := range names {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			b := flatbuffers.NewBuilder(0)
+			b.Finish(child(i).Pack(b))
+			children[i] = b.FinishedBytes()
+		}(i)
+	}
+	wg.Wait()
+
+	// A parent built from spliced children is the same as one built from
+	// packed children, vtables and all.
+	build := func(child func(*flatbuffers.Builder, int) flatbuffers.UOffsetT) *flatbuffers.Builder {
+		b := flatbuffers.NewBuilder(0)
+		offsets := make([]flatbuffers.UOffsetT, len(names))
+		for i := range names {
+			offsets[i] = child(b, i)
+		}
+		vec := b.CreateVectorOfTables(offsets)
+		name := b.CreateString("Boss")
+		example.MonsterStart(b)
+		example.MonsterAddName(b, name)
+		example.MonsterAddTestarrayoftables(b, vec)
+		b.Finish(example.MonsterEnd(b))
+		return b
+	}
+	spliced := build(func(b *flatbuffers.Builder, i int) flatbuffers.UOffsetT {
+		return b.Splice(children[i], &example.Monster{})
+	}).FinishedBytes()
+	packed := build(func(b *flatbuffers.Builder, i int) flatbuffers.UOffsetT {
+		return child(i).Pack(b)
+	}).FinishedBytes()
+	if !bytes.Equal(spliced, packed) {
+		fail("spliced buffer differs from the packed one")
+	}
+
+	// Packing the object API value of a buffer writes its missing vectors as
+	// empty ones, so a spliced buffer is compared with the original repacked.
+	repack := func(buf []byte) []byte {
+		b := flatbuffers.NewBuilder(0)
+		b.Finish(example.GetRootAsMonster(buf, 0).UnPack().Pack(b))
+		return b.FinishedBytes()
+	}
+	b := flatbuffers.NewBuilder(0)
+	b.Finish(b.Splice(monster, &example.Monster{}))
+	if !bytes.Equal(repack(b.FinishedBytes()), repack(monster)) {
+		fail("spliced monster differs from the original")
+	}
+	if m := example.GetRootAsMonster(b.FinishedBytes(), 0); m.Pos(nil).Test3(nil).A() != 5 || m.TestType() != example.AnyMonster {
+		fail("spliced monster lost its struct or union field")
+	}
+
+	boss := example.GetRootAsMonster(spliced, 0)
+	for i := range names {
+		var m example.Monster
+		boss.Testarrayoftables(&m, i)
+		if string(m.Name()) != names[i] || m.Hp() != int16(10*(i+1)) {
+			fail("spliced child %d is %q with %d hp", i, m.Name(), m.Hp())
+		}
+	}
+}
+
+// CheckCheckedBuilder checks that a checked Builder builds the same buffers
+// as an unchecked one, and panics with ErrInvalidOffset when given an offset
+// from another Builder, from before Reset or Rollback, or past its data.
+func CheckCheckedBuilder(monster []byte, fail func(string, ...interface{})) {
+	m := example.GetRootAsMonster(monster, 0).UnPack()
+	want := flatbuffers.NewUncheckedBuilder(0)
+	want.Finish(m.Pack(want))
+	got := flatbuffers.NewCheckedBuilder(0)
+	got.Finish(m.Pack(got))
+	if !bytes.Equal(got.FinishedBytes(), want.FinishedBytes()) {
+		fail("checked Builder packed a different monster")
+	}
+
+	invalid := func(what, reason string, build func()) {
+		defer func() {
+			err, _ := recover().(error)
+			if !errors.Is(err, flatbuffers.ErrInvalidOffset) {
+				fail("%s: got %v, want a panic with ErrInvalidOffset", what, err)
+			} else if !strings.Contains(err.Error(), reason) {
+				fail("%s: got %q, want it to mention %q", what, err, reason)
+			}
+		}()
+		build()
+	}
+
+	other := flatbuffers.NewCheckedBuilder(0)
+	foreign := other.CreateString("Pig")
+	invalid("foreign name", "not returned by this Builder", func() {
+		b := flatbuffers.NewCheckedBuilder(0)
+		b.CreateString("Mushroom")
+		example.MonsterStart(b)
+		example.MonsterAddName(b, foreign)
+	})
+	invalid("foreign vector element", "not returned by this Builder", func() {
+		b := flatbuffers.NewCheckedBuilder(0)
+		b.CreateString("Mushroom")
+		b.CreateVectorOfTables([]flatbuffers.UOffsetT{foreign})
+	})
+	invalid("forward name", "past the", func() {
+		b := flatbuffers.NewCheckedBuilder(0)
+		example.MonsterStart(b)
+		example.MonsterAddName(b, other.CreateString("Mushroom"))
+	})
+	invalid("stale name", "Reset", func() {
+		b := flatbuffers.NewCheckedBuilder(0)
+		stale := b.CreateString("Pig")
+		b.Reset()
+		b.CreateString("Mushroom")
+		example.MonsterStart(b)
+		example.MonsterAddName(b, stale)
+	})
+	invalid("name from an earlier build", "not returned by this Builder", func() {
+		b := flatbuffers.NewCheckedBuilder(0)
+		stale := b.CreateString("Pig")
+		b.Reset()
+		b.Reset()
+		b.CreateString("Mushroom")
+		example.MonsterStart(b)
+		example.MonsterAddName(b, stale)
+	})
+	invalid("rolled back name", "Rollback", func() {
+		b := flatbuffers.NewCheckedBuilder(0)
+		mark := b.Mark()
+		abandoned := b.CreateString("Pig")
+		b.Rollback(mark)
+		b.CreateString("Mushroom")
+		example.MonsterStart(b)
+		example.MonsterAddName(b, abandoned)
+	})
+}
+
+// CheckTableBuilder checks that a generated builder type builds the same
+// table as the builder functions, checks required fields, and panics when a
+// field is added after Finish.
+func CheckTableBuilder(fail func(string, ...interface{})) {
+	want := func() []byte {
+		b := flatbuffers.NewBuilder(0)
+		name := b.CreateString("Orc")
+		example.MonsterStart(b)
+		example.MonsterAddPos(b, example.CreateVec3(b, 1, 2, 3, 4, example.ColorRed, 5, 6))
+		example.MonsterAddHp(b, 300)
+		example.MonsterAddName(b, name)
+		example.MonsterAddColor(b, example.ColorGreen)
+		b.Finish(example.MonsterEnd(b))
+		return b.FinishedBytes()
+	}()
+
+	b := flatbuffers.NewBuilder(0)
+	name := b.CreateString("Orc")
+	mb := example.NewMonsterBuilder(b)
+	mb.AddPos(example.CreateVec3(b, 1, 2, 3, 4, example.ColorRed, 5, 6))
+	orc, err := mb.AddHp(300).AddName(name).AddColor(example.ColorGreen).Finish()
+	if err != nil {
+		fail("unexpected error: %v", err)
+	}
+	b.Finish(orc)
+	if !bytes.Equal(b.FinishedBytes(), want) {
+		fail("MonsterBuilder built a different monster")
+	}
+
+	b.Reset()
+	if _, err := example.NewMonsterBuilder(b).AddHp(300).Finish(); !errors.Is(err, flatbuffers.ErrRequiredField) ||
+		!strings.Contains(err.Error(), "MyGame.Example.Monster.name") {
+		fail("got %v, want the missing name", err)
+	}
+	// A zero offset is not written, so it does not count as the name.
+	b.Reset()
+	if _, err := example.NewMonsterBuilder(b).AddName(0).Finish(); !errors.Is(err, flatbuffers.ErrRequiredField) {
+		fail("got %v, want the missing name for a zero offset", err)
+	}
+	// The error of the Builder is returned too.
+	b.Reset()
+	example.MonsterStart(b)
+	example.MonsterEnd(b)
+	if _, err := example.NewStatBuilder(b).Finish(); !errors.Is(err, flatbuffers.ErrRequiredField) {
+		fail("got %v, want the name missing from the earlier monster", err)
+	}
+
+	panics := func(what string, build func()) {
+		defer func() {
+			if recover() == nil {
+				fail("%s did not panic", what)
+			}
+		}()
+		build()
+	}
+	panics("adding after Finish", func() {
+		mb := example.NewStatBuilder(flatbuffers.NewBuilder(0))
+		mb.Finish()
+		mb.AddCount(1)
+	})
+	panics("finishing twice", func() {
+		mb := example.NewStatBuilder(flatbuffers.NewBuilder(0))
+		mb.Finish()
+		mb.Finish()
+	})
+	panics("adding to the zero value", func() {
+		var mb example.StatBuilder
+		mb.AddCount(1)
+	})
+}
+
+// CheckTypedOffsets checks that the builder functions generated with
+// --go-typed-offsets, and the object API built on them, write the same buffers
+// as the untyped ones.
+func CheckTypedOffsets(monster []byte, fail func(string, ...interface{})) {
+	untypedBuilder := flatbuffers.NewBuilder(0)
+	untypedBuilder.Finish(example.GetRootAsMonster(monster, 0).UnPack().Pack(untypedBuilder))
+	typedBuilder := flatbuffers.NewBuilder(0)
+	typedBuilder.Finish(typed.GetRootAsMonster(monster, 0).UnPack().Pack(typedBuilder))
+	if !bytes.Equal(typedBuilder.FinishedBytes(), untypedBuilder.FinishedBytes()) {
+		fail("typed object API packed a different monster")
+	}
+	typedBuilder.Reset()
+	typedBuilder.Finish(typedBuilder.Splice(monster, &typed.Monster{}))
+	untypedBuilder.Reset()
+	untypedBuilder.Finish(untypedBuilder.Splice(monster, &example.Monster{}))
+	if !bytes.Equal(typedBuilder.FinishedBytes(), untypedBuilder.FinishedBytes()) {
+		fail("typed Splice copied a different monster")
+	}
+
+	want := func() []byte {
+		b := flatbuffers.NewBuilder(0)
+		enemyName := b.CreateString("Pig")
+		example.MonsterStart(b)
+		example.MonsterAddName(b, enemyName)
+		enemy := example.MonsterEnd(b)
+		children := b.CreateVectorOfTables([]flatbuffers.UOffsetT{enemy})
+		name := b.CreateString("Orc")
+		example.MonsterStartInventoryVector(b, 2)
+		b.PrependByte(1)
+		b.PrependByte(0)
+		inventory := b.EndVector(2)
+		example.MonsterStart(b)
+		example.MonsterAddName(b, name)
+		example.MonsterAddPos(b, example.CreateVec3(b, 1, 2, 3, 4, example.ColorRed, 5, 6))
+		example.MonsterAddInventory(b, inventory)
+		example.MonsterAddTestarrayoftables(b, children)
+		example.MonsterAddTestType(b, example.AnyMonster)
+		example.MonsterAddTest(b, enemy)
+		example.FinishMonsterBuffer(b, example.MonsterEnd(b))
+		return b.FinishedBytes()
+	}()
+
+	b := flatbuffers.NewBuilder(0)
+	enemyName := flatbuffers.CreateString(b, "Pig")
+	typed.MonsterStart(b)
+	typed.MonsterAddName(b, enemyName)
+	enemy := typed.MonsterEnd(b)
+	children := flatbuffers.CreateVectorOfTables(b, []flatbuffers.Offset[typed.Monster]{enemy})
+	name := flatbuffers.CreateString(b, "Orc")
+	typed.MonsterStartInventoryVector(b, 2)
+	b.PrependByte(1)
+	b.PrependByte(0)
+	inventory := flatbuffers.EndVector[byte](b, 2)
+	typed.MonsterStart(b)
+	typed.MonsterAddName(b, name)
+	typed.MonsterAddPos(b, typed.CreateVec3(b, 1, 2, 3, 4, typed.ColorRed, 5, 6))
+	typed.MonsterAddInventory(b, inventory)
+	typed.MonsterAddTestarrayoftables(b, children)
+	typed.MonsterAddTestType(b, typed.AnyMonster)
+	typed.MonsterAddTest(b, flatbuffers.Offset[typed.Any](enemy))
+	typed.FinishMonsterBuffer(b, typed.MonsterEnd(b))
+	if !bytes.Equal(b.FinishedBytes(), want) {
+		fail("typed builder functions wrote a different monster")
+	}
+}
+
+// CheckUnPackReuse checks that UnPackTo reuses the objects and vectors of the
+// object it unpacks into, and unpacks the same values as UnPack.
+func CheckUnPackReuse(monster []byte, fail func(string, ...interface{})) {
+	pack := func(m *example.MonsterT) []byte {
+		b := flatbuffers.NewBuilder(0)
+		b.Finish(m.Pack(b))
+		return b.FinishedBytes()
+	}
+	want := pack(example.GetRootAsMonster(monster, 0).UnPack())
+
+	orc := func() []byte {
+		b := flatbuffers.NewBuilder(0)
+		name := b.CreateString("Orc")
+		example.MonsterStart(b)
+		example.MonsterAddName(b, name)
+		b.Finish(example.MonsterEnd(b))
+		return b.FinishedBytes()
+	}()
+
+	var interner flatbuffers.Interner
+	m := &example.MonsterT{}
+	example.GetRootAsMonster(monster, 0).UnPackToInterned(m, &interner)
+	pos, test4, names := m.Pos, m.Test4, m.Testarrayofstring
+	interned := interner.Len()
+	example.GetRootAsMonster(monster, 0).UnPackToInterned(m, &interner)
+	if m.Pos != pos || m.Test4[0] != test4[0] || &m.Testarrayofstring[0] != &names[0] {
+		fail("UnPackTo did not reuse the objects and vectors it was given")
+	}
+	if interner.Len() != interned {
+		fail("Interner holds %d strings, want %d", interner.Len(), interned)
+	}
+
+	example.GetRootAsMonster(orc, 0).UnPackToInterned(m, &interner)
+	if m.Name != "Orc" || m.Pos != nil || m.Test != nil || m.Test4 != nil {
+		fail("UnPackTo kept the fields of the previous monster: %+v", m)
+	}
+	// The vectors the orc lacks stay absent, as they do after UnPack.
+	wantOrc := pack(example.GetRootAsMonster(orc, 0).UnPack())
+	if !bytes.Equal(pack(m), wantOrc) {
+		fail("UnPackTo into a used MonsterT packed a different orc")
+	}
+	reset := &example.MonsterT{}
+	example.GetRootAsMonster(monster, 0).UnPackTo(reset)
+	reset.Reset()
+	example.GetRootAsMonster(orc, 0).UnPackTo(reset)
+	if !bytes.Equal(pack(reset), wantOrc) {
+		fail("UnPackTo into a Reset MonsterT packed a different orc")
+	}
+	example.GetRootAsMonster(monster, 0).UnPackToInterned(m, &interner)
+	if !bytes.Equal(pack(m), want) {
+		fail("UnPackTo into a used MonsterT unpacked a different monster")
+	}
+
+	table := example.GetRootAsMonster(monster, 0)
+	allocs := testing.AllocsPerRun(100, func() {
+		table.UnPackToInterned(m, &interner)
+	})
+	if allocs > 0 {
+		fail("UnPackTo into a used MonsterT allocated %v times, want 0", allocs)
+	}
+
+	m.Reset()
+	if m.Name != "" || m.Pos != nil || len(m.Testarrayofstring) != 0 || cap(m.Testarrayofstring) == 0 {
+		fail("Reset did not keep only the vectors: %+v", m)
+	}
+	if interner.Len() == 0 {
+		fail("Interner holds no strings")
+	}
+	interner.Reset()
+	if interner.Len() != 0 {
+		fail("Interner holds %d strings after Reset", interner.Len())
+	}
+}
+
+// CheckFieldMask checks that UnPackWithMask and ProjectTo keep only the fields
+// selected by a mask, and the fields of nested tables selected by their masks.
+func CheckFieldMask(monster []byte, fail func(string, ...interface{})) {
+	// Compare packed monsters, as the NaN defaults of MonsterT are not equal.
+	same := func(a, b *example.MonsterT) bool {
+		pack := func(m *example.MonsterT) []byte {
+			b := flatbuffers.NewBuilder(0)
+			b.Finish(m.Pack(b))
+			return b.FinishedBytes()
+		}
+		return bytes.Equal(pack(a), pack(b))
+	}
+	full := example.GetRootAsMonster(monster, 0).UnPack()
+	mask := &example.MonsterMask{Pos: true, Hp: true, Name: true, Test: true, Testarrayofstring: true}
+	want := &example.MonsterT{
+		Pos:               full.Pos,
+		Hp:                full.Hp,
+		Name:              full.Name,
+		Test:              full.Test,
+		Testarrayofstring: full.Testarrayofstring,
+	}
+	if got := example.GetRootAsMonster(monster, 0).UnPackWithMask(mask); !same(got, want) {
+		fail(FailString("UnPackWithMask", want, got))
+	}
+	if got := example.GetRootAsMonster(monster, 0).UnPackWithMask(&example.MonsterMask{All: true}); !same(got, full) {
+		fail(FailString("UnPackWithMask of All", full, got))
+	}
+
+	b := flatbuffers.NewBuilder(0)
+	b.Finish(example.GetRootAsMonster(monster, 0).ProjectTo(b, mask))
+	projected := example.GetRootAsMonster(b.FinishedBytes(), 0)
+	if got := projected.UnPackWithMask(mask); !same(got, want) {
+		fail(FailString("ProjectTo", want, got))
+	}
+	if projected.Mana() != 150 || projected.InventoryLength() != 0 || projected.Test4Length() != 0 {
+		fail("ProjectTo copied fields that were not selected")
+	}
+	if len(b.FinishedBytes()) >= len(monster) {
+		fail("projected %d bytes of a %d byte monster", len(b.FinishedBytes()), len(monster))
+	}
+
+	b.Reset()
+	if b.Finish(example.GetRootAsMonster(monster, 0).ProjectTo(b, &example.MonsterMask{Hp: true})); !errors.Is(b.Err(), flatbuffers.ErrRequiredField) {
+		fail("got %v, want the missing name", b.Err())
+	}
+
+	// A nil mask selects no fields, like an empty one.
+	if got := example.GetRootAsMonster(monster, 0).UnPackWithMask(nil); !same(got, &example.MonsterT{}) {
+		fail(FailString("UnPackWithMask of nil", &example.MonsterT{}, got))
+	}
+	b.Reset()
+	if b.Finish(example.GetRootAsMonster(monster, 0).ProjectTo(b, nil)); !errors.Is(b.Err(), flatbuffers.ErrRequiredField) {
+		fail("got %v from a nil mask, want the missing name", b.Err())
+	}
+
+	nested := func() []byte {
+		b := flatbuffers.NewBuilder(0)
+		monster := func(name string, hp int16) flatbuffers.UOffsetT {
+			n := b.CreateString(name)
+			example.MonsterStart(b)
+			example.MonsterAddName(b, n)
+			example.MonsterAddHp(b, hp)
+			return example.MonsterEnd(b)
+		}
+		enemy := monster("Orc", 300)
+		troll := monster("Troll", 400)
+		tables := b.CreateVectorOfTables([]flatbuffers.UOffsetT{troll})
+		name := b.CreateString("Hero")
+		example.MonsterStart(b)
+		example.MonsterAddName(b, name)
+		example.MonsterAddEnemy(b, enemy)
+		example.MonsterAddTestarrayoftables(b, tables)
+		b.Finish(example.MonsterEnd(b))
+		return b.FinishedBytes()
+	}()
+	nestedMask := &example.MonsterMask{
+		Name:              true,
+		Enemy:             &example.MonsterMask{Name: true},
+		Testarrayoftables: &example.MonsterMask{All: true},
+	}
+	wantNested := &example.MonsterT{
+		Name:              "Hero",
+		Enemy:             &example.MonsterT{Name: "Orc"},
+		Testarrayoftables: []*example.MonsterT{example.GetRootAsMonster(nested, 0).UnPack().Testarrayoftables[0]},
+	}
+	if got := example.GetRootAsMonster(nested, 0).UnPackWithMask(nestedMask); !same(got, wantNested) {
+		fail(FailString("UnPackWithMask of nested tables", wantNested, got))
+	}
+	b.Reset()
+	b.Finish(example.GetRootAsMonster(nested, 0).ProjectTo(b, nestedMask))
+	projected = example.GetRootAsMonster(b.FinishedBytes(), 0)
+	if enemy := projected.Enemy(nil); string(enemy.Name()) != "Orc" || enemy.Hp() != 100 {
+		fail("ProjectTo copied the enemy %s with %d hp", enemy.Name(), enemy.Hp())
+	}
+	if troll := new(example.Monster); !projected.Testarrayoftables(troll, 0) || troll.Hp() != 400 {
+		fail("ProjectTo did not copy all the fields of the Troll")
+	}
+}
+
+// randomMonster is a MonsterT that testing/quick generates with
+// RandomMonsterT.
+type randomMonster struct {
+	*example.MonsterT
+}
+
+func (randomMonster) Generate(r *rand.Rand, size int) reflect.Value {
+	// Bound vectors, as each nested monster holds vectors of monsters.
+	return reflect.ValueOf(randomMonster{example.RandomMonsterT(flatbuffers.NewRandom(r, size/8))})
+}
+
+// checkMonsterRoundTrip packs m, and checks that unpacking and packing it
+// again writes the same values.
+func checkMonsterRoundTrip(bfbs []byte, m *example.MonsterT) error {
+	b := flatbuffers.NewBuilder(0)
+	b.Finish(m.Pack(b))
+	if err := b.Err(); err != nil {
+		return err
+	}
+	packed := b.FinishedBytes()
+	repacked := flatbuffers.NewBuilder(0)
+	repacked.Finish(example.GetRootAsMonster(packed, 0).UnPack().Pack(repacked))
+	diffs, err := flatdiff.Diff(bfbs, packed, repacked.FinishedBytes(), flatdiff.Options{})
+	if err != nil {
+		return err
+	}
+	if len(diffs) != 0 {
+		return fmt.Errorf("round trip changed %v", diffs)
+	}
+	return nil
+}
+
+// CheckRandomObjects checks that the generated Random functions make objects
+// within the bounds of their Random that pack, with their required fields, and
+// unpack to the same values.
+func CheckRandomObjects(testDir string, fail func(string, ...interface{})) {
+	bfbs, err := os.ReadFile(filepath.Join(testDir, "monster_test.bfbs"))
+	if err != nil {
+		fail("%v", err)
+	}
+
+	// depth returns the depth of the nested monsters of m, and the length of
+	// its longest vector of monsters.
+	var depth func(m *example.MonsterT) (int, int)
+	depth = func(m *example.MonsterT) (int, int) {
+		if m == nil {
+			return 0, 0
+		}
+		children := append([]*example.MonsterT{m.Enemy, m.Testnestedflatbuffer}, m.Testarrayoftables...)
+		if m.Test != nil && m.Test.Type == example.AnyMonster {
+			children = append(children, m.Test.Value.(*example.MonsterT))
+		}
+		maxDepth, maxLength := 0, len(m.Testarrayoftables)
+		for _, child := range children {
+			d, l := depth(child)
+			if d > maxDepth {
+				maxDepth = d
+			}
+			if l > maxLength {
+				maxLength = l
+			}
+		}
+		return maxDepth + 1, maxLength
+	}
+
+	r := flatbuffers.NewRandom(rand.New(rand.NewSource(1)), 5)
+	r.MaxDepth = 2
+	for i := 0; i < 100; i++ {
+		if d, l := depth(example.RandomMonsterT(r)); d > 2 || l > 5 {
+			fail("RandomMonsterT made monsters %d deep with %d monster vectors", d, l)
+		}
+	}
+
+	err = quick.Check(func(m randomMonster) bool {
+		if err := checkMonsterRoundTrip(bfbs, m.MonsterT); err != nil {
+			fail("%v", err)
+		}
+		return true
+	}, &quick.Config{MaxCount: 100})
+	if err != nil {
+		fail("%v", err)
+	}
+}
+
+// FuzzMonsterRoundTrip checks that random monsters, made from the fuzzed seed
+// and vector length, unpack and pack again to the same values.
+func FuzzMonsterRoundTrip(f *testing.F) {
+	bfbs, err := os.ReadFile(filepath.Join(filepath.Dir(cppData), "monster_test.bfbs"))
+	if err != nil {
+		f.Fatal(err)
+	}
+	for seed := int64(0); seed < 16; seed++ {
+		f.Add(seed, uint8(seed))
+	}
+	f.Fuzz(func(t *testing.T, seed int64, length uint8) {
+		r := flatbuffers.NewRandom(rand.New(rand.NewSource(seed)), int(length%16))
+		if err := checkMonsterRoundTrip(bfbs, example.RandomMonsterT(r)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// FuzzScalarStuffRoundTrip checks that random ScalarStuff, made from the
+// fuzzed seed, unpacks to the values it was packed from, including its absent
+// optional scalars.
+func FuzzScalarStuffRoundTrip(f *testing.F) {
+	for seed := int64(0); seed < 16; seed++ {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		want := optional_scalars.RandomScalarStuffT(flatbuffers.NewRandom(rand.New(rand.NewSource(seed)), 0))
+		b := flatbuffers.NewBuilder(0)
+		b.Finish(want.Pack(b))
+		got := optional_scalars.GetRootAsScalarStuff(b.FinishedBytes(), 0).UnPack()
+		if !reflect.DeepEqual(got, want) {
+			t.Fatal(FailString("ScalarStuff round trip", want, got))
+		}
+	})
+}
+
+// CheckNestedFlatBuffer verifies that a nested_flatbuffer field can be built
+// from a child Builder and read back as its typed root.
+func CheckNestedFlatBuffer(fail func(string, ...interface{})) {
+	nested := flatbuffers.NewBuilder(0)
+	nestedName := nested.CreateString("Nested")
+	example.MonsterStart(nested)
+	example.MonsterAddPos(nested, example.CreateVec3(nested, 1.0, 2.0, 3.0, 3.0, example.ColorGreen, 5, 6))
+	example.MonsterAddName(nested, nestedName)
+	example.MonsterAddHp(nested, 42)
+	example.FinishMonsterBuffer(nested, example.MonsterEnd(nested))
+
+	b := flatbuffers.NewBuilder(0)
+	// Misalign the parent buffer so the nested vector needs padding.
+	b.CreateString("x")
+	nestedOffset := example.MonsterMakeTestnestedflatbufferVector(b, nested)
+	name := b.CreateString("Parent")
+	example.MonsterStart(b)
+	example.MonsterAddName(b, name)
+	example.MonsterAddTestnestedflatbuffer(b, nestedOffset)
+	example.FinishMonsterBuffer(b, example.MonsterEnd(b))
+
+	buf := b.FinishedBytes()
+	monster := example.GetRootAsMonster(buf, 0)
+	if got := monster.TestnestedflatbufferBytes(); !bytes.Equal(got, nested.FinishedBytes()) {
+		fail(FailString("nested bytes", nested.FinishedBytes(), got))
+	}
+	tab := monster.Table()
+	o := flatbuffers.UOffsetT(tab.Offset(30))
+	if start := tab.Vector(o); start%8 != 0 {
+		fail(FailString("nested buffer alignment", 0, start%8))
+	}
+
+	root := monster.TestnestedflatbufferNestedRoot()
+	if root == nil {
+		fail("expected non-nil TestnestedflatbufferNestedRoot")
+	}
+	if got := string(root.Name()); got != "Nested" {
+		fail(FailString("nested name", "Nested", got))
+	}
+	if got := root.Hp(); got != 42 {
+		fail(FailString("nested hp", 42, got))
+	}
+	if got := root.Pos(nil).Test1(); got != 3.0 {
+		fail(FailString("nested pos.test1", 3.0, got))
+	}
+	if monster.TestrequirednestedflatbufferNestedRoot() != nil {
+		fail("expected nil TestrequirednestedflatbufferNestedRoot")
+	}
+
+	// The object API carries the nested root as a *MonsterT.
+	unpacked := monster.UnPack()
+	if unpacked.Testnestedflatbuffer == nil || unpacked.Testnestedflatbuffer.Name != "Nested" {
+		fail(FailString("unpacked nested monster", "Nested", unpacked.Testnestedflatbuffer))
+	}
+	unpacked.NanDefault = 0
+	unpacked.Testnestedflatbuffer.NanDefault = 0
+
+	b2 := flatbuffers.NewBuilder(0)
+	b2.Finish(unpacked.Pack(b2))
+	repacked := example.GetRootAsMonster(b2.FinishedBytes(), 0).UnPack()
+	repacked.NanDefault = 0
+	repacked.Testnestedflatbuffer.NanDefault = 0
+	if !reflect.DeepEqual(unpacked, repacked) {
+		fail(FailString("nested Pack/UnPack()", unpacked, repacked))
+	}
+}
+
+// CheckHashedFields verifies that the generated hash helpers write the same
+// values that flatc computed for monsterdata_test.json.
+func CheckHashedFields(cppData []byte, fail func(string, ...interface{})) {
+	const input = "This string is being hashed!"
+	want := example.GetRootAsMonster(cppData, 0)
+
+	b := flatbuffers.NewBuilder(0)
+	name := b.CreateString("MyMonster")
+	example.MonsterStart(b)
+	example.MonsterAddName(b, name)
+	example.MonsterAddTesthashs32Fnv1FromString(b, input)
+	example.MonsterAddTesthashu32Fnv1FromString(b, input)
+	example.MonsterAddTesthashs64Fnv1FromString(b, input)
+	example.MonsterAddTesthashu64Fnv1FromString(b, input)
+	example.MonsterAddTesthashs32Fnv1aFromString(b, input)
+	example.MonsterAddTesthashu32Fnv1aFromString(b, input)
+	example.MonsterAddTesthashs64Fnv1aFromString(b, input)
+	example.MonsterAddTesthashu64Fnv1aFromString(b, input)
+	b.Finish(example.MonsterEnd(b))
+	got := example.GetRootAsMonster(b.FinishedBytes(), 0)
+
+	t := &example.MonsterT{}
+	t.SetTesthashs32Fnv1FromString(input)
+	t.SetTesthashu32Fnv1FromString(input)
+	t.SetTesthashs64Fnv1FromString(input)
+	t.SetTesthashu64Fnv1FromString(input)
+	t.SetTesthashs32Fnv1aFromString(input)
+	t.SetTesthashu32Fnv1aFromString(input)
+	t.SetTesthashs64Fnv1aFromString(input)
+	t.SetTesthashu64Fnv1aFromString(input)
+
+	check := func(what string, want, got, obj interface{}) {
+		if want != got {
+			fail(FailString(what, want, got))
+		}
+		if want != obj {
+			fail(FailString(what+" (object API)", want, obj))
+		}
+	}
+	check("testhashs32_fnv1", want.Testhashs32Fnv1(), got.Testhashs32Fnv1(), t.Testhashs32Fnv1)
+	check("testhashu32_fnv1", want.Testhashu32Fnv1(), got.Testhashu32Fnv1(), t.Testhashu32Fnv1)
+	check("testhashs64_fnv1", want.Testhashs64Fnv1(), got.Testhashs64Fnv1(), t.Testhashs64Fnv1)
+	check("testhashu64_fnv1", want.Testhashu64Fnv1(), got.Testhashu64Fnv1(), t.Testhashu64Fnv1)
+	check("testhashs32_fnv1a", want.Testhashs32Fnv1a(), got.Testhashs32Fnv1a(), t.Testhashs32Fnv1a)
+	check("testhashu32_fnv1a", want.Testhashu32Fnv1a(), got.Testhashu32Fnv1a(), t.Testhashu32Fnv1a)
+	check("testhashs64_fnv1a", want.Testhashs64Fnv1a(), got.Testhashs64Fnv1a(), t.Testhashs64Fnv1a)
+	check("testhashu64_fnv1a", want.Testhashu64Fnv1a(), got.Testhashu64Fnv1a(), t.Testhashu64Fnv1a)
+
+	t.SetVectorOfWeakReferencesFromStrings([]string{"a", "b"})
+	if got := t.VectorOfWeakReferences; len(got) != 2 || got[1] != flathash.Fnv1aHash64("b") {
+		fail(FailString("vector_of_weak_references", flathash.Fnv1aHash64("b"), got))
+	}
+
+	// 16 bit hashes fold the 32 bit hash, and hashing stops at NUL like the
+	// C string hashed by flatc.
+	if got, want := flathash.Fnv1Hash16(input), uint16(flathash.Fnv1Hash32(input)>>16^flathash.Fnv1Hash32(input)&0xffff); got != want {
+		fail(FailString("fnv1_16", want, got))
+	}
+	if got, want := flathash.Fnv1aHash64("abc\x00def"), flathash.Fnv1aHash64("abc"); got != want {
+		fail(FailString("fnv1a_64 with NUL", want, got))
+	}
+	if flathash.FindHashFunction32("fnv1a_32") == nil || flathash.FindHashFunction32("fnv1a_64") != nil {
+		fail("FindHashFunction32 returned an unexpected result")
+	}
+}
+
+// BenchmarkVtableDeduplication measures the speed of vtable deduplication
+// by creating prePop vtables, then populating b.N objects with a
+// different single vtable.
+//
+// When b.N is large (as in long benchmarks), memory usage may be high.
+func BenchmarkVtableDeduplication(b *testing.B) {
+	prePop := 10
+	builder := flatbuffers.NewBuilder(0)
+
+	// pre-populate some vtables:
+	for i := 0; i < prePop; i++ {
+		builder.StartObject(i)
+		for j := 0; j < i; j++ {
+			builder.PrependInt16Slot(j, int16(j), 0)
+		}
+		builder.EndObject()
+	}
+
+	// benchmark deduplication of a new vtable:
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lim := prePop
+
+		builder.StartObject(lim)
+		for j := 0; j < lim; j++ {
+			builder.PrependInt16Slot(j, int16(j), 0)
+		}
+		builder.EndObject()
+	}
+}
+
+// BenchmarkParseGold measures the speed of parsing the 'gold' data
+// used throughout this test suite.
+func BenchmarkParseGold(b *testing.B) {
+	buf, offset := CheckGeneratedBuild(false, false, b.Fatalf)
+	monster := example.GetRootAsMonster(buf, offset)
+
+	// use these to prevent allocations:
+	reuse_pos := example.Vec3{}
+	reuse_test3 := example.Test{}
+	reuse_table2 := flatbuffers.Table{}
+	reuse_monster2 := example.Monster{}
+	reuse_test4_0 := example.Test{}
+	reuse_test4_1 := example.Test{}
+
+	b.SetBytes(int64(len(buf[offset:])))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		monster.Hp()
+		monster.Mana()
+		name := monster.Name()
+		_ = name[0]
+		_ = name[len(name)-1]
+
+		monster.Pos(&reuse_pos)
+		reuse_pos.X()
+		reuse_pos.Y()
+		reuse_pos.Z()
+		reuse_pos.Test1()
+		reuse_pos.Test2()
+		reuse_pos.Test3(&reuse_test3)
+		reuse_test3.A()
+		reuse_test3.B()
+		monster.TestType()
+		monster.Test(&reuse_table2)
+		reuse_monster2.Init(reuse_table2.Bytes, reuse_table2.Pos)
+		name2 := reuse_monster2.Name()
+		_ = name2[0]
+		_ = name2[len(name2)-1]
+		monster.InventoryLength()
+		l := monster.InventoryLength()
+		for i := 0; i < l; i++ {
+			monster.Inventory(i)
+		}
+		monster.Test4Length()
+		monster.Test4(&reuse_test4_0, 0)
+		monster.Test4(&reuse_test4_1, 1)
+
+		reuse_test4_0.A()
+		reuse_test4_0.B()
+		reuse_test4_1.A()
+		reuse_test4_1.B()
+
+		monster.TestarrayofstringLength()
+		str0 := monster.Testarrayofstring(0)
+		_ = str0[0]
 		_ = str0[len(str0)-1]
 		str1 := monster.Testarrayofstring(1)
 		_ = str1[0]
@@ -2573,3 +3915,618 @@ func BenchmarkBuildGold(b *testing.B) {
 		bldr.Finish(mon)
 	}
 }
+
+// CheckAnnotatedBinary verifies that the Go annotator reproduces the .afb
+// files written by `flatc --annotate`, for valid and corrupted buffers.
+func CheckAnnotatedBinary(testDir string, fail func(string, ...interface{})) {
+	check := func(dir, bfbsFile, schemaFile, binaryFile, afbFile string) {
+		bfbs, err := os.ReadFile(filepath.Join(dir, bfbsFile))
+		if err != nil {
+			fail("%v", err)
+		}
+		buf, err := os.ReadFile(filepath.Join(dir, binaryFile))
+		if err != nil {
+			fail("%v", err)
+		}
+		want, err := os.ReadFile(filepath.Join(dir, afbFile))
+		if err != nil {
+			fail("%v", err)
+		}
+
+		sections, err := annotator.Annotate(bfbs, buf, false)
+		if err != nil {
+			fail("annotating %s: %v", binaryFile, err)
+		}
+		var got bytes.Buffer
+		opts := annotator.Options{SchemaFile: schemaFile, BinaryFile: binaryFile}
+		if err := annotator.WriteText(&got, sections, buf, opts); err != nil {
+			fail("%v", err)
+		}
+		if !bytes.Equal(got.Bytes(), want) {
+			fail("annotation of %s differs from %s:\n%s", binaryFile, afbFile, got.String())
+		}
+	}
+
+	check(testDir, "monster_test.bfbs", "monster_test.fbs",
+		"monsterdata_test.mon", "monsterdata_test.afb")
+
+	annotatedDir := filepath.Join(testDir, "annotated_binary")
+	check(annotatedDir, "annotated_binary.bfbs", "annotated_binary.fbs",
+		"annotated_binary.bin", "annotated_binary.afb")
+	invalid, err := filepath.Glob(filepath.Join(annotatedDir, "tests", "*.bin"))
+	if err != nil || len(invalid) == 0 {
+		fail("no corrupted binaries found: %v", err)
+	}
+	for _, path := range invalid {
+		name := filepath.Base(path)
+		check(annotatedDir, "annotated_binary.bfbs", "annotated_binary.fbs",
+			"tests/"+name, "tests/"+name[:len(name)-len(".bin")]+".afb")
+	}
+
+	// A cut short buffer is reported in the regions, not as an error.
+	bfbs, _ := os.ReadFile(filepath.Join(testDir, "monster_test.bfbs"))
+	buf, _ := os.ReadFile(filepath.Join(testDir, "monsterdata_test.mon"))
+	sections, err := annotator.Annotate(bfbs, buf[:len(buf)/2], false)
+	if err != nil {
+		fail("annotating a cut short buffer: %v", err)
+	}
+	hasError := false
+	for _, section := range sections {
+		for _, region := range section.Regions {
+			hasError = hasError || region.Comment.Status.IsError()
+		}
+	}
+	if !hasError {
+		fail("expected a cut short buffer to have invalid regions")
+	}
+
+	if _, err := annotator.Annotate(buf, buf, false); err != annotator.ErrInvalidSchema {
+		fail("expected ErrInvalidSchema, got %v", err)
+	}
+}
+
+// recordingTB captures the errors reported to a testing.TB.
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+// CheckStructuralDiff verifies that flatdiff compares buffers by value,
+// ignoring their layout, and reports the path of each difference.
+func CheckStructuralDiff(testDir string, cppData []byte, t testing.TB, fail func(string, ...interface{})) {
+	bfbs, err := os.ReadFile(filepath.Join(testDir, "monster_test.bfbs"))
+	if err != nil {
+		fail("%v", err)
+	}
+
+	pack := func(m *example.MonsterT) []byte {
+		b := flatbuffers.NewBuilder(0)
+		example.FinishMonsterBuffer(b, m.Pack(b))
+		return b.FinishedBytes()
+	}
+
+	// Repacking with the object API changes the layout, but not the values.
+	repacked := pack(example.GetRootAsMonster(cppData, 0).UnPack())
+	if bytes.Equal(repacked, cppData) {
+		fail("expected the repacked buffer to have a different layout")
+	}
+	diffs, err := flatdiff.Diff(bfbs, cppData, repacked, flatdiff.Options{})
+	if err != nil {
+		fail("%v", err)
+	}
+	if len(diffs) != 0 {
+		fail("expected no differences, got %v", diffs)
+	}
+
+	want := example.GetRootAsMonster(cppData, 0).UnPack()
+	want.Testarrayoftables = []*example.MonsterT{
+		{Name: "Barney", Hp: 100},
+		{Name: "Fred", Hp: 100},
+	}
+	got := example.GetRootAsMonster(cppData, 0).UnPack()
+	got.Testarrayoftables = []*example.MonsterT{
+		{Name: "Barney", Hp: 100},
+		{Name: "Fred", Hp: 150},
+		{Name: "Wilma"},
+	}
+	got.Name = "MyOtherMonster"
+	got.Pos.Test3.B = 7
+	got.Color = example.ColorRed | example.ColorGreen
+	got.Enemy = nil
+
+	expected := []string{
+		"pos.test3.b: 6 → 7",
+		`name: "MyMonster" → "MyOtherMonster"`,
+		"color: Blue (8) → Red|Green (3)",
+		"len(testarrayoftables): 2 → 3",
+		"testarrayoftables[1].hp: 100 → 150",
+		"enemy: {...} → null",
+	}
+	diffs, err = flatdiff.Diff(bfbs, pack(want), pack(got), flatdiff.Options{})
+	if err != nil {
+		fail("%v", err)
+	}
+	if len(diffs) != len(expected) {
+		fail("expected %d differences, got %v", len(expected), diffs)
+	}
+	for i, d := range diffs {
+		if d.String() != expected[i] {
+			fail("difference %d: expected %q, got %q", i, expected[i], d.String())
+		}
+	}
+
+	rec := &recordingTB{TB: t}
+	if !flatdiff.AssertEqual(rec, bfbs, cppData, repacked, flatdiff.Options{}) {
+		fail("unexpected test errors: %v", rec.errors)
+	}
+	if flatdiff.AssertEqual(rec, bfbs, pack(want), pack(got), flatdiff.Options{}) || len(rec.errors) != 1 {
+		fail("expected AssertEqual to report one error, got %v", rec.errors)
+	}
+
+	if _, err := flatdiff.Diff(bfbs, cppData, cppData[:8], flatdiff.Options{}); err == nil {
+		fail("expected an error for a malformed buffer")
+	}
+}
+
+// CheckGoGenerator verifies that gogen generates, from monster_test.bfbs, the
+// same code as `flatc --go --gen-object-api` in tests/MyGame, and from
+// goldens/go/basic.bfbs the same code as `flatc --go` in goldens/go.
+func CheckGoGenerator(testDir string, fail func(string, ...interface{})) {
+	bfbs, err := os.ReadFile(filepath.Join(testDir, "monster_test.bfbs"))
+	if err != nil {
+		fail("%v", err)
+	}
+	files, err := gogen.Generate(bfbs, gogen.Options{ObjectAPI: true})
+	if err != nil {
+		fail("generating from monster_test.bfbs: %v", err)
+	}
+	if len(files) != 18 {
+		fail("expected 18 files, got %d", len(files))
+	}
+	for _, f := range files {
+		want, err := os.ReadFile(filepath.Join(testDir, filepath.FromSlash(f.Path)))
+		if err != nil {
+			fail("%v", err)
+		}
+		if !bytes.Equal(f.Content, want) {
+			fail("generated %s differs from flatc's", f.Path)
+		}
+	}
+
+	goldens := filepath.Join(testDir, "..", "goldens", "go")
+	bfbs, err = os.ReadFile(filepath.Join(goldens, "basic.bfbs"))
+	if err != nil {
+		fail("%v", err)
+	}
+	files, err = gogen.Generate(bfbs, gogen.Options{})
+	if err != nil {
+		fail("generating from basic.bfbs: %v", err)
+	}
+	if len(files) != 2 {
+		fail("expected 2 files, got %d", len(files))
+	}
+	for _, f := range files {
+		want, err := os.ReadFile(filepath.Join(goldens, filepath.FromSlash(f.Path)))
+		if err != nil {
+			fail("%v", err)
+		}
+		if !bytes.Equal(f.Content, want) {
+			fail("generated %s differs from goldens/go", f.Path)
+		}
+	}
+
+	// flatc rejects unions of structs and strings for Go, and so does gogen.
+	bfbs, err = idl.Compile(filepath.Join(testDir, "union_vector", "union_vector.fbs"), idl.Options{})
+	if err != nil {
+		fail("parsing union_vector.fbs: %v", err)
+	}
+	if _, err := gogen.Generate(bfbs, gogen.Options{ObjectAPI: true}); err == nil ||
+		!strings.Contains(err.Error(), "unions of structs and strings are not supported") {
+		fail("got %v, want an error for the members of Character", err)
+	}
+
+	// Unlike flatc, gogen writes no partial UnionInNestedNS.go for the union
+	// that namespace_test2.fbs uses from namespace_test1.fbs.
+	dir := filepath.Join(testDir, "namespace_test")
+	bfbs, err = idl.Compile(filepath.Join(dir, "namespace_test2.fbs"), idl.Options{ProjectRoot: dir})
+	if err != nil {
+		fail("parsing namespace_test2.fbs: %v", err)
+	}
+	files, err = gogen.Generate(bfbs, gogen.Options{ObjectAPI: true})
+	if err != nil {
+		fail("generating from namespace_test2.fbs: %v", err)
+	}
+	var paths []string
+	for _, f := range files {
+		paths = append(paths, f.Path)
+	}
+	sort.Strings(paths)
+	if want := []string{"NamespaceA/SecondTableInA.go", "NamespaceA/TableInFirstNS.go", "NamespaceC/TableInC.go"}; !reflect.DeepEqual(paths, want) {
+		fail("generated %v from namespace_test2.fbs, want %v", paths, want)
+	}
+
+	if _, err := gogen.Generate([]byte("not a schema"), gogen.Options{}); err != gogen.ErrInvalidSchema {
+		fail("expected ErrInvalidSchema, got %v", err)
+	}
+}
+
+// CheckSchemaParser verifies that idl parses monster_test.fbs and
+// arrays_test.fbs into the schemas flatc wrote to their .bfbs files, and
+// reports errors at their position.
+func CheckSchemaParser(testDir string, fail func(string, ...interface{})) {
+	// Repacking the unpacked schemas leaves out the differences between
+	// builders, such as the order of strings in the buffer.
+	canonical := func(bfbs []byte) []byte {
+		b := flatbuffers.NewBuilder(0)
+		b.Finish(reflection.GetRootAsSchema(bfbs, 0).UnPack().Pack(b))
+		return b.FinishedBytes()
+	}
+	opts := idl.Options{
+		IncludePaths: []string{filepath.Join(testDir, "include_test")},
+		ProjectRoot:  testDir,
+		Comments:     true,
+		Builtins:     true,
+	}
+	for _, name := range []string{"monster_test", "arrays_test"} {
+		got, err := idl.Compile(filepath.Join(testDir, name+".fbs"), opts)
+		if err != nil {
+			fail("parsing %s.fbs: %v", name, err)
+		}
+		want, err := os.ReadFile(filepath.Join(testDir, name+".bfbs"))
+		if err != nil {
+			fail("%v", err)
+		}
+		if !bytes.Equal(canonical(got), canonical(want)) {
+			fail("%s.fbs parsed to a different schema than %s.bfbs", name, name)
+		}
+	}
+
+	// The parsed schema generates the same code as flatc's.
+	bfbs, err := idl.Compile(filepath.Join(testDir, "monster_test.fbs"), opts)
+	if err != nil {
+		fail("%v", err)
+	}
+	// Tables without fields still have the required, empty, fields vector.
+	schema := reflection.GetRootAsSchema(bfbs, 0)
+	for i := 0; i < schema.ObjectsLength(); i++ {
+		var o reflection.Object
+		schema.Objects(&o, i)
+		if tab := o.Table(); tab.Offset(6) == 0 {
+			fail("parsed object %s has no fields", o.Name())
+		}
+	}
+	files, err := gogen.Generate(bfbs, gogen.Options{ObjectAPI: true})
+	if err != nil {
+		fail("generating from monster_test.fbs: %v", err)
+	}
+	for _, f := range files {
+		want, err := os.ReadFile(filepath.Join(testDir, filepath.FromSlash(f.Path)))
+		if err != nil {
+			fail("%v", err)
+		}
+		if !bytes.Equal(f.Content, want) {
+			fail("generated %s differs from flatc's", f.Path)
+		}
+	}
+
+	dir, err := os.MkdirTemp("", "idl")
+	if err != nil {
+		fail("%v", err)
+	}
+	defer os.RemoveAll(dir)
+	for _, c := range []struct{ schema, err string }{
+		{"table T { a: U; }", "2:14: type referenced but not defined (check namespace): U"},
+		{"table T { a: int (priority: 1); }", "2:19: user define attributes must be declared before use: priority"},
+		{"enum E : byte { A = 128 }", "2:21: enum value does not fit, \"128\" out of [-128; 127]"},
+		{"table T { a: ubyte = 256; }", "2:22: invalid number: \"256\", constant does not fit [0; 255]"},
+		{"table T { a: int (id: 1); }", "2:27: field id's must be consecutive from 0, id 0 missing or set twice, field: a, id: 1"},
+		{"include \"missing.fbs\";", "2:9: unable to locate include file: missing.fbs"},
+	} {
+		path := filepath.Join(dir, "schema.fbs")
+		if err := os.WriteFile(path, []byte("// A broken schema.\n"+c.schema+"\n"), 0o644); err != nil {
+			fail("%v", err)
+		}
+		_, err := idl.Parse(path, idl.Options{})
+		var e *idl.Error
+		if !errors.As(err, &e) || fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Msg) != c.err {
+			fail("parsing %q: expected error %q, got %v", c.schema, c.err, err)
+		}
+	}
+}
+
+// CheckCodec verifies that FlatbuffersCodec marshals every supported message
+// kind and rejects wrong types and malformed payloads with errors.
+func CheckCodec(buf []byte, fail func(string, ...interface{})) {
+	codec := flatbuffers.FlatbuffersCodec{}
+
+	b := flatbuffers.NewBuilder(0)
+	if _, err := codec.Marshal(b); err == nil {
+		fail("expected an error marshaling an unfinished Builder")
+	}
+	if _, err := codec.Marshal(example.Monster{}); err == nil {
+		fail("expected an error marshaling a %T", example.Monster{})
+	}
+	b.Finish((&example.StatT{Id: "orc", Val: 80}).Pack(b))
+	fromBuilder, err := codec.Marshal(b)
+	if err != nil {
+		fail("marshaling a Builder: %v", err)
+	}
+	fromBytes, err := codec.Marshal(b.FinishedBytes())
+	if err != nil || !bytes.Equal(fromBytes, fromBuilder) {
+		fail("marshaling a []byte: %v", err)
+	}
+	fromObject, err := codec.Marshal(&example.StatT{Id: "orc", Val: 80})
+	if err != nil || !bytes.Equal(fromObject, fromBuilder) {
+		fail("marshaling a *StatT: %v", err)
+	}
+
+	stat := new(example.Stat)
+	if err := codec.Unmarshal(fromBuilder, stat); err != nil {
+		fail("unmarshaling a Stat: %v", err)
+	}
+	if string(stat.Id()) != "orc" || stat.Val() != 80 {
+		fail("unmarshaled Stat has the wrong fields")
+	}
+	if err := codec.Unmarshal(fromBuilder, &example.StatT{}); err == nil {
+		fail("expected an error unmarshaling into a *StatT")
+	}
+	if err := codec.Unmarshal([]byte{1, 0}, stat); !errors.Is(err, flatbuffers.ErrBufferTooShort) {
+		fail("expected ErrBufferTooShort, got %v", err)
+	}
+	if err := codec.Unmarshal([]byte{0xff, 0, 0, 0, 0, 0, 0, 0}, stat); !errors.Is(err, flatbuffers.ErrInvalidRootOffset) {
+		fail("expected ErrInvalidRootOffset, got %v", err)
+	}
+
+	// The identifier is checked on receive and written by Pack-able objects:
+	codec.Identifier = "MONS"
+	if err := codec.Unmarshal(buf, new(example.Monster)); err != nil {
+		fail("unmarshaling a MONS buffer: %v", err)
+	}
+	if err := codec.Unmarshal(fromBuilder, stat); !errors.Is(err, flatbuffers.ErrIdentifierMismatch) {
+		fail("expected ErrIdentifierMismatch, got %v", err)
+	}
+	packed, err := codec.Marshal(&example.MonsterT{Name: "orc"})
+	if err != nil || !flatbuffers.BufferHasIdentifier(packed, "MONS") {
+		fail("marshaling a *MonsterT with an identifier: %v", err)
+	}
+	codec.Identifier = ""
+
+	errRejected := errors.New("rejected")
+	codec.Verify = func([]byte) error { return errRejected }
+	if err := codec.Unmarshal(fromBuilder, stat); err != errRejected {
+		fail("expected the Verify error, got %v", err)
+	}
+	codec.Verify = nil
+
+	// CopyBuffer detaches the message from the receive buffer:
+	codec.Ownership = flatbuffers.CopyBuffer
+	received := append([]byte(nil), fromBuilder...)
+	if err := codec.Unmarshal(received, stat); err != nil {
+		fail("unmarshaling a Stat: %v", err)
+	}
+	for i := range received {
+		received[i] = 0
+	}
+	if string(stat.Id()) != "orc" || stat.Val() != 80 {
+		fail("CopyBuffer message changed with the receive buffer")
+	}
+}
+
+// httpMonsterStorage is a MonsterStorage served over HTTP. It answers with
+// the name and hp of the monsters it gets.
+type httpMonsterStorage struct{}
+
+func statBuilder(id string, val int64) *flatbuffers.Builder {
+	b := flatbuffers.NewBuilder(0)
+	b.Finish((&example.StatT{Id: id, Val: val}).Pack(b))
+	return b
+}
+
+func (httpMonsterStorage) Store(ctx context.Context, m *example.Monster) (*flatbuffers.Builder, error) {
+	if len(m.Name()) == 0 {
+		return nil, flathttp.Errorf(http.StatusBadRequest, "monster has no name")
+	}
+	return statBuilder(string(m.Name()), int64(m.Hp())), nil
+}
+
+func (httpMonsterStorage) Retrieve(s *example.Stat, stream example.MonsterStorage_RetrieveHTTPServer) error {
+	for i := int64(0); i < s.Val(); i++ {
+		b := flatbuffers.NewBuilder(0)
+		b.Finish((&example.MonsterT{Name: string(s.Id()), Hp: int16(i)}).Pack(b))
+		if err := stream.Send(b); err != nil {
+			return err
+		}
+	}
+	if string(s.Id()) == "fail" {
+		return fmt.Errorf("out of monsters")
+	}
+	return nil
+}
+
+func (httpMonsterStorage) GetMaxHitPoint(stream example.MonsterStorage_GetMaxHitPointHTTPServer) error {
+	max := int64(0)
+	for {
+		m, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(statBuilder("max", max))
+		}
+		if err != nil {
+			return err
+		}
+		if int64(m.Hp()) > max {
+			max = int64(m.Hp())
+		}
+	}
+}
+
+func (httpMonsterStorage) GetMinMaxHitPoints(stream example.MonsterStorage_GetMinMaxHitPointsHTTPServer) error {
+	for {
+		m, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(statBuilder(string(m.Name()), int64(m.Hp()))); err != nil {
+			return err
+		}
+	}
+}
+
+// CheckHTTPService verifies that the net/http code generated for
+// MonsterStorage carries unary and streaming calls, and their errors.
+func CheckHTTPService(fail func(string, ...interface{})) {
+	server := httptest.NewServer(example.NewMonsterStorageHTTPHandler(httpMonsterStorage{}))
+	defer server.Close()
+	client := example.NewMonsterStorageHTTPClient(flathttp.NewClient(server.URL, server.Client()))
+	ctx := context.Background()
+
+	monster := func(name string, hp int16) *flatbuffers.Builder {
+		b := flatbuffers.NewBuilder(0)
+		b.Finish((&example.MonsterT{Name: name, Hp: hp}).Pack(b))
+		return b
+	}
+
+	stat, err := client.Store(ctx, monster("orc", 80))
+	if err != nil {
+		fail("Store: %v", err)
+	}
+	if string(stat.Id()) != "orc" || stat.Val() != 80 {
+		fail("Store returned %s %d", stat.Id(), stat.Val())
+	}
+	var httpErr *flathttp.Error
+	if _, err := client.Store(ctx, monster("", 80)); !errors.As(err, &httpErr) || httpErr.Code != http.StatusBadRequest {
+		fail("expected a 400 error, got %v", err)
+	}
+
+	retrieve, err := client.Retrieve(ctx, statBuilder("orc", 3))
+	if err != nil {
+		fail("Retrieve: %v", err)
+	}
+	for i := int16(0); i < 3; i++ {
+		m, err := retrieve.Recv()
+		if err != nil {
+			fail("Retrieve.Recv: %v", err)
+		}
+		if string(m.Name()) != "orc" || m.Hp() != i {
+			fail("Retrieve got %s %d", m.Name(), m.Hp())
+		}
+	}
+	if _, err := retrieve.Recv(); err != io.EOF {
+		fail("expected io.EOF after the stream, got %v", err)
+	}
+	retrieve, err = client.Retrieve(ctx, statBuilder("fail", 1))
+	if err != nil {
+		fail("Retrieve: %v", err)
+	}
+	if _, err := retrieve.Recv(); err != nil {
+		fail("Retrieve.Recv: %v", err)
+	}
+	if _, err := retrieve.Recv(); !errors.As(err, &httpErr) || httpErr.Message != "out of monsters" {
+		fail("expected the error of the stream, got %v", err)
+	}
+
+	maxHp, err := client.GetMaxHitPoint(ctx)
+	if err != nil {
+		fail("GetMaxHitPoint: %v", err)
+	}
+	for _, hp := range []int16{30, 150, 80} {
+		if err := maxHp.Send(monster("orc", hp)); err != nil {
+			fail("GetMaxHitPoint.Send: %v", err)
+		}
+	}
+	if stat, err := maxHp.CloseAndRecv(); err != nil || stat.Val() != 150 {
+		fail("GetMaxHitPoint: %v", err)
+	}
+
+	minMax, err := client.GetMinMaxHitPoints(ctx)
+	if err != nil {
+		fail("GetMinMaxHitPoints: %v", err)
+	}
+	for _, hp := range []int16{30, 150} {
+		if err := minMax.Send(monster("orc", hp)); err != nil {
+			fail("GetMinMaxHitPoints.Send: %v", err)
+		}
+		// Each reply is read before the next request is sent.
+		if stat, err := minMax.Recv(); err != nil || stat.Val() != int64(hp) {
+			fail("GetMinMaxHitPoints.Recv: %v", err)
+		}
+	}
+	minMax.CloseSend()
+	if _, err := minMax.Recv(); err != io.EOF {
+		fail("expected io.EOF after the stream, got %v", err)
+	}
+
+	resp, err := server.Client().Post(server.URL+"/MyGame.Example.MonsterStorage/Store", "application/json", nil)
+	if err != nil {
+		fail("%v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnsupportedMediaType {
+		fail("expected status 415, got %d", resp.StatusCode)
+	}
+}
+
+// rpcMonsterStorage serves the unary method of MonsterStorage over net/rpc.
+type rpcMonsterStorage struct{}
+
+func (rpcMonsterStorage) Store(m *example.Monster, reply *example.StatT) error {
+	if len(m.Name()) == 0 {
+		return errors.New("monster has no name")
+	}
+	*reply = example.StatT{Id: string(m.Name()), Val: int64(m.Hp())}
+	return nil
+}
+
+// CheckNetRPC verifies that the flatrpc codecs carry calls, and their
+// errors, between a net/rpc client and server over a net.Pipe.
+func CheckNetRPC(fail func(string, ...interface{})) {
+	server := rpc.NewServer()
+	if err := server.RegisterName("MyGame.Example.MonsterStorage", rpcMonsterStorage{}); err != nil {
+		fail("%v", err)
+	}
+	serverConn, clientConn := net.Pipe()
+	go server.ServeCodec(flatrpc.NewServerCodec(serverConn))
+	client := flatrpc.NewClient(clientConn)
+	defer client.Close()
+
+	b := flatbuffers.NewBuilder(0)
+	b.Finish((&example.MonsterT{Name: "orc", Hp: 80}).Pack(b))
+	var stat example.Stat
+	if err := client.Call("MyGame.Example.MonsterStorage.Store", b, &stat); err != nil {
+		fail("Store: %v", err)
+	}
+	if string(stat.Id()) != "orc" || stat.Val() != 80 {
+		fail("Store returned %s %d", stat.Id(), stat.Val())
+	}
+
+	// Object-API values are packed by the codec:
+	var stat2 example.Stat
+	if err := client.Call("MyGame.Example.MonsterStorage.Store", &example.MonsterT{Name: "elf", Hp: 5}, &stat2); err != nil {
+		fail("Store: %v", err)
+	}
+	if string(stat2.Id()) != "elf" || stat2.Val() != 5 {
+		fail("Store returned %s %d", stat2.Id(), stat2.Val())
+	}
+
+	if err := client.Call("MyGame.Example.MonsterStorage.Store", &example.MonsterT{}, &stat); err == nil || err.Error() != "monster has no name" {
+		fail("expected the error of Store, got %v", err)
+	}
+	if err := client.Call("MyGame.Example.MonsterStorage.Retrieve", b, &stat); err == nil {
+		fail("expected an error calling an unknown method")
+	}
+	if err := client.Call("MyGame.Example.MonsterStorage.Store", example.Monster{}, &stat); err == nil {
+		fail("expected an error sending a %T", example.Monster{})
+	}
+	// The connection is still usable after the errors:
+	if err := client.Call("MyGame.Example.MonsterStorage.Store", b, &stat); err != nil {
+		fail("Store: %v", err)
+	}
+}